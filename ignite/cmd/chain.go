@@ -80,6 +80,18 @@ to send token from any other account that exists on chain.
 
 The "simulate" command helps you start a simulation testing process for your
 chain.
+
+The "snapshot" command lets you save the state of your local chain under a name
+and restore it later.
+
+The "config" command lets you export the JSON Schema of the config file and
+validate the config file strictly.
+
+The "indexer" command collects the transactions and events of your running
+chain into a local database.
+
+The "upgrade-proposal" command generates the governance proposal that schedules
+a software upgrade to a release of your chain.
 `,
 		Aliases:           []string{"c"},
 		Args:              cobra.ExactArgs(1),
@@ -99,6 +111,10 @@ chain.
 		NewChainDebug(),
 		NewChainLint(),
 		NewChainModules(),
+		NewChainSnapshot(),
+		NewChainConfig(),
+		NewChainIndexer(),
+		NewChainUpgradeProposal(),
 	)
 
 	return c
@@ -1,6 +1,7 @@
 package ignitecmd
 
 import (
+	"encoding/hex"
 	"os/exec"
 	"path/filepath"
 
@@ -10,6 +11,8 @@ import (
 	"github.com/ignite/cli/v29/ignite/pkg/chaincmd"
 	"github.com/ignite/cli/v29/ignite/pkg/cliui"
 	"github.com/ignite/cli/v29/ignite/pkg/cliui/colors"
+	"github.com/ignite/cli/v29/ignite/pkg/cosmosaccount"
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
 	"github.com/ignite/cli/v29/ignite/pkg/goenv"
 	"github.com/ignite/cli/v29/ignite/services/chain"
 )
@@ -22,6 +25,11 @@ const (
 	flagBuildTags         = "build.tags"
 	flagReleasePrefix     = "release.prefix"
 	flagReleaseTargets    = "release.targets"
+	flagReleaseRepro      = "release.reproducible"
+	flagReleaseSigner     = "release.signer"
+	flagReleaseURL        = "release.url-template"
+
+	defaultReleaseSigner = "release"
 )
 
 // NewChainBuild returns a new build command to build a blockchain app.
@@ -80,6 +88,30 @@ tags. If the optional --release.targets is not specified, a binary is created
 for your current environment.
 
 	ignite chain build --release -t linux:amd64 -t darwin:amd64 -t darwin:arm64
+
+Validators can verify what went into a release built with the
+--release.reproducible flag. The binaries are built with "-trimpath" and
+without a build ID, and the tarballs use fixed modification times taken from
+the SOURCE_DATE_EPOCH environment variable (defaulting to the time of the last
+commit), so building the same commit produces the same artifacts. The release
+also contains an SBOM of the chain's Go modules in the CycloneDX and SPDX
+formats, and an in-toto provenance statement signed with an ed25519 key of the
+Ignite keyring. The key is created if it doesn't exist:
+
+	ignite chain build --release --release.reproducible --release.signer release
+
+To schedule a software upgrade with the release, use the --release.url-template
+flag to also write the "upgrade-info.json" file used as the info of the upgrade
+plan. The file contains the download URL of the binary of each target in the
+format expected by cosmovisor, with the checksum of the tarball. The URL is
+rendered for each target from a Go template with the .Version, .OS, .Arch and
+.Tarball fields:
+
+	ignite chain build --release -t linux:amd64 -t linux:arm64 \
+	  --release.url-template "https://github.com/org/mars/releases/download/v{{.Version}}/{{.Tarball}}"
+
+The "ignite chain upgrade-proposal" command generates the governance proposal
+that references it.
 `,
 		Args: cobra.NoArgs,
 		RunE: chainBuildHandler,
@@ -91,10 +123,16 @@ for your current environment.
 	c.Flags().AddFlagSet(flagSetSkipProto())
 	c.Flags().AddFlagSet(flagSetDebug())
 	c.Flags().AddFlagSet(flagSetVerbose())
+	c.Flags().AddFlagSet(flagSetProfile())
 	c.Flags().Bool(flagRelease, false, "build for a release")
 	c.Flags().StringSliceP(flagReleaseTargets, "t", []string{}, "release targets. Available only with --release flag")
 	c.Flags().StringSlice(flagBuildTags, []string{}, "parameters to build the chain binary")
 	c.Flags().String(flagReleasePrefix, "", "tarball prefix for each release target. Available only with --release flag")
+	c.Flags().Bool(flagReleaseRepro, false, "build a reproducible release with an SBOM and a signed provenance. Available only with --release flag")
+	c.Flags().String(flagReleaseSigner, defaultReleaseSigner, "name of the ed25519 key signing the release provenance. Available only with --release.reproducible flag")
+	c.Flags().String(flagReleaseURL, "", "template of the binary URL of each target written in the upgrade info. Available only with --release flag")
+	c.Flags().AddFlagSet(flagSetKeyringBackend())
+	c.Flags().AddFlagSet(flagSetKeyringDir())
 	c.Flags().StringP(flagOutput, "o", "", "binary output path")
 
 	return c
@@ -105,6 +143,9 @@ func chainBuildHandler(cmd *cobra.Command, _ []string) error {
 		isRelease, _      = cmd.Flags().GetBool(flagRelease)
 		releaseTargets, _ = cmd.Flags().GetStringSlice(flagReleaseTargets)
 		releasePrefix, _  = cmd.Flags().GetString(flagReleasePrefix)
+		isReproducible, _ = cmd.Flags().GetBool(flagReleaseRepro)
+		releaseSigner, _  = cmd.Flags().GetString(flagReleaseSigner)
+		releaseURL, _     = cmd.Flags().GetString(flagReleaseURL)
 		buildTags, _      = cmd.Flags().GetStringSlice(flagBuildTags)
 		output, _         = cmd.Flags().GetString(flagOutput)
 		session           = cliui.New(
@@ -131,6 +172,22 @@ func chainBuildHandler(cmd *cobra.Command, _ []string) error {
 		chainOption = append(chainOption, chain.ConfigFile(config))
 	}
 
+	if profile := flagGetProfile(cmd); profile != "" {
+		chainOption = append(chainOption, chain.Profile(profile))
+	}
+
+	if isRelease && isReproducible {
+		signer, err := newReleaseSigner(cmd, session, releaseSigner)
+		if err != nil {
+			return err
+		}
+		chainOption = append(chainOption, chain.ReproducibleRelease(), chain.ReleaseSigner(signer))
+	}
+
+	if isRelease && releaseURL != "" {
+		chainOption = append(chainOption, chain.ReleaseUpgradeInfo(releaseURL))
+	}
+
 	c, err := chain.NewWithHomeFlags(cmd, chainOption...)
 	if err != nil {
 		return err
@@ -193,3 +250,66 @@ func flagGetDebug(cmd *cobra.Command) (debug bool) {
 	debug, _ = cmd.Flags().GetBool(flagDebug)
 	return
 }
+
+// releaseSigner signs release provenances with an ed25519 key of the Ignite keyring.
+type releaseSigner struct {
+	registry cosmosaccount.Registry
+	name     string
+	keyID    string
+}
+
+func newReleaseSigner(cmd *cobra.Command, session *cliui.Session, name string) (releaseSigner, error) {
+	registry, err := cosmosaccount.New(
+		cosmosaccount.WithKeyringBackend(getKeyringBackend(cmd)),
+		cosmosaccount.WithHome(getKeyringDir(cmd)),
+		cosmosaccount.WithSigningAlgo(cosmosaccount.SigningAlgoEd25519),
+	)
+	if err != nil {
+		return releaseSigner{}, errors.Errorf("unable to create registry: %w", err)
+	}
+
+	account, err := registry.GetByName(name)
+	var accErr *cosmosaccount.AccountDoesNotExistError
+	if errors.As(err, &accErr) {
+		var mnemonic string
+		if account, mnemonic, err = registry.Create(name); err != nil {
+			return releaseSigner{}, errors.Errorf("unable to create signing key: %w", err)
+		}
+
+		session.StopSpinner()
+		if err := session.Printf(
+			"🔑 Signing key %q created, keep your mnemonic in a secret place:\n\n%s\n\n",
+			name,
+			mnemonic,
+		); err != nil {
+			return releaseSigner{}, err
+		}
+	} else if err != nil {
+		return releaseSigner{}, err
+	}
+
+	pubKey, err := account.Record.GetPubKey()
+	if err != nil {
+		return releaseSigner{}, err
+	}
+	if pubKey.Type() != cosmosaccount.SigningAlgoEd25519 {
+		return releaseSigner{}, errors.Errorf("key %q is not an ed25519 key", name)
+	}
+
+	return releaseSigner{
+		registry: registry,
+		name:     name,
+		keyID:    hex.EncodeToString(pubKey.Bytes()),
+	}, nil
+}
+
+// KeyID returns the hex encoded public key of the signer.
+func (s releaseSigner) KeyID() string {
+	return s.keyID
+}
+
+// Sign signs msg with the signer's key.
+func (s releaseSigner) Sign(msg []byte) ([]byte, error) {
+	sig, _, err := s.registry.Sign(s.name, msg)
+	return sig, err
+}
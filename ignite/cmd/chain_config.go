@@ -0,0 +1,64 @@
+package ignitecmd
+
+import (
+	"github.com/spf13/cobra"
+
+	chainconfig "github.com/ignite/cli/v29/ignite/config/chain"
+)
+
+// NewChainConfig returns the config command.
+func NewChainConfig() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "config [command]",
+		Short: "Inspect and validate the chain config file",
+		Long: `The config command provides tools to work with the chain config file.
+
+To enable autocompletion and validation of "config.yml" in editors that support
+the YAML language server, save the JSON Schema of the config and reference it at
+the top of the config file:
+
+	ignite chain config schema -o config.schema.json
+
+	# yaml-language-server: $schema=./config.schema.json
+
+The config file is read leniently by the other commands: unknown keys are
+ignored. To check that the config file doesn't contain unknown keys, for example
+a misspelled property, use the validate command:
+
+	ignite chain config validate
+
+Config files using a previous config version are migrated in memory when they
+are read. To rewrite the config file to the latest version, use the migrate
+command:
+
+	ignite chain config migrate
+`,
+		Args: cobra.ExactArgs(1),
+		// The config commands work on the config file as it is, so they must
+		// not require a chain nor migrate the config file.
+		PersistentPreRunE: func(*cobra.Command, []string) error { return nil },
+	}
+
+	c.AddCommand(
+		NewChainConfigSchema(),
+		NewChainConfigValidate(),
+		NewChainConfigMigrate(),
+	)
+
+	return c
+}
+
+// chainConfigPath returns the path of the config file set by the config flag
+// or the path of the default config file of the chain.
+func chainConfigPath(cmd *cobra.Command) (string, error) {
+	if configPath := getConfig(cmd); configPath != "" {
+		return configPath, nil
+	}
+
+	appPath, err := goModulePath(cmd)
+	if err != nil {
+		return "", err
+	}
+
+	return chainconfig.LocateDefault(appPath)
+}
@@ -0,0 +1,140 @@
+package ignitecmd
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/pmezard/go-difflib/difflib"
+	"github.com/spf13/cobra"
+
+	chainconfig "github.com/ignite/cli/v29/ignite/config/chain"
+	"github.com/ignite/cli/v29/ignite/pkg/cliui"
+	"github.com/ignite/cli/v29/ignite/pkg/cliui/colors"
+	"github.com/ignite/cli/v29/ignite/pkg/cliui/icons"
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+)
+
+const flagCheck = "check"
+
+// NewChainConfigMigrate returns the config migrate command.
+func NewChainConfigMigrate() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "migrate",
+		Short: "Migrate the config file to the latest version",
+		Long: `The migrate command rewrites the config file to the latest config version.
+
+Only the keys that changed between the config versions are rewritten, the
+comments and the order of the other keys are preserved. The changes are
+displayed as a diff before the config file is updated.
+
+To check in CI that the config file uses the latest version, use the following
+flag, the command fails when the config file must be migrated:
+
+	ignite chain config migrate --check
+`,
+		Args: cobra.NoArgs,
+		RunE: chainConfigMigrateHandler,
+	}
+
+	flagSetPath(c)
+	c.Flags().Bool(flagCheck, false, "fail when the config file is not using the latest version without migrating it")
+
+	return c
+}
+
+func chainConfigMigrateHandler(cmd *cobra.Command, _ []string) error {
+	check, _ := cmd.Flags().GetBool(flagCheck)
+
+	session := cliui.New(cliui.WithoutUserInteraction(getYes(cmd)))
+	defer session.End()
+
+	configPath, err := chainConfigPath(cmd)
+	if err != nil {
+		return err
+	}
+
+	current, err := os.ReadFile(configPath)
+	if err != nil {
+		return err
+	}
+
+	version, err := chainconfig.ReadConfigVersion(bytes.NewReader(current))
+	if err != nil {
+		return err
+	}
+
+	if version == chainconfig.LatestVersion {
+		return session.Printf("%s Config file %s already uses the latest version %s\n", icons.OK, configPath, version)
+	}
+
+	latest, err := chainconfig.Migrate(current)
+	if err != nil {
+		return err
+	}
+
+	diff, err := configDiff(configPath, current, latest)
+	if err != nil {
+		return err
+	}
+
+	if err := session.Println(diff); err != nil {
+		return err
+	}
+
+	if check {
+		return errors.Errorf(
+			"config file %s uses version %s and must be migrated to %s with \"ignite chain config migrate\"",
+			configPath,
+			version,
+			chainconfig.LatestVersion,
+		)
+	}
+
+	if !getYes(cmd) {
+		question := fmt.Sprintf("Do you want to migrate %s to version %s", configPath, chainconfig.LatestVersion)
+		if err := session.AskConfirm(question); err != nil {
+			if errors.Is(err, cliui.ErrAbort) {
+				return errors.New("config migration aborted")
+			}
+			return err
+		}
+	}
+
+	if err := os.WriteFile(configPath, latest, 0o644); err != nil {
+		return errors.Errorf("config file migration failed: %w", err)
+	}
+
+	return session.Printf("%s Config file %s migrated to version %s\n", icons.OK, configPath, chainconfig.LatestVersion)
+}
+
+// configDiff returns the colored unified diff between two versions of a config file.
+func configDiff(path string, current, latest []byte) (string, error) {
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(string(current)),
+		B:        difflib.SplitLines(string(latest)),
+		FromFile: path,
+		ToFile:   path,
+		Context:  3,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	lines := strings.Split(strings.TrimSuffix(diff, "\n"), "\n")
+	for i, line := range lines {
+		switch {
+		case strings.HasPrefix(line, "+++"), strings.HasPrefix(line, "---"):
+			lines[i] = colors.Faint(line)
+		case strings.HasPrefix(line, "+"):
+			lines[i] = colors.Success(line)
+		case strings.HasPrefix(line, "-"):
+			lines[i] = colors.Error(line)
+		case strings.HasPrefix(line, "@@"):
+			lines[i] = colors.Info(line)
+		}
+	}
+
+	return strings.Join(lines, "\n"), nil
+}
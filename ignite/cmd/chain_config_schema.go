@@ -0,0 +1,54 @@
+package ignitecmd
+
+import (
+	"encoding/json"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	chainconfig "github.com/ignite/cli/v29/ignite/config/chain"
+	"github.com/ignite/cli/v29/ignite/pkg/cliui"
+)
+
+// NewChainConfigSchema returns the config schema command.
+func NewChainConfigSchema() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "schema",
+		Short: "Print the JSON Schema of the latest config version",
+		Args:  cobra.NoArgs,
+		RunE:  chainConfigSchemaHandler,
+	}
+
+	c.Flags().StringP(flagOutput, "o", "", "path of the file to write the schema to")
+
+	return c
+}
+
+func chainConfigSchemaHandler(cmd *cobra.Command, _ []string) error {
+	output, _ := cmd.Flags().GetString(flagOutput)
+
+	schema, err := chainconfig.Schema(chainconfig.LatestVersion)
+	if err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(schema, "", "  ")
+	if err != nil {
+		return err
+	}
+	data = append(data, '\n')
+
+	if output == "" {
+		_, err = cmd.OutOrStdout().Write(data)
+		return err
+	}
+
+	if err := os.WriteFile(output, data, 0o644); err != nil {
+		return err
+	}
+
+	session := cliui.New()
+	defer session.End()
+
+	return session.Printf("Config schema saved to %s\n", output)
+}
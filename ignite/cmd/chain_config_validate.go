@@ -0,0 +1,39 @@
+package ignitecmd
+
+import (
+	"github.com/spf13/cobra"
+
+	chainconfig "github.com/ignite/cli/v29/ignite/config/chain"
+	"github.com/ignite/cli/v29/ignite/pkg/cliui"
+	"github.com/ignite/cli/v29/ignite/pkg/cliui/icons"
+)
+
+// NewChainConfigValidate returns the config validate command.
+func NewChainConfigValidate() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "validate",
+		Short: "Validate the config file rejecting unknown keys",
+		Args:  cobra.NoArgs,
+		RunE:  chainConfigValidateHandler,
+	}
+
+	flagSetPath(c)
+
+	return c
+}
+
+func chainConfigValidateHandler(cmd *cobra.Command, _ []string) error {
+	session := cliui.New()
+	defer session.End()
+
+	configPath, err := chainConfigPath(cmd)
+	if err != nil {
+		return err
+	}
+
+	if _, err := chainconfig.ParseFileStrict(configPath); err != nil {
+		return err
+	}
+
+	return session.Printf("%s Config file %s is valid\n", icons.OK, configPath)
+}
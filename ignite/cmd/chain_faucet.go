@@ -54,6 +54,7 @@ func chainFaucetHandler(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return err
 	}
+	defer faucet.Close()
 
 	// parse provided coins
 	parsedCoins, err := sdk.ParseCoinsNormalized(coins)
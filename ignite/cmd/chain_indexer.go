@@ -0,0 +1,30 @@
+package ignitecmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// NewChainIndexer returns the indexer command.
+func NewChainIndexer() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "indexer [command]",
+		Short: "Collect the transactions and events of the chain into a data backend",
+		Long: `The indexer command collects the transactions and events of a running chain
+and saves them into an embedded SQLite database or appends them to a JSON lines
+file, which can be used to query the chain data or to pipe it into other tools.
+
+The indexer follows the chain: it first collects the blocks that are missing in
+the data backend and then keeps collecting new blocks as they are committed. It
+can be stopped and started again at any time, collection resumes at the block
+next to the latest collected one:
+
+	ignite chain serve
+	ignite chain indexer run
+`,
+		Args: cobra.ExactArgs(1),
+	}
+
+	c.AddCommand(NewChainIndexerRun())
+
+	return c
+}
@@ -0,0 +1,209 @@
+package ignitecmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/v29/ignite/pkg/cliui"
+	"github.com/ignite/cli/v29/ignite/pkg/cliui/colors"
+	"github.com/ignite/cli/v29/ignite/pkg/cosmosaccount"
+	"github.com/ignite/cli/v29/ignite/pkg/cosmosclient"
+	"github.com/ignite/cli/v29/ignite/pkg/cosmostxcollector"
+	"github.com/ignite/cli/v29/ignite/pkg/cosmostxcollector/adapter/jsonl"
+	"github.com/ignite/cli/v29/ignite/pkg/cosmostxcollector/adapter/sqlite"
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/pkg/xurl"
+	"github.com/ignite/cli/v29/ignite/services/chain"
+)
+
+const (
+	flagAdapter      = "adapter"
+	flagFromHeight   = "from-height"
+	flagNode         = "node"
+	flagPollInterval = "poll-interval"
+	flagWorkers      = "workers"
+
+	indexerAdapterSQLite = "sqlite"
+	indexerAdapterJSONL  = "jsonl"
+
+	indexerDir = "indexer"
+)
+
+// indexerAdapter is the data backend used by the indexer.
+type indexerAdapter interface {
+	Init(context.Context) error
+	Save(context.Context, []cosmosclient.TX) error
+	Close() error
+}
+
+// NewChainIndexerRun returns the indexer run command.
+func NewChainIndexerRun() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "run",
+		Short: "Collect the transactions of the chain until the command is stopped",
+		Long: `Collect the transactions of the chain until the command is stopped.
+
+By default the transactions are saved in a SQLite database inside the chain's
+data directory and the RPC address of the chain is read from the config file.
+The transactions can also be appended to a JSON lines file, or written to the
+standard output using "-" as output:
+
+	ignite chain indexer run --adapter jsonl -o - | jq .hash
+
+A different node can be indexed using the node flag:
+
+	ignite chain indexer run --node http://localhost:26657 -o txs.db
+`,
+		Args: cobra.NoArgs,
+		RunE: chainIndexerRunHandler,
+	}
+
+	flagSetPath(c)
+	c.Flags().AddFlagSet(flagSetHome())
+	c.Flags().AddFlagSet(flagSetProfile())
+	c.Flags().String(flagAdapter, indexerAdapterSQLite, fmt.Sprintf("data backend adapter (%s or %s)", indexerAdapterSQLite, indexerAdapterJSONL))
+	c.Flags().StringP(flagOutput, "o", "", "path of the database or JSON lines file (default: inside the chain's data directory)")
+	c.Flags().String(flagNode, "", "RPC address of the node to index (default: the chain's RPC address)")
+	c.Flags().Int64(flagFromHeight, 1, "block height to start indexing from when the data backend is empty")
+	c.Flags().Int(flagWorkers, cosmostxcollector.DefaultFollowWorkers, "number of blocks fetched in parallel when catching up with the chain")
+	c.Flags().Duration(flagPollInterval, cosmostxcollector.DefaultPollInterval, "interval to check for new blocks when the websocket is not available")
+
+	return c
+}
+
+func chainIndexerRunHandler(cmd *cobra.Command, _ []string) error {
+	var (
+		adapterName, _  = cmd.Flags().GetString(flagAdapter)
+		output, _       = cmd.Flags().GetString(flagOutput)
+		node, _         = cmd.Flags().GetString(flagNode)
+		fromHeight, _   = cmd.Flags().GetInt64(flagFromHeight)
+		workers, _      = cmd.Flags().GetInt(flagWorkers)
+		pollInterval, _ = cmd.Flags().GetDuration(flagPollInterval)
+	)
+
+	if adapterName != indexerAdapterSQLite && adapterName != indexerAdapterJSONL {
+		return errors.Errorf("invalid adapter %q, supported adapters are %s and %s", adapterName, indexerAdapterSQLite, indexerAdapterJSONL)
+	}
+
+	// Keep the standard output for the JSON lines when they are written to it
+	options := []cliui.Option{cliui.StartSpinnerWithText("Starting indexer...")}
+	if output == "-" {
+		options = append(options, cliui.WithStdout(os.Stderr))
+	}
+
+	session := cliui.New(options...)
+	defer session.End()
+
+	chainOption := []chain.Option{
+		chain.WithOutputer(session),
+		chain.CollectEvents(session.EventBus()),
+		chain.Profile(flagGetProfile(cmd)),
+	}
+
+	if config := getConfig(cmd); config != "" {
+		chainOption = append(chainOption, chain.ConfigFile(config))
+	}
+
+	c, err := chain.NewWithHomeFlags(cmd, chainOption...)
+	if err != nil {
+		return err
+	}
+
+	if node == "" {
+		addr, err := c.RPCPublicAddress()
+		if err != nil {
+			return err
+		}
+
+		if node, err = xurl.HTTP(addr); err != nil {
+			return errors.Errorf("invalid rpc address format %s: %w", addr, err)
+		}
+	}
+
+	if output == "" {
+		if output, err = defaultIndexerOutput(c, adapterName); err != nil {
+			return err
+		}
+	}
+
+	db, err := newIndexerAdapter(adapterName, output)
+	if err != nil {
+		return err
+	}
+
+	defer db.Close()
+
+	ctx := cmd.Context()
+	if err := db.Init(ctx); err != nil {
+		return err
+	}
+
+	client, err := cosmosclient.New(
+		ctx,
+		cosmosclient.WithNodeAddress(node),
+		cosmosclient.WithKeyringBackend(cosmosaccount.KeyringMemory),
+	)
+	if err != nil {
+		return errors.Errorf("failed to connect to %s, make sure that the chain is running: %w", node, err)
+	}
+
+	if output != "-" {
+		_ = session.Printf("🗂  Indexing %s into %s\n", colors.Info(node), colors.Info(output))
+	}
+
+	session.StartSpinner("Waiting for blocks...")
+
+	collector := cosmostxcollector.New(db, client)
+	err = collector.Follow(
+		ctx,
+		cosmostxcollector.FollowFromHeight(fromHeight),
+		cosmostxcollector.FollowWorkers(workers),
+		cosmostxcollector.FollowPollInterval(pollInterval),
+		cosmostxcollector.FollowOnBlock(func(height int64, txs []cosmosclient.TX) {
+			session.StartSpinner(fmt.Sprintf("Indexed block %d (%d transactions)", height, len(txs)))
+		}),
+		cosmostxcollector.FollowOnError(func(err error) {
+			session.StartSpinner(fmt.Sprintf("Waiting for the chain: %s", err))
+		}),
+	)
+	if err != nil && !errors.Is(err, context.Canceled) {
+		return err
+	}
+
+	return nil
+}
+
+func defaultIndexerOutput(c *chain.Chain, adapterName string) (string, error) {
+	home, err := c.Home()
+	if err != nil {
+		return "", err
+	}
+
+	dir := filepath.Join(home, indexerDir)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", err
+	}
+
+	name := "txs.db"
+	if adapterName == indexerAdapterJSONL {
+		name = "txs.jsonl"
+	}
+
+	return filepath.Join(dir, name), nil
+}
+
+func newIndexerAdapter(adapterName, output string) (indexerAdapter, error) {
+	if adapterName == indexerAdapterJSONL {
+		if output == "-" {
+			return jsonl.NewWriterSink(os.Stdout), nil
+		}
+
+		return jsonl.NewSink(output)
+	}
+
+	return sqlite.NewAdapter(output)
+}
@@ -89,6 +89,7 @@ commands manually to ensure a production-level node initialization.
 	c.Flags().AddFlagSet(flagSetSkipProto())
 	c.Flags().AddFlagSet(flagSetDebug())
 	c.Flags().AddFlagSet(flagSetVerbose())
+	c.Flags().AddFlagSet(flagSetProfile())
 	c.Flags().StringSlice(flagBuildTags, []string{}, "parameters to build the chain binary")
 
 	return c
@@ -119,6 +120,10 @@ func chainInitHandler(cmd *cobra.Command, _ []string) error {
 		chainOption = append(chainOption, chain.ConfigFile(config))
 	}
 
+	if profile := flagGetProfile(cmd); profile != "" {
+		chainOption = append(chainOption, chain.Profile(profile))
+	}
+
 	c, err := chain.NewWithHomeFlags(cmd, chainOption...)
 	if err != nil {
 		return err
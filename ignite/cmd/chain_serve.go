@@ -25,9 +25,11 @@ const (
 	flagConfig          = "config"
 	flagForceReset      = "force-reset"
 	flagGenerateClients = "generate-clients"
+	flagHotReload       = "hot-reload"
 	flagQuitOnFail      = "quit-on-fail"
 	flagResetOnce       = "reset-once"
 	flagOutputFile      = "output-file"
+	flagProfile         = "profile"
 )
 
 var isTerminal = term.IsTerminal
@@ -49,6 +51,17 @@ and restarts the node.
 Whenever possible Ignite will try to keep the current state of the chain by
 exporting and importing the genesis file.
 
+Exporting and importing the genesis file resets the block history and can take
+a long time for large states. To restart the node on its existing data directory
+when only the app source code changes, use the following flag:
+
+	ignite chain serve --hot-reload
+
+When new modules are added, Ignite scaffolds a no-op upgrade in "app/upgrades"
+that adds their stores to the existing data. When the new binary fails to start
+on the existing data for any other reason, Ignite falls back to exporting the
+state with the previous binary and importing it.
+
 To force Ignite to start from a clean slate even if a genesis file exists, use
 the following flag:
 
@@ -66,6 +79,11 @@ from one blockchain to another. To start a node using a specific config file:
 
 	ignite chain serve --config mars.yml
 
+To apply the overlays of a config profile, defined in the "profiles" section of
+the config file or in a "config.<profile>.yml" file, over the config file:
+
+	ignite chain serve --profile devnet
+
 The serve command is meant to be used ONLY FOR DEVELOPMENT PURPOSES. Under the
 hood, it runs "appd start", where "appd" is the name of your chain's binary. For
 production, you may want to run "appd start" manually.
@@ -81,10 +99,12 @@ production, you may want to run "appd start" manually.
 	c.Flags().AddFlagSet(flagSetSkipProto())
 	c.Flags().AddFlagSet(flagSetSkipBuild())
 	c.Flags().AddFlagSet(flagSetVerbose())
+	c.Flags().AddFlagSet(flagSetProfile())
 	c.Flags().BoolP(flagForceReset, "f", false, "force reset of the app state on start and every source change")
 	c.Flags().BoolP(flagResetOnce, "r", false, "reset the app state once on init")
 	c.Flags().Bool(flagGenerateClients, false, "generate code for the configured clients on reset or source code change")
 	c.Flags().Bool(flagQuitOnFail, false, "quit program if the app fails to start")
+	c.Flags().Bool(flagHotReload, false, "restart the app on its existing data directory on source change instead of exporting and importing the state")
 	c.Flags().StringSlice(flagBuildTags, []string{}, "parameters to build the chain binary")
 	c.Flags().StringP(flagOutputFile, "o", "", "output file logging the chain output (no UI, no stdin, listens for SIGTERM, implies --yes) (default: stdout)")
 
@@ -221,6 +241,10 @@ func chainServe(cmd *cobra.Command, session *cliui.Session) error {
 		chainOption = append(chainOption, chain.ConfigFile(config))
 	}
 
+	if profile := flagGetProfile(cmd); profile != "" {
+		chainOption = append(chainOption, chain.Profile(profile))
+	}
+
 	// create the chain
 	c, err := chain.NewWithHomeFlags(cmd, chainOption...)
 	if err != nil {
@@ -255,6 +279,11 @@ func chainServe(cmd *cobra.Command, session *cliui.Session) error {
 		serveOptions = append(serveOptions, chain.GenerateClients())
 	}
 
+	hotReload, _ := cmd.Flags().GetBool(flagHotReload)
+	if hotReload {
+		serveOptions = append(serveOptions, chain.ServeHotReload())
+	}
+
 	buildTags, _ := cmd.Flags().GetStringSlice(flagBuildTags)
 	if len(buildTags) > 0 {
 		serveOptions = append(serveOptions, chain.BuildTags(buildTags...))
@@ -0,0 +1,62 @@
+package ignitecmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/v29/ignite/pkg/cliui"
+	"github.com/ignite/cli/v29/ignite/services/chain"
+)
+
+// NewChainSnapshot returns the snapshot command.
+func NewChainSnapshot() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "snapshot [command]",
+		Short: "Save and restore named snapshots of the chain state",
+		Long: `The snapshot command allows you to save the state of your local chain under a
+name and to restore it later, for example to return to a state with test data
+loaded after experimenting with changes that require a state reset.
+
+Each snapshot contains the exported genesis of the chain and its metadata: the
+block height, the git commit and the checksum of the source code, and the time
+when it was created.
+
+Snapshots are exported from and restored to the chain's data directory, so the
+chain must not be running while saving or restoring a snapshot:
+
+	ignite chain snapshot save proposals-loaded
+	ignite chain snapshot list
+	ignite chain snapshot restore proposals-loaded
+	ignite chain serve
+`,
+		Args: cobra.ExactArgs(1),
+	}
+
+	c.AddCommand(
+		NewChainSnapshotSave(),
+		NewChainSnapshotList(),
+		NewChainSnapshotRestore(),
+		NewChainSnapshotDelete(),
+	)
+
+	return c
+}
+
+func newChainWithSnapshotFlags(cmd *cobra.Command, session *cliui.Session) (*chain.Chain, error) {
+	chainOption := []chain.Option{
+		chain.WithOutputer(session),
+		chain.CollectEvents(session.EventBus()),
+	}
+
+	// check if custom config is defined
+	config, _ := cmd.Flags().GetString(flagConfig)
+	if config != "" {
+		chainOption = append(chainOption, chain.ConfigFile(config))
+	}
+
+	return chain.NewWithHomeFlags(cmd, chainOption...)
+}
+
+func flagSetSnapshot(c *cobra.Command) {
+	flagSetPath(c)
+	c.Flags().AddFlagSet(flagSetHome())
+}
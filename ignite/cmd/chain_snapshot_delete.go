@@ -0,0 +1,38 @@
+package ignitecmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/v29/ignite/pkg/cliui"
+)
+
+// NewChainSnapshotDelete returns the snapshot delete command.
+func NewChainSnapshotDelete() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "delete [name]",
+		Short: "Delete a named snapshot of the chain state",
+		Args:  cobra.ExactArgs(1),
+		RunE:  chainSnapshotDeleteHandler,
+	}
+
+	flagSetSnapshot(c)
+
+	return c
+}
+
+func chainSnapshotDeleteHandler(cmd *cobra.Command, args []string) error {
+	session := cliui.New(cliui.StartSpinnerWithText(statusDeleting))
+	defer session.End()
+
+	c, err := newChainWithSnapshotFlags(cmd, session)
+	if err != nil {
+		return err
+	}
+
+	name := args[0]
+	if err := c.DeleteSnapshot(name); err != nil {
+		return err
+	}
+
+	return session.Printf("Snapshot %s deleted.\n", name)
+}
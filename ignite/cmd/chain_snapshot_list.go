@@ -0,0 +1,70 @@
+package ignitecmd
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/v29/ignite/pkg/cliui"
+)
+
+// NewChainSnapshotList returns the snapshot list command.
+func NewChainSnapshotList() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "list",
+		Short: "List the saved snapshots of the chain state",
+		Args:  cobra.NoArgs,
+		RunE:  chainSnapshotListHandler,
+	}
+
+	flagSetSnapshot(c)
+
+	return c
+}
+
+func chainSnapshotListHandler(cmd *cobra.Command, _ []string) error {
+	session := cliui.New()
+	defer session.End()
+
+	c, err := newChainWithSnapshotFlags(cmd, session)
+	if err != nil {
+		return err
+	}
+
+	snapshots, err := c.Snapshots()
+	if err != nil {
+		return err
+	}
+
+	if len(snapshots) == 0 {
+		return session.Println("no snapshots found")
+	}
+
+	var entries [][]string
+	for _, s := range snapshots {
+		commit := s.Commit
+		if len(commit) > 8 {
+			commit = commit[:8]
+		}
+		if commit == "" {
+			commit = "-"
+		}
+
+		checksum := s.SourceChecksum
+		if len(checksum) > 12 {
+			checksum = checksum[:12]
+		}
+
+		entries = append(entries, []string{
+			s.Name,
+			strconv.FormatInt(s.Height, 10),
+			commit,
+			checksum,
+			s.CreatedAt.Local().Format(time.DateTime),
+		})
+	}
+
+	header := []string{"name", "height", "commit", "source checksum", "created"}
+	return session.PrintTable(header, entries...)
+}
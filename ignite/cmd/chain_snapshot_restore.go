@@ -0,0 +1,60 @@
+package ignitecmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/v29/ignite/pkg/cliui"
+	"github.com/ignite/cli/v29/ignite/pkg/cliui/colors"
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+)
+
+// NewChainSnapshotRestore returns the snapshot restore command.
+func NewChainSnapshotRestore() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "restore [name]",
+		Short: "Restore the chain state from a named snapshot",
+		Long: `The restore command resets the chain's database and uses the state saved in the
+snapshot as the chain's genesis. The restored state is used the next time the
+chain is started with "ignite chain serve".
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: chainSnapshotRestoreHandler,
+	}
+
+	flagSetSnapshot(c)
+
+	return c
+}
+
+func chainSnapshotRestoreHandler(cmd *cobra.Command, args []string) error {
+	session := cliui.New(
+		cliui.StartSpinnerWithText(statusImporting),
+		cliui.WithoutUserInteraction(getYes(cmd)),
+	)
+	defer session.End()
+
+	c, err := newChainWithSnapshotFlags(cmd, session)
+	if err != nil {
+		return err
+	}
+
+	name := args[0]
+	if _, err := c.Snapshot(name); err != nil {
+		return err
+	}
+
+	if err := session.AskConfirm("The current chain state will be lost. Do you want to continue"); err != nil {
+		if errors.Is(err, cliui.ErrAbort) {
+			return errors.New("restore aborted")
+		}
+
+		return err
+	}
+
+	snapshot, err := c.RestoreSnapshot(cmd.Context(), name)
+	if err != nil {
+		return err
+	}
+
+	return session.Printf("📦 Snapshot %s restored at height %d\n", colors.Info(snapshot.Name), snapshot.Height)
+}
@@ -0,0 +1,44 @@
+package ignitecmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/v29/ignite/pkg/cliui"
+	"github.com/ignite/cli/v29/ignite/pkg/cliui/colors"
+)
+
+const flagOverwrite = "overwrite"
+
+// NewChainSnapshotSave returns the snapshot save command.
+func NewChainSnapshotSave() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "save [name]",
+		Short: "Export the chain state and save it as a named snapshot",
+		Args:  cobra.ExactArgs(1),
+		RunE:  chainSnapshotSaveHandler,
+	}
+
+	flagSetSnapshot(c)
+	c.Flags().Bool(flagOverwrite, false, "overwrite the snapshot if it already exists")
+
+	return c
+}
+
+func chainSnapshotSaveHandler(cmd *cobra.Command, args []string) error {
+	session := cliui.New(cliui.StartSpinnerWithText(statusExporting))
+	defer session.End()
+
+	c, err := newChainWithSnapshotFlags(cmd, session)
+	if err != nil {
+		return err
+	}
+
+	overwrite, _ := cmd.Flags().GetBool(flagOverwrite)
+
+	snapshot, err := c.SaveSnapshot(cmd.Context(), args[0], overwrite)
+	if err != nil {
+		return err
+	}
+
+	return session.Printf("💾 Snapshot %s saved at height %d\n", colors.Info(snapshot.Name), snapshot.Height)
+}
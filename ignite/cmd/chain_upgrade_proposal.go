@@ -0,0 +1,294 @@
+package ignitecmd
+
+import (
+	"encoding/json"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/v29/ignite/pkg/cliui"
+	"github.com/ignite/cli/v29/ignite/pkg/cliui/colors"
+	"github.com/ignite/cli/v29/ignite/pkg/cosmosaccount"
+	"github.com/ignite/cli/v29/ignite/pkg/cosmosclient"
+	"github.com/ignite/cli/v29/ignite/pkg/cosmosupgrade"
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/pkg/xurl"
+	"github.com/ignite/cli/v29/ignite/services/chain"
+)
+
+const (
+	flagUpgradeHeight    = "height"
+	flagUpgradeTime      = "time"
+	flagUpgradeBlockTime = "block-time"
+	flagUpgradeInfo      = "upgrade-info"
+	flagAuthority        = "authority"
+	flagDeposit          = "deposit"
+	flagTitle            = "title"
+	flagSummary          = "summary"
+	flagMetadata         = "metadata"
+	flagExpedited        = "expedited"
+
+	// upgradeBlockTimeWindow is the number of blocks used to compute the average block time.
+	upgradeBlockTimeWindow = 1000
+)
+
+// NewChainUpgradeProposal returns a command that generates the governance
+// proposal of a software upgrade.
+func NewChainUpgradeProposal() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "upgrade-proposal [name]",
+		Short: "Generate the governance proposal of a software upgrade",
+		Long: `Generate the governance proposal JSON of a software upgrade of the chain.
+
+The proposal contains a MsgSoftwareUpgrade message whose plan references the
+upgrade info of a release, written by "ignite chain build --release" with the
+--release.url-template flag. The name of the upgrade must match the name of the
+upgrade handler registered by the new binary.
+
+The upgrade is scheduled at a block height:
+
+	ignite chain upgrade-proposal v2 --height 1200000 --deposit 10000000stake -o proposal.json
+
+Or at a time, either absolute (RFC 3339) or relative to the latest block time.
+The height is estimated from the latest block of the node and the average block
+time of the last blocks:
+
+	ignite chain upgrade-proposal v2 --time 72h --node https://rpc.mars.example.com:443
+
+The proposal is submitted with the chain's binary:
+
+	marsd tx gov submit-proposal proposal.json --from alice
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: chainUpgradeProposalHandler,
+	}
+
+	flagSetPath(c)
+	c.Flags().AddFlagSet(flagSetHome())
+	c.Flags().AddFlagSet(flagSetProfile())
+	c.Flags().Int64(flagUpgradeHeight, 0, "block height of the upgrade")
+	c.Flags().String(flagUpgradeTime, "", "time of the upgrade, as an RFC 3339 time or a duration from the latest block time")
+	c.Flags().Duration(flagUpgradeBlockTime, 0, "average block time used to estimate the upgrade height (default: computed from the node)")
+	c.Flags().String(flagUpgradeInfo, "", "path of the upgrade info of the release (default: the upgrade info in the chain's release directory)")
+	c.Flags().String(flagNode, "", "RPC address of the node used to estimate the upgrade height (default: the chain's RPC address)")
+	c.Flags().String(flagAuthority, "", "address of the upgrade authority (default: the gov module account)")
+	c.Flags().String(flagDeposit, "", "deposit of the proposal")
+	c.Flags().String(flagTitle, "", "title of the proposal")
+	c.Flags().String(flagSummary, "", "summary of the proposal")
+	c.Flags().String(flagMetadata, "", "metadata of the proposal")
+	c.Flags().Bool(flagExpedited, false, "submit the proposal as expedited")
+	c.Flags().StringP(flagOutput, "o", "", "path of the proposal file (default: the standard output)")
+
+	return c
+}
+
+func chainUpgradeProposalHandler(cmd *cobra.Command, args []string) error {
+	var (
+		name           = args[0]
+		height, _      = cmd.Flags().GetInt64(flagUpgradeHeight)
+		upgradeTime, _ = cmd.Flags().GetString(flagUpgradeTime)
+		blockTime, _   = cmd.Flags().GetDuration(flagUpgradeBlockTime)
+		infoPath, _    = cmd.Flags().GetString(flagUpgradeInfo)
+		node, _        = cmd.Flags().GetString(flagNode)
+		authority, _   = cmd.Flags().GetString(flagAuthority)
+		deposit, _     = cmd.Flags().GetString(flagDeposit)
+		title, _       = cmd.Flags().GetString(flagTitle)
+		summary, _     = cmd.Flags().GetString(flagSummary)
+		metadata, _    = cmd.Flags().GetString(flagMetadata)
+		expedited, _   = cmd.Flags().GetBool(flagExpedited)
+		output, _      = cmd.Flags().GetString(flagOutput)
+	)
+
+	if (height == 0) == (upgradeTime == "") {
+		return errors.Errorf("either --%s or --%s is required", flagUpgradeHeight, flagUpgradeTime)
+	}
+
+	// Keep the standard output for the proposal when it is written to it
+	options := []cliui.Option{cliui.StartSpinnerWithText("Generating proposal...")}
+	if output == "" {
+		options = append(options, cliui.WithStdout(os.Stderr))
+	}
+
+	session := cliui.New(options...)
+	defer session.End()
+
+	chainOption := []chain.Option{
+		chain.WithOutputer(session),
+		chain.CollectEvents(session.EventBus()),
+		chain.Profile(flagGetProfile(cmd)),
+	}
+
+	if config := getConfig(cmd); config != "" {
+		chainOption = append(chainOption, chain.ConfigFile(config))
+	}
+
+	c, err := chain.NewWithHomeFlags(cmd, chainOption...)
+	if err != nil {
+		return err
+	}
+
+	if infoPath == "" {
+		infoPath = c.UpgradeInfoPath()
+	}
+
+	info, err := readUpgradeInfo(infoPath)
+	if err != nil {
+		return err
+	}
+
+	if upgradeTime != "" {
+		if node == "" {
+			addr, err := c.RPCPublicAddress()
+			if err != nil {
+				return err
+			}
+
+			if node, err = xurl.HTTP(addr); err != nil {
+				return errors.Errorf("invalid rpc address format %s: %w", addr, err)
+			}
+		}
+
+		height, err = estimateUpgradeHeight(cmd, session, node, upgradeTime, blockTime)
+		if err != nil {
+			return err
+		}
+	}
+
+	if authority == "" {
+		prefix, err := c.Bech32Prefix()
+		if err != nil {
+			return err
+		}
+
+		if authority, err = cosmosupgrade.GovAuthority(prefix); err != nil {
+			return err
+		}
+	}
+
+	proposal, err := cosmosupgrade.NewProposal(cosmosupgrade.ProposalOptions{
+		Name:      name,
+		Height:    height,
+		Info:      info,
+		Authority: authority,
+		Title:     title,
+		Summary:   summary,
+		Metadata:  metadata,
+		Deposit:   deposit,
+		Expedited: expedited,
+	})
+	if err != nil {
+		return err
+	}
+
+	bz, err := json.MarshalIndent(proposal, "", "  ")
+	if err != nil {
+		return err
+	}
+	bz = append(bz, '\n')
+
+	session.StopSpinner()
+
+	if output == "" {
+		_, err := cmd.OutOrStdout().Write(bz)
+		return err
+	}
+
+	if err := os.WriteFile(output, bz, 0o644); err != nil {
+		return err
+	}
+
+	return session.Printf("🗳  Upgrade proposal %s at height %d created: %s\n", name, height, colors.Info(output))
+}
+
+func readUpgradeInfo(path string) (cosmosupgrade.Info, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return cosmosupgrade.Info{}, errors.Errorf(
+				"upgrade info %s not found, build the release with --%s first",
+				path,
+				flagReleaseURL,
+			)
+		}
+		return cosmosupgrade.Info{}, err
+	}
+
+	var info cosmosupgrade.Info
+	if err := json.Unmarshal(bz, &info); err != nil {
+		return cosmosupgrade.Info{}, errors.Errorf("invalid upgrade info %s: %w", path, err)
+	}
+	if len(info.Binaries) == 0 {
+		return cosmosupgrade.Info{}, errors.Errorf("upgrade info %s has no binaries", path)
+	}
+
+	return info, nil
+}
+
+// estimateUpgradeHeight estimates the height of the upgrade from the latest block
+// of the node. upgradeTime is either an RFC 3339 time or a duration from the
+// latest block time.
+func estimateUpgradeHeight(
+	cmd *cobra.Command,
+	session *cliui.Session,
+	node, upgradeTime string,
+	blockTime time.Duration,
+) (int64, error) {
+	ctx := cmd.Context()
+
+	client, err := cosmosclient.New(
+		ctx,
+		cosmosclient.WithNodeAddress(node),
+		cosmosclient.WithKeyringBackend(cosmosaccount.KeyringMemory),
+	)
+	if err != nil {
+		return 0, errors.Errorf("failed to connect to %s, make sure that the chain is running: %w", node, err)
+	}
+
+	status, err := client.Status(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	latestHeight, latestTime := status.SyncInfo.LatestBlockHeight, status.SyncInfo.LatestBlockTime
+
+	target, err := parseUpgradeTime(upgradeTime, latestTime)
+	if err != nil {
+		return 0, err
+	}
+
+	if blockTime == 0 {
+		if blockTime, err = client.AverageBlockTime(ctx, upgradeBlockTimeWindow); err != nil {
+			return 0, err
+		}
+	}
+
+	height, err := cosmosupgrade.EstimateHeight(latestHeight, latestTime, blockTime, target)
+	if err != nil {
+		return 0, err
+	}
+
+	session.StopSpinner()
+	_ = session.Printf(
+		"⏱  Estimated height %d at %s (latest block %d, block time %s)\n",
+		height,
+		colors.Info(target.Format(time.RFC3339)),
+		latestHeight,
+		blockTime,
+	)
+
+	return height, nil
+}
+
+func parseUpgradeTime(value string, latestTime time.Time) (time.Time, error) {
+	if d, err := time.ParseDuration(value); err == nil {
+		return latestTime.Add(d), nil
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, errors.Errorf("invalid upgrade time %q, use an RFC 3339 time or a duration: %w", value, err)
+	}
+
+	return t, nil
+}
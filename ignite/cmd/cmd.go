@@ -98,6 +98,7 @@ $ ignite scaffold chain example
 		NewTestnet(),
 	)
 	c.AddCommand(deprecated()...)
+	c.PersistentFlags().Bool(flagFrozen, false, "fail when Ignite Apps don't match their igniteapps.lock instead of updating it")
 	c.SetContext(ctx)
 
 	// Don't load Ignite apps for level one commands that doesn't allow them
@@ -165,6 +166,17 @@ func flagSetConfig() *flag.FlagSet {
 	return fs
 }
 
+func flagSetProfile() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.String(flagProfile, "", "name of the config profile whose overlays are applied over the config file")
+	return fs
+}
+
+func flagGetProfile(cmd *cobra.Command) string {
+	profile, _ := cmd.Flags().GetString(flagProfile)
+	return profile
+}
+
 func getConfig(cmd *cobra.Command) (config string) {
 	config, _ = cmd.Flags().GetString(flagConfig)
 	return
@@ -189,7 +201,7 @@ func getChainConfig(cmd *cobra.Command) (*chainconfig.Config, string, error) {
 		}
 	}
 
-	cfg, err = chainconfig.ParseFile(configPath)
+	cfg, err = chainconfig.ParseFileWithProfile(configPath, flagGetProfile(cmd))
 	if err != nil {
 		return nil, "", err
 	}
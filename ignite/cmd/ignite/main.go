@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"image/color"
+	"io"
 	"os"
 	"sync"
 
@@ -47,10 +48,16 @@ func run() int {
 	analytics.SendMetric(&wg, subCmd)
 	analytics.EnableSentry(ctx, &wg)
 
+	// the errors are printed below, so fang must not print them too.
+	// fang writes the error to stderr when it's not a terminal, and through
+	// the error handler otherwise.
+	cmd.SetErr(io.Discard)
+
 	// use charm's fang to improve CLI output
 	err = fang.Execute(ctx, cmd,
 		fang.WithColorSchemeFunc(cliColorScheme),
 		fang.WithVersion(version.Version),
+		fang.WithErrorHandler(func(io.Writer, fang.Styles, error) {}),
 	)
 	if err != nil {
 		err = ensureError(err)
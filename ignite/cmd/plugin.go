@@ -4,6 +4,9 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
 	"strings"
 	"time"
 
@@ -21,30 +24,50 @@ import (
 	"github.com/ignite/cli/v29/ignite/pkg/xgit"
 	"github.com/ignite/cli/v29/ignite/services/chain"
 	"github.com/ignite/cli/v29/ignite/services/plugin"
+	"github.com/ignite/cli/v29/ignite/services/plugin/host"
 )
 
 const (
 	flagPluginsGlobal = "global"
+	flagFrozen        = "frozen"
 )
 
-// plugins hold the list of plugin declared in the config.
-// A global variable is used so the list is accessible to the plugin commands.
-var plugins []*plugin.Plugin
+var (
+	// plugins hold the list of plugin declared in the config.
+	// A global variable is used so the list is accessible to the plugin commands.
+	plugins []*plugin.Plugin
+
+	// pluginsLocks hold the locks of the local and global configs, so they can be
+	// saved by the plugin commands.
+	pluginsLocks []*pluginsconfig.Lock
+)
 
 // LoadPlugins tries to load all the plugins found in configurations.
 // If no configurations found, it returns w/o error.
 func LoadPlugins(ctx context.Context, cmd *cobra.Command, session *cliui.Session) error {
-	var pluginsConfigs []pluginsconfig.Plugin
+	var (
+		pluginsConfigs        []pluginsconfig.Plugin
+		localLock, globalLock *pluginsconfig.Lock
+		localPath, globalPath string
+	)
 	localCfg, err := parseLocalPlugins()
 	if err != nil && !errors.As(err, &cosmosanalysis.ErrPathNotChain{}) {
 		return err
 	} else if err == nil {
 		pluginsConfigs = append(pluginsConfigs, localCfg.Apps...)
+		localPath = localCfg.Path()
+		if localLock, err = parsePluginsLock(localCfg); err != nil {
+			return err
+		}
 	}
 
 	globalCfg, err := parseGlobalPlugins()
 	if err == nil {
 		pluginsConfigs = append(pluginsConfigs, globalCfg.Apps...)
+		globalPath = globalCfg.Path()
+		if globalLock, err = parsePluginsLock(globalCfg); err != nil {
+			return err
+		}
 	}
 	ensureDefaultPlugins(cmd, globalCfg)
 
@@ -52,11 +75,30 @@ func LoadPlugins(ctx context.Context, cmd *cobra.Command, session *cliui.Session
 		return nil
 	}
 
+	options := []plugin.Option{
+		plugin.CollectEvents(session.EventBus()),
+		plugin.WithLock(localLock, globalLock),
+		plugin.WithConfigPath(localPath, globalPath),
+	}
+	if isFrozen() {
+		options = append(options, plugin.Frozen())
+	}
+
 	uniquePlugins := pluginsconfig.RemoveDuplicates(pluginsConfigs)
-	plugins, err = plugin.Load(ctx, uniquePlugins, plugin.CollectEvents(session.EventBus()))
+	plugins, err = plugin.Load(ctx, uniquePlugins, options...)
 	if err != nil {
 		return err
 	}
+
+	for _, lock := range []*pluginsconfig.Lock{localLock, globalLock} {
+		if lock == nil {
+			continue
+		}
+		pluginsLocks = append(pluginsLocks, lock)
+		if err := savePluginsLock(lock); err != nil {
+			return err
+		}
+	}
 	if len(plugins) == 0 {
 		return nil
 	}
@@ -96,6 +138,60 @@ func parseGlobalPlugins() (cfg *pluginsconfig.Config, err error) {
 	return
 }
 
+// parsePluginsLock parses the lock next to a plugin config.
+// Plugins no longer declared in the config are removed from the lock.
+func parsePluginsLock(cfg *pluginsconfig.Config) (*pluginsconfig.Lock, error) {
+	if cfg.Path() == "" {
+		return nil, nil
+	}
+
+	lock, err := pluginsconfig.ParseLock(filepath.Dir(cfg.Path()))
+	if err != nil {
+		return nil, err
+	}
+	lock.Retain(cfg.Apps)
+	return lock, nil
+}
+
+// savePluginsLock saves the lock when it differs from the saved one.
+// When the --frozen flag is used, an error is returned instead.
+func savePluginsLock(lock *pluginsconfig.Lock) error {
+	saved, err := pluginsconfig.ParseLock(filepath.Dir(lock.Path()))
+	if err != nil {
+		return err
+	}
+	if slices.Equal(saved.Apps, lock.Apps) {
+		return nil
+	}
+	if isFrozen() {
+		return errors.Errorf("%s is out of date, run \"ignite app update\" without --%s", lock.Path(), flagFrozen)
+	}
+	return lock.Save()
+}
+
+// isFrozen returns true if the --frozen flag is used.
+// Apps are loaded before the command line is parsed, so the flag is read from
+// the program arguments. As with the parsed flag, the last value wins.
+func isFrozen() bool {
+	frozen := false
+	for _, arg := range os.Args[1:] {
+		if arg == "--" {
+			break
+		}
+		name, value, ok := strings.Cut(arg, "=")
+		if name != "--"+flagFrozen {
+			continue
+		}
+		if !ok {
+			frozen = true
+			continue
+		}
+		// an invalid value fails once the command line is parsed
+		frozen, _ = strconv.ParseBool(value)
+	}
+	return frozen
+}
+
 func linkPlugins(ctx context.Context, rootCmd *cobra.Command, plugins []*plugin.Plugin) error {
 	// Link plugins to related commands
 	var linkErrors []*plugin.Plugin
@@ -414,26 +510,44 @@ func NewAppUpdate() *cobra.Command {
 		Short: "Update app",
 		Long: `Updates an Ignite App specified by path.
 
-If no path is specified all declared apps are updated.`,
+If no path is specified all declared apps are updated.
+
+Remote apps are pinned to the commit they were fetched at in the igniteapps.lock
+file next to igniteapps.yml, along with the checksum of their source tree. The
+update resolves the reference of the apps again and rewrites their lock.`,
 		Example: "ignite app update github.com/org/my-app/",
 		Args:    cobra.MaximumNArgs(1),
 		RunE: func(_ *cobra.Command, args []string) error {
-			if len(args) == 0 {
-				// update all plugins
-				return plugin.Update(plugins...)
+			if isFrozen() {
+				return errors.Errorf("apps can't be updated with --%s", flagFrozen)
 			}
-			pluginPath, err := getAppPath(args[0])
-			if err != nil {
-				return err
+
+			toUpdate := plugins
+			if len(args) > 0 {
+				pluginPath, err := getAppPath(args[0])
+				if err != nil {
+					return err
+				}
+
+				// find the plugin to update
+				i := slices.IndexFunc(plugins, func(p *plugin.Plugin) bool {
+					return p.HasPath(pluginPath)
+				})
+				if i == -1 {
+					return errors.Errorf("App %q not found", pluginPath)
+				}
+				toUpdate = plugins[i : i+1]
 			}
 
-			// find the plugin to update
-			for _, p := range plugins {
-				if p.HasPath(pluginPath) {
-					return plugin.Update(p)
+			if err := plugin.Update(toUpdate...); err != nil {
+				return err
+			}
+			for _, lock := range pluginsLocks {
+				if err := savePluginsLock(lock); err != nil {
+					return err
 				}
 			}
-			return errors.Errorf("App %q not found", pluginPath)
+			return nil
 		},
 	}
 }
@@ -444,7 +558,13 @@ func NewAppInstall() *cobra.Command {
 		Short: "Install app",
 		Long: `Installs an Ignite App.
 
-Respects key value pairs declared after the app path to be added to the generated configuration definition.`,
+Respects key value pairs declared after the app path to be added to the generated configuration definition.
+
+Remote apps are built from source, unless a release of the app provides a
+prebuilt binary for the current platform. Releases are declared in the
+app.ignite.yml file of the app repository, or in a release index referenced by
+the "index" property of the app in igniteapps.yml, which can be a local file for
+air-gapped environments.`,
 		Example: "ignite app install github.com/org/my-app/ foo=bar baz=qux",
 		Args:    cobra.MinimumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -486,8 +606,14 @@ Respects key value pairs declared after the app path to be added to the generate
 				Global: global,
 			}
 
+			lock, err := parsePluginsLock(conf)
+			if err != nil {
+				return err
+			}
+
 			pluginsOptions := []plugin.Option{
 				plugin.CollectEvents(session.EventBus()),
+				plugin.WithLock(lock, lock),
 			}
 
 			var pluginArgs []string
@@ -522,6 +648,11 @@ Respects key value pairs declared after the app path to be added to the generate
 			if err := conf.Save(); err != nil {
 				return err
 			}
+			if lock != nil {
+				if err := lock.Save(); err != nil {
+					return err
+				}
+			}
 
 			session.Printf("%s Installed %s\n", icons.Tada, pluginPath)
 			return nil
@@ -582,6 +713,16 @@ func NewAppUninstall() *cobra.Command {
 				return err
 			}
 
+			lock, err := parsePluginsLock(conf)
+			if err != nil {
+				return err
+			}
+			if lock != nil {
+				if err := lock.Save(); err != nil {
+					return err
+				}
+			}
+
 			s.Printf("%s %s uninstalled\n", icons.OK, pluginPath)
 			s.Printf("\t%s updated\n", conf.Path())
 
@@ -731,12 +872,12 @@ func newAppClientAPI(cmd *cobra.Command) (plugin.ClientAPI, error) {
 		return nil, err
 	}
 
-	var options []plugin.APIOption
+	var options []host.APIOption
 	if c != nil {
-		options = append(options, plugin.WithChain(c))
+		options = append(options, host.WithChain(c))
 	}
 
-	return plugin.NewClientAPI(options...), nil
+	return host.NewClientAPI(options...), nil
 }
 
 func flagSetPluginsGlobal() *flag.FlagSet {
@@ -79,6 +79,11 @@ func newPluginInstallCmd(dp defaultPlugin) *cobra.Command {
 				return err
 			}
 
+			lock, err := parsePluginsLock(cfg)
+			if err != nil {
+				return err
+			}
+
 			session := cliui.New(cliui.WithoutUserInteraction(getYes(cmd)))
 			defer session.End()
 
@@ -87,12 +92,19 @@ func newPluginInstallCmd(dp defaultPlugin) *cobra.Command {
 				cmd.Context(),
 				[]pluginsconfig.Plugin{pluginCfg},
 				plugin.CollectEvents(session.EventBus()),
+				plugin.WithLock(lock, lock),
 			)
 			if err != nil {
 				return err
 			}
 			defer plugins[0].KillClient()
 
+			if lock != nil {
+				if err := savePluginsLock(lock); err != nil {
+					return err
+				}
+			}
+
 			// Keep reference of the root command before removal
 			rootCmd := cmd.Root()
 			// Remove this command before call to linkPlugins because a plugin is
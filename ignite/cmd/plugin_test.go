@@ -654,3 +654,30 @@ func execCmd(t *testing.T, c *cobra.Command, args []string) {
 		execCmd(t, c, args)
 	}
 }
+
+func TestIsFrozen(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     []string
+		expected bool
+	}{
+		{name: "no flag", args: []string{"chain", "serve"}},
+		{name: "bare flag", args: []string{"chain", "serve", "--frozen"}, expected: true},
+		{name: "true", args: []string{"--frozen=true", "chain", "serve"}, expected: true},
+		{name: "one", args: []string{"--frozen=1"}, expected: true},
+		{name: "capitalized", args: []string{"--frozen=True"}, expected: true},
+		{name: "false", args: []string{"--frozen=false"}},
+		{name: "last value wins", args: []string{"--frozen", "--frozen=0"}},
+		{name: "invalid value", args: []string{"--frozen=yes"}},
+		{name: "after args terminator", args: []string{"app", "--", "--frozen"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			args := os.Args
+			t.Cleanup(func() { os.Args = args })
+			os.Args = append([]string{"ignite"}, tt.args...)
+
+			require.Equal(t, tt.expected, isFrozen())
+		})
+	}
+}
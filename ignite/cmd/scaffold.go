@@ -26,7 +26,10 @@ const (
 	flagDescription  = "desc"
 	flagProtoDir     = "proto-dir"
 
-	msgCommitPrefix = "Your project changes have not been committed.\nTo enable reverting to your current state, commit your saved changes."
+	flagSecondaryIndex = "secondary-index"
+	flagEvents         = "events"
+
+	msgCommitPrefix = "Your project changes have not been committed.\nTo enable reverting to your current state, commit your saved changes.\nScaffold operations can also be reverted with \"ignite scaffold undo\"."
 	msgCommitPrompt = "Do you want to proceed without committing your saved changes"
 
 	statusScaffolding      = "Scaffolding..."
@@ -53,7 +56,8 @@ corresponding command with a "--help" flag, for example, "ignite scaffold chain
 The Ignite team strongly recommends committing the code to a version control
 system before running scaffolding commands. This will make it easier to see the
 changes to the source code as well as undo the command if you've decided to roll
-back the changes.
+back the changes. Scaffold operations are also recorded in the app, the latest
+ones can be reverted with "ignite scaffold undo".
 
 This blockchain you create with the chain scaffolding command uses the modular
 Cosmos SDK framework and imports many standard modules for functionality like
@@ -90,12 +94,16 @@ data back from your blockchain application.
 You can also scaffold a type, which just produces a new protocol buffer file
 with a proto message description. Note that proto messages produce (and
 correspond with) Go types whereas Cosmos SDK messages correspond to proto "rpc"
-in the "Msg" service.
+in the "Msg" service. Types, messages and queries can be removed from a module
+with "ignite scaffold remove".
 
 If you're building an application with custom IBC logic, you might need to
 scaffold IBC packets. An IBC packet represents the data sent from one blockchain
 to another. You can only scaffold IBC packets in IBC-enabled modules scaffolded
 with an "--ibc" flag. Note that the default module is not IBC-enabled.
+
+Modules and their components can also be described in a blueprint file and
+scaffolded at once with "ignite scaffold apply".
 `,
 		Aliases: []string{"s"},
 		Args:    cobra.ExactArgs(1),
@@ -106,6 +114,7 @@ with an "--ibc" flag. Note that the default module is not IBC-enabled.
 		NewScaffoldChain(),
 		NewScaffoldModule(),
 		NewScaffoldMigration(),
+		NewScaffoldUpgrade(),
 		NewScaffoldList(),
 		NewScaffoldMap(),
 		NewScaffoldSingle(),
@@ -113,6 +122,13 @@ with an "--ibc" flag. Note that the default module is not IBC-enabled.
 		NewScaffoldParams(),
 		NewScaffoldConfigs(),
 		NewScaffoldMessage(),
+		NewScaffoldEvent(),
+		NewScaffoldError(),
+		NewScaffoldBlocker(),
+		NewScaffoldHooks(),
+		NewScaffoldApply(),
+		NewScaffoldRemove(),
+		NewScaffoldUndo(),
 		NewScaffoldQuery(),
 		NewScaffoldPacket(),
 		NewScaffoldVue(),
@@ -129,15 +145,20 @@ with an "--ibc" flag. Note that the default module is not IBC-enabled.
 }
 
 func migrationPreRunHandler(cmd *cobra.Command, args []string) error {
+	if err := gitChangesConfirmPreRunHandler(cmd, args); err != nil {
+		return err
+	}
+
+	return appMigrationPreRunHandler(cmd)
+}
+
+// appMigrationPreRunHandler checks the Cosmos SDK version of the app and migrates its tools and dependencies.
+func appMigrationPreRunHandler(cmd *cobra.Command) error {
 	if verbose := flagGetVerbose(cmd); verbose {
 		// sets the IGNT_DEBUG env var to enable verbose logging
 		env.SetDebug()
 	}
 
-	if err := gitChangesConfirmPreRunHandler(cmd, args); err != nil {
-		return err
-	}
-
 	session := cliui.New(cliui.WithoutUserInteraction(getYes(cmd)))
 	defer session.End()
 
@@ -179,6 +200,8 @@ func scaffoldType(
 		withoutMessage    = flagGetNoMessage(cmd)
 		withoutSimulation = flagGetNoSimulation(cmd)
 		signer            = flagGetSigner(cmd)
+		secondaryIndexes  = flagGetSecondaryIndexes(cmd)
+		withEvents        = flagGetEvents(cmd)
 		appPath           = flagGetPath(cmd)
 	)
 
@@ -190,6 +213,9 @@ func scaffoldType(
 	if moduleName != "" {
 		options = append(options, scaffolder.TypeWithModule(moduleName))
 	}
+	if len(secondaryIndexes) > 0 {
+		options = append(options, scaffolder.TypeWithSecondaryIndexes(secondaryIndexes...))
+	}
 	if withoutMessage {
 		options = append(options, scaffolder.TypeWithoutMessage())
 	} else {
@@ -200,6 +226,9 @@ func scaffoldType(
 			options = append(options, scaffolder.TypeWithoutSimulation())
 		}
 	}
+	if withEvents {
+		options = append(options, scaffolder.TypeWithEvents())
+	}
 
 	session := cliui.New(
 		cliui.StartSpinnerWithText(statusScaffolding),
@@ -322,6 +351,28 @@ func flagGetSigner(cmd *cobra.Command) string {
 	return signer
 }
 
+func flagSetSecondaryIndex() *flag.FlagSet {
+	f := flag.NewFlagSet("", flag.ContinueOnError)
+	f.StringSlice(flagSecondaryIndex, nil, "comma-separated fields indexed by a secondary index, suffixed with \":unique\" for unique values")
+	return f
+}
+
+func flagGetSecondaryIndexes(cmd *cobra.Command) []string {
+	secondaryIndexes, _ := cmd.Flags().GetStringSlice(flagSecondaryIndex)
+	return secondaryIndexes
+}
+
+func flagSetEvents() *flag.FlagSet {
+	f := flag.NewFlagSet("", flag.ContinueOnError)
+	f.Bool(flagEvents, false, "emit typed events from the create, update and delete message handlers")
+	return f
+}
+
+func flagGetEvents(cmd *cobra.Command) bool {
+	withEvents, _ := cmd.Flags().GetBool(flagEvents)
+	return withEvents
+}
+
 func flagGetVerbose(cmd *cobra.Command) bool {
 	verbose, _ := cmd.Flags().GetBool(flagVerbose)
 	return verbose
@@ -0,0 +1,139 @@
+package ignitecmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/v29/ignite/pkg/cliui"
+	"github.com/ignite/cli/v29/ignite/pkg/xgenny"
+	"github.com/ignite/cli/v29/ignite/services/scaffolder"
+)
+
+// NewScaffoldApply returns the command to scaffold the modules and components described in a blueprint file.
+func NewScaffoldApply() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "apply [blueprint]",
+		Short: "Scaffold the modules and components described in a blueprint file",
+		Long: `Scaffold the modules and components described in a blueprint file.
+
+A blueprint is a YAML file describing modules, with their params and configs,
+and the types, messages, queries and packets scaffolded in them. The options of
+the components match the flags of the corresponding scaffold commands:
+
+	modules:
+	  - name: blog
+	    params: [maxTitleLength:uint]
+	    types:
+	      - name: post
+	        kind: list # list, map, single or type
+	        fields: [title, body]
+	      - name: comment
+	        kind: map
+	        fields: [body]
+	        index: postID:uint
+	        secondary-indexes: [creator]
+	        signer: author
+	    messages:
+	      - name: like-post
+	        fields: [id:uint]
+	        response: [count:uint]
+	    queries:
+	      - name: count-posts
+	        fields: [author]
+	        response: [count:uint]
+	  - name: transfer
+	    ibc: true
+	    ordering: unordered
+	    dependencies: [bank]
+	    packets:
+	      - name: send-post
+	        fields: [title]
+	        ack: [postID:uint]
+
+The blueprint can be applied several times: the modules and components that
+already exist are skipped, and the params and configs missing in an existing
+module are added to it.
+
+	ignite scaffold apply blueprint.yml
+
+Every component is recorded in the scaffold history, and can be reverted with
+"ignite scaffold undo".
+`,
+		Args:    cobra.ExactArgs(1),
+		PreRunE: scaffoldApplyPreRunHandler,
+		RunE:    scaffoldApplyHandler,
+	}
+
+	flagSetPath(c)
+	flagSetClearCache(c)
+
+	c.Flags().AddFlagSet(flagSetYes())
+
+	return c
+}
+
+func scaffoldApplyPreRunHandler(cmd *cobra.Command, _ []string) error {
+	// A blueprint can scaffold many modules and components at once,
+	// so confirm before applying it to an app with uncommitted changes.
+	if !getYes(cmd) {
+		session := cliui.New()
+		err := confirmWhenUncommittedChanges(session, flagGetPath(cmd))
+		session.End()
+		if err != nil {
+			return err
+		}
+	}
+
+	return appMigrationPreRunHandler(cmd)
+}
+
+func scaffoldApplyHandler(cmd *cobra.Command, args []string) error {
+	appPath := flagGetPath(cmd)
+
+	session := cliui.New(
+		cliui.StartSpinnerWithText(statusScaffolding),
+		cliui.WithoutUserInteraction(getYes(cmd)),
+	)
+	defer session.End()
+
+	bp, err := scaffolder.ParseBlueprintFile(args[0])
+	if err != nil {
+		return err
+	}
+
+	cfg, _, err := getChainConfig(cmd)
+	if err != nil {
+		return err
+	}
+
+	cacheStorage, err := newCache(cmd)
+	if err != nil {
+		return err
+	}
+
+	sc, err := scaffolder.New(cmd.Context(), appPath, cfg.Build.Proto.Path)
+	if err != nil {
+		return err
+	}
+
+	sm, skipped, err := sc.ApplyBlueprint(cmd.Context(), *bp, xgenny.ApplyPreRun(scaffolder.AskOverwriteFiles(session)))
+	if err != nil {
+		return err
+	}
+
+	if err := sc.PostScaffold(cmd.Context(), cacheStorage, false); err != nil {
+		return err
+	}
+
+	modificationsStr, err := sm.String()
+	if err != nil {
+		return err
+	}
+
+	session.Println(modificationsStr)
+	for _, component := range skipped {
+		session.Printf("Skipped the existing %s.\n", component)
+	}
+	session.Printf("\n🎉 Blueprint %s applied.\n\n", args[0])
+
+	return nil
+}
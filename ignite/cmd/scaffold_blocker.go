@@ -0,0 +1,92 @@
+package ignitecmd
+
+import (
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/v29/ignite/pkg/cache"
+	"github.com/ignite/cli/v29/ignite/pkg/cliui"
+	"github.com/ignite/cli/v29/ignite/pkg/xgenny"
+	"github.com/ignite/cli/v29/ignite/services/scaffolder"
+	moduleblocker "github.com/ignite/cli/v29/ignite/templates/module/blocker"
+)
+
+// NewScaffoldBlocker returns the command to scaffold a module blocker.
+func NewScaffoldBlocker() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "blocker [" + strings.Join(moduleblocker.Kinds(), "|") + "]",
+		Short: "Begin, end or pre blocker of a module",
+		Long: `Scaffold a begin, end or pre blocker for an existing module.
+
+The module is declared as implementing the blocker interface and its blocker
+method calls a new keeper method, where the logic of the blocker goes:
+
+	ignite scaffold blocker end --module blog
+
+The command above creates "x/blog/keeper/end_blocker.go" with an "EndBlocker"
+keeper method called at the end of every block.
+
+Pre blockers are only run for modules listed in the "PreBlockers" of the app
+configuration, so the module is also added to "app/app_config.go".
+`,
+		Args:      cobra.ExactArgs(1),
+		ValidArgs: moduleblocker.Kinds(),
+		PreRunE:   migrationPreRunHandler,
+		RunE:      scaffoldBlockerHandler,
+	}
+
+	flagSetPath(c)
+
+	c.Flags().AddFlagSet(flagSetYes())
+	c.Flags().String(flagModule, "", "module to add the blocker into. Default: app's main module")
+
+	return c
+}
+
+func scaffoldBlockerHandler(cmd *cobra.Command, args []string) error {
+	var (
+		kind    = args[0]
+		module  = flagGetModule(cmd)
+		appPath = flagGetPath(cmd)
+	)
+
+	session := cliui.New(
+		cliui.StartSpinnerWithText(statusScaffolding),
+		cliui.WithoutUserInteraction(getYes(cmd)),
+	)
+	defer session.End()
+
+	cfg, _, err := getChainConfig(cmd)
+	if err != nil {
+		return err
+	}
+
+	sc, err := scaffolder.New(cmd.Context(), appPath, cfg.Build.Proto.Path)
+	if err != nil {
+		return err
+	}
+
+	if err := sc.CreateBlocker(module, kind); err != nil {
+		return err
+	}
+
+	sm, err := sc.ApplyModifications(xgenny.ApplyPreRun(scaffolder.AskOverwriteFiles(session)))
+	if err != nil {
+		return err
+	}
+
+	if err := sc.PostScaffold(cmd.Context(), cache.Storage{}, true); err != nil {
+		return err
+	}
+
+	modificationsStr, err := sm.String()
+	if err != nil {
+		return err
+	}
+
+	session.Println(modificationsStr)
+	session.Printf("\n🎉 Blocker `%[1]v` added.\n\n", kind)
+
+	return nil
+}
@@ -0,0 +1,100 @@
+package ignitecmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/v29/ignite/pkg/cache"
+	"github.com/ignite/cli/v29/ignite/pkg/cliui"
+	"github.com/ignite/cli/v29/ignite/pkg/xgenny"
+	"github.com/ignite/cli/v29/ignite/services/scaffolder"
+)
+
+const flagReplaceIn = "replace-in"
+
+// NewScaffoldError returns the command to scaffold module errors.
+func NewScaffoldError() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "error [name] [description]",
+		Short: "Sentinel error registered by a module",
+		Long: `Register a new sentinel error in the "x/<module>/types/errors.go" file of a module.
+
+The error is registered with the next free error code of the module, so codes
+never have to be picked manually:
+
+	ignite scaffold error post-not-found "post not found" --module blog
+
+The command above registers an "ErrPostNotFound" error in the "blog" module.
+
+Handlers scaffolded by Ignite return the generic "sdkerrors.ErrInvalidRequest"
+error. Use the "--replace-in" flag to return the new error instead in a keeper
+handler:
+
+	ignite scaffold error title-taken "title already taken" --replace-in CreatePost
+`,
+		Args:    cobra.ExactArgs(2),
+		PreRunE: migrationPreRunHandler,
+		RunE:    scaffoldErrorHandler,
+	}
+
+	flagSetPath(c)
+
+	c.Flags().AddFlagSet(flagSetYes())
+	c.Flags().String(flagModule, "", "module to add the error into. Default: app's main module")
+	c.Flags().String(flagReplaceIn, "", "keeper handler returning the error instead of sdkerrors.ErrInvalidRequest")
+
+	return c
+}
+
+func scaffoldErrorHandler(cmd *cobra.Command, args []string) error {
+	var (
+		errorName    = args[0]
+		description  = args[1]
+		module       = flagGetModule(cmd)
+		replaceIn, _ = cmd.Flags().GetString(flagReplaceIn)
+		appPath      = flagGetPath(cmd)
+	)
+
+	session := cliui.New(
+		cliui.StartSpinnerWithText(statusScaffolding),
+		cliui.WithoutUserInteraction(getYes(cmd)),
+	)
+	defer session.End()
+
+	cfg, _, err := getChainConfig(cmd)
+	if err != nil {
+		return err
+	}
+
+	sc, err := scaffolder.New(cmd.Context(), appPath, cfg.Build.Proto.Path)
+	if err != nil {
+		return err
+	}
+
+	var options []scaffolder.ErrorOption
+	if replaceIn != "" {
+		options = append(options, scaffolder.ErrorWithReplaceIn(replaceIn))
+	}
+
+	if err := sc.AddError(module, errorName, description, options...); err != nil {
+		return err
+	}
+
+	sm, err := sc.ApplyModifications(xgenny.ApplyPreRun(scaffolder.AskOverwriteFiles(session)))
+	if err != nil {
+		return err
+	}
+
+	if err := sc.PostScaffold(cmd.Context(), cache.Storage{}, true); err != nil {
+		return err
+	}
+
+	modificationsStr, err := sm.String()
+	if err != nil {
+		return err
+	}
+
+	session.Println(modificationsStr)
+	session.Printf("\n🎉 Error `%[1]v` added.\n\n", errorName)
+
+	return nil
+}
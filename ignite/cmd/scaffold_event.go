@@ -0,0 +1,101 @@
+package ignitecmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/v29/ignite/pkg/cliui"
+	"github.com/ignite/cli/v29/ignite/pkg/xgenny"
+	"github.com/ignite/cli/v29/ignite/services/scaffolder"
+)
+
+// NewScaffoldEvent returns the command to scaffold typed events.
+func NewScaffoldEvent() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "event [name] [field1:type1] [field2:type2] ...",
+		Short: "Typed event emitted by a module",
+		Long: `Event scaffolding adds a typed event to a module.
+
+Typed events are proto messages emitted through the event manager of the SDK
+context. Unlike the generic "message" event, their attributes describe exactly
+what happened in the module, which makes them easy to consume for indexers and
+clients subscribing to the chain:
+
+	ignite scaffold event pool-created id:uint amount:coins --module dex
+
+The command above will create a new proto message EventPoolCreated with two
+fields: id (an unsigned integer) and amount (in tokens). A "NewEventPoolCreated"
+constructor is added to the module's "types" package and an
+"EmitPoolCreatedEvent" function is added to the keeper. Call it from your
+message handlers to emit the event:
+
+	if err := k.EmitPoolCreatedEvent(ctx, types.NewEventPoolCreated(id, amount)); err != nil {
+		return nil, err
+	}
+
+Event scaffolding supports fields with standard and custom types. See
+"ignite scaffold list --help" for details.
+`,
+		Args:    cobra.MinimumNArgs(1),
+		PreRunE: migrationPreRunHandler,
+		RunE:    eventHandler,
+	}
+
+	flagSetPath(c)
+	flagSetClearCache(c)
+
+	c.Flags().AddFlagSet(flagSetYes())
+	c.Flags().String(flagModule, "", "module to add the event into. Default: app's main module")
+
+	return c
+}
+
+func eventHandler(cmd *cobra.Command, args []string) error {
+	var (
+		module  = flagGetModule(cmd)
+		appPath = flagGetPath(cmd)
+	)
+
+	session := cliui.New(
+		cliui.StartSpinnerWithText(statusScaffolding),
+		cliui.WithoutUserInteraction(getYes(cmd)),
+	)
+	defer session.End()
+
+	cfg, _, err := getChainConfig(cmd)
+	if err != nil {
+		return err
+	}
+
+	cacheStorage, err := newCache(cmd)
+	if err != nil {
+		return err
+	}
+
+	sc, err := scaffolder.New(cmd.Context(), appPath, cfg.Build.Proto.Path)
+	if err != nil {
+		return err
+	}
+
+	if err := sc.AddEvent(cmd.Context(), module, args[0], args[1:]); err != nil {
+		return err
+	}
+
+	sm, err := sc.ApplyModifications(xgenny.ApplyPreRun(scaffolder.AskOverwriteFiles(session)))
+	if err != nil {
+		return err
+	}
+
+	if err := sc.PostScaffold(cmd.Context(), cacheStorage, false); err != nil {
+		return err
+	}
+
+	modificationsStr, err := sm.String()
+	if err != nil {
+		return err
+	}
+
+	session.Println(modificationsStr)
+	session.Printf("\n🎉 Created an event `%[1]v`.\n\n", args[0])
+
+	return nil
+}
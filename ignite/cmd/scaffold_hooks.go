@@ -0,0 +1,92 @@
+package ignitecmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/v29/ignite/pkg/cache"
+	"github.com/ignite/cli/v29/ignite/pkg/cliui"
+	"github.com/ignite/cli/v29/ignite/pkg/xgenny"
+	"github.com/ignite/cli/v29/ignite/services/scaffolder"
+)
+
+// NewScaffoldHooks returns the command to scaffold module hooks.
+func NewScaffoldHooks() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "hooks",
+		Short: "Hooks other modules can subscribe to",
+		Long: `Scaffold hooks for an existing module, so other modules can react to its events.
+
+The command creates a "Hooks" interface and a "MultiHooks" type calling every
+subscriber in "x/<module>/types/hooks.go", adds a "SetHooks" method to the
+keeper and wires the subscribers with depinject:
+
+	ignite scaffold hooks --module blog
+
+Add methods to the "Hooks" interface and call them from the keeper with
+"k.Hooks()". A module subscribes to the hooks by returning a
+"blogtypes.HooksWrapper" from its "ProvideModule" function:
+
+	type ModuleOutputs struct {
+		depinject.Out
+
+		BlogHooks blogtypes.HooksWrapper
+	}
+`,
+		Args:    cobra.NoArgs,
+		PreRunE: migrationPreRunHandler,
+		RunE:    scaffoldHooksHandler,
+	}
+
+	flagSetPath(c)
+
+	c.Flags().AddFlagSet(flagSetYes())
+	c.Flags().String(flagModule, "", "module to add the hooks into. Default: app's main module")
+
+	return c
+}
+
+func scaffoldHooksHandler(cmd *cobra.Command, _ []string) error {
+	var (
+		module  = flagGetModule(cmd)
+		appPath = flagGetPath(cmd)
+	)
+
+	session := cliui.New(
+		cliui.StartSpinnerWithText(statusScaffolding),
+		cliui.WithoutUserInteraction(getYes(cmd)),
+	)
+	defer session.End()
+
+	cfg, _, err := getChainConfig(cmd)
+	if err != nil {
+		return err
+	}
+
+	sc, err := scaffolder.New(cmd.Context(), appPath, cfg.Build.Proto.Path)
+	if err != nil {
+		return err
+	}
+
+	if err := sc.CreateHooks(module); err != nil {
+		return err
+	}
+
+	sm, err := sc.ApplyModifications(xgenny.ApplyPreRun(scaffolder.AskOverwriteFiles(session)))
+	if err != nil {
+		return err
+	}
+
+	if err := sc.PostScaffold(cmd.Context(), cache.Storage{}, true); err != nil {
+		return err
+	}
+
+	modificationsStr, err := sm.String()
+	if err != nil {
+		return err
+	}
+
+	session.Println(modificationsStr)
+	session.Printf("\n🎉 Hooks added.\n\n")
+
+	return nil
+}
@@ -92,6 +92,20 @@ like to scaffold messages manually. Use a flag to skip message scaffolding:
 
 The "creator" field is not generated if a list is scaffolded with the
 "--no-message" flag.
+
+Fields can be indexed by secondary indexes to query the list by their values.
+Append ":unique" to a field to enforce that no two values share it:
+
+	ignite scaffold list post title body --secondary-index creator,title:unique
+
+	blogd q blog list-post-by-creator cosmos1...
+	blogd q blog get-post-by-title "My first post"
+
+Use the "--events" flag to emit typed events (EventPostCreated,
+EventPostUpdated and EventPostDeleted) from the message handlers, so indexers
+can follow the changes made to the list:
+
+	ignite scaffold list post title body --events
 `,
 		Args:    cobra.MinimumNArgs(1),
 		PreRunE: migrationPreRunHandler,
@@ -103,6 +117,8 @@ The "creator" field is not generated if a list is scaffolded with the
 
 	c.Flags().AddFlagSet(flagSetYes())
 	c.Flags().AddFlagSet(flagSetScaffoldType())
+	c.Flags().AddFlagSet(flagSetEvents())
+	c.Flags().AddFlagSet(flagSetSecondaryIndex())
 
 	return c
 }
@@ -42,9 +42,24 @@ for the "hello" key.
 
 By default, the index is called "index", to customize the index, use the "--index" flag.
 
+The index can be composed by up to three fields separated by commas. The values
+are then stored under a composite key and can also be listed by their first
+index:
+
+	ignite scaffold map balance amount:uint --index owner:address,denom:string
+
+	blogd tx blog create-balance [owner] [denom] [amount]
+	blogd q blog show-balance cosmos1... token
+	blogd q blog list-balance-by-owner cosmos1...
+
 Since the behavior of "list" and "map" scaffolding is very similar, you can use
-the "--no-message", "--module", "--signer" flags as well as the colon syntax for
-custom types.
+the "--no-message", "--module", "--signer" and "--events" flags as well as the
+colon syntax for custom types.
+
+Fields of the value can be indexed by secondary indexes with the
+"--secondary-index" flag, like with "ignite scaffold list":
+
+	ignite scaffold map post title body --secondary-index title:unique
 
 For detailed type information use ignite scaffold type --help
 `,
@@ -58,7 +73,9 @@ For detailed type information use ignite scaffold type --help
 
 	c.Flags().AddFlagSet(flagSetYes())
 	c.Flags().AddFlagSet(flagSetScaffoldType())
-	c.Flags().String(FlagIndexName, "index", "field that index the value")
+	c.Flags().AddFlagSet(flagSetEvents())
+	c.Flags().AddFlagSet(flagSetSecondaryIndex())
+	c.Flags().String(FlagIndexName, "index", "comma-separated fields that index the value (up to 3)")
 
 	return c
 }
@@ -0,0 +1,125 @@
+package ignitecmd
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/v29/ignite/pkg/cliui"
+	"github.com/ignite/cli/v29/ignite/pkg/xgenny"
+	"github.com/ignite/cli/v29/ignite/services/scaffolder"
+	"github.com/ignite/cli/v29/ignite/templates/remove"
+)
+
+// NewScaffoldRemove returns the command to remove scaffolded components.
+func NewScaffoldRemove() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "remove [command]",
+		Short: "Remove a type, message or query from a module",
+		Long: `Remove a component scaffolded with the "list", "map", "single", "type",
+"message" or "query" commands from a module.
+
+The proto definitions of the component are removed from the proto files of the
+module, the files created for the component are deleted, and the code wiring
+it into the keeper, the genesis, the simulation, the AutoCLI options and the
+codec of the module is removed. The Go code is then generated again from the
+proto files:
+
+	ignite scaffold remove type post
+	ignite scaffold remove message like-post --module blog
+	ignite scaffold remove query count-posts
+
+A type can't be removed while other messages of the module use it. Changes made
+to the removed code are lost, use "ignite scaffold undo" to revert the removal.
+`,
+		Args: cobra.ExactArgs(1),
+	}
+
+	c.AddCommand(
+		newScaffoldRemoveComponent(remove.KindType, "Remove a list, map, single or type"),
+		newScaffoldRemoveComponent(remove.KindMessage, "Remove a message"),
+		newScaffoldRemoveComponent(remove.KindQuery, "Remove a query"),
+	)
+
+	return c
+}
+
+func newScaffoldRemoveComponent(kind remove.Kind, short string) *cobra.Command {
+	c := &cobra.Command{
+		Use:     string(kind) + " NAME",
+		Short:   short,
+		Args:    cobra.ExactArgs(1),
+		PreRunE: migrationPreRunHandler,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return scaffoldRemoveHandler(cmd, kind, args[0])
+		},
+	}
+
+	flagSetPath(c)
+	flagSetClearCache(c)
+
+	c.Flags().AddFlagSet(flagSetYes())
+	c.Flags().String(flagModule, "", "module to remove the "+string(kind)+" from. Default: app's main module")
+
+	return c
+}
+
+func scaffoldRemoveHandler(cmd *cobra.Command, kind remove.Kind, name string) error {
+	var (
+		module  = flagGetModule(cmd)
+		appPath = flagGetPath(cmd)
+	)
+
+	session := cliui.New(
+		cliui.StartSpinnerWithText(statusScaffolding),
+		cliui.WithoutUserInteraction(getYes(cmd)),
+	)
+	defer session.End()
+
+	cfg, _, err := getChainConfig(cmd)
+	if err != nil {
+		return err
+	}
+
+	cacheStorage, err := newCache(cmd)
+	if err != nil {
+		return err
+	}
+
+	sc, err := scaffolder.New(cmd.Context(), appPath, cfg.Build.Proto.Path)
+	if err != nil {
+		return err
+	}
+
+	var removeComponent func(context.Context, string, string) error
+	switch kind {
+	case remove.KindType:
+		removeComponent = sc.RemoveType
+	case remove.KindMessage:
+		removeComponent = sc.RemoveMessage
+	case remove.KindQuery:
+		removeComponent = sc.RemoveQuery
+	}
+	if err := removeComponent(cmd.Context(), module, name); err != nil {
+		return err
+	}
+
+	sm, err := sc.ApplyModifications(xgenny.ApplyPreRun(scaffolder.AskOverwriteFiles(session)))
+	if err != nil {
+		return err
+	}
+
+	if err := sc.PostScaffold(cmd.Context(), cacheStorage, false); err != nil {
+		return err
+	}
+
+	modificationsStr, err := sm.String()
+	if err != nil {
+		return err
+	}
+
+	session.Println(modificationsStr)
+	session.Printf("\n🎉 Removed %s `%s`.\n\n", kind, name)
+
+	return nil
+}
@@ -25,6 +25,7 @@ For detailed type information use ignite scaffold type --help.`,
 
 	c.Flags().AddFlagSet(flagSetYes())
 	c.Flags().AddFlagSet(flagSetScaffoldType())
+	c.Flags().AddFlagSet(flagSetEvents())
 
 	return c
 }
@@ -0,0 +1,148 @@
+package ignitecmd
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/v29/ignite/pkg/cliui"
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/services/scaffolder"
+)
+
+const (
+	flagSteps = "steps"
+	flagList  = "list"
+)
+
+var historyHeader = []string{"id", "date", "command", "created files", "modified files", "deleted files"}
+
+// NewScaffoldUndo returns the command to revert the latest scaffold operations.
+func NewScaffoldUndo() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "undo",
+		Short: "Revert the latest scaffold operations",
+		Long: `Revert the latest scaffold operations without relying on a version control system.
+
+Every scaffold command records the files it creates, and the original content of
+the files it modifies or deletes, in the "` + scaffolder.HistoryDir + `" folder of the app.
+The undo command restores the modified and deleted files and removes the created ones:
+
+	ignite scaffold undo
+
+Use the "--steps" flag to revert more than the latest operation, and the "--list"
+flag to show the recorded operations without reverting them:
+
+	ignite scaffold undo --list
+	ignite scaffold undo --steps 2
+
+Changes made to the files after the scaffold operations are lost when they are
+reverted.
+`,
+		Args: cobra.NoArgs,
+		RunE: scaffoldUndoHandler,
+	}
+
+	flagSetPath(c)
+
+	c.Flags().AddFlagSet(flagSetYes())
+	c.Flags().Int(flagSteps, 1, "number of scaffold operations to revert")
+	c.Flags().Bool(flagList, false, "list the recorded scaffold operations without reverting them")
+
+	return c
+}
+
+func scaffoldUndoHandler(cmd *cobra.Command, _ []string) error {
+	var (
+		steps, _ = cmd.Flags().GetInt(flagSteps)
+		list, _  = cmd.Flags().GetBool(flagList)
+		appPath  = flagGetPath(cmd)
+	)
+
+	session := cliui.New(
+		cliui.StartSpinnerWithText(statusScaffolding),
+		cliui.WithoutUserInteraction(getYes(cmd)),
+	)
+	defer session.End()
+
+	cfg, _, err := getChainConfig(cmd)
+	if err != nil {
+		return err
+	}
+
+	sc, err := scaffolder.New(cmd.Context(), appPath, cfg.Build.Proto.Path)
+	if err != nil {
+		return err
+	}
+
+	entries, err := sc.History()
+	if err != nil {
+		return err
+	}
+
+	if list {
+		session.StopSpinner()
+		if len(entries) == 0 {
+			return session.Println("No scaffold operations recorded.")
+		}
+		return printHistory(session, entries)
+	}
+
+	if len(entries) < steps {
+		return errors.Errorf("cannot undo %d scaffold operations, %d recorded", steps, len(entries))
+	}
+
+	session.StopSpinner()
+	if err := printHistory(session, entries[len(entries)-steps:]); err != nil {
+		return err
+	}
+	if err := session.AskConfirm("Do you want to revert these scaffold operations"); err != nil {
+		if errors.Is(err, cliui.ErrAbort) {
+			return errors.New("No")
+		}
+		return err
+	}
+	session.StartSpinner("Reverting...")
+
+	reverted, err := sc.Undo(steps)
+	if err != nil {
+		return err
+	}
+
+	// the code generated from the proto files must be generated again from the restored files.
+	skipProto := true
+	for _, entry := range reverted {
+		if entry.ChangesProto() {
+			skipProto = false
+		}
+	}
+
+	cacheStorage, err := newCache(cmd)
+	if err != nil {
+		return err
+	}
+
+	if err := sc.PostScaffold(cmd.Context(), cacheStorage, skipProto); err != nil {
+		return err
+	}
+
+	session.Printf("\n🎉 %d scaffold operation(s) reverted.\n\n", len(reverted))
+
+	return nil
+}
+
+func printHistory(session *cliui.Session, entries []scaffolder.HistoryEntry) error {
+	rows := make([][]string, 0, len(entries))
+	for _, entry := range entries {
+		rows = append(rows, []string{
+			strconv.Itoa(entry.ID),
+			entry.Time.Local().Format(time.DateTime),
+			entry.Command,
+			strconv.Itoa(len(entry.Created)),
+			strconv.Itoa(len(entry.Modified)),
+			strconv.Itoa(len(entry.Deleted)),
+		})
+	}
+	return session.PrintTable(historyHeader, rows...)
+}
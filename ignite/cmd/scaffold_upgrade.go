@@ -0,0 +1,108 @@
+package ignitecmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ignite/cli/v29/ignite/pkg/cache"
+	"github.com/ignite/cli/v29/ignite/pkg/cliui"
+	"github.com/ignite/cli/v29/ignite/pkg/xgenny"
+	"github.com/ignite/cli/v29/ignite/services/scaffolder"
+)
+
+const (
+	flagAddStore    = "add-store"
+	flagDeleteStore = "delete-store"
+	flagWithTest    = "with-test"
+)
+
+// NewScaffoldUpgrade returns the command to scaffold a chain software upgrade.
+func NewScaffoldUpgrade() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "upgrade [name]",
+		Short: "Chain software upgrade handler",
+		Long: `Scaffold the handler of a chain software upgrade.
+
+This command creates the "app/upgrades/<name>/" package with the upgrade name
+and a handler running the in-place store migrations of the modules, and
+registers the upgrade handler and its store loader in the app.
+
+The stores of the modules added or deleted by the upgrade can be declared with
+flags:
+
+	ignite scaffold upgrade v2 --add-store blog --delete-store crisis
+
+Use the "--with-test" flag to scaffold a test applying the upgrade to the state
+of an exported genesis.`,
+		Args:    cobra.ExactArgs(1),
+		PreRunE: migrationPreRunHandler,
+		RunE:    scaffoldUpgradeHandler,
+	}
+
+	flagSetPath(c)
+	c.Flags().AddFlagSet(flagSetYes())
+	c.Flags().StringSlice(flagAddStore, []string{}, "stores added by the upgrade")
+	c.Flags().StringSlice(flagDeleteStore, []string{}, "stores deleted by the upgrade")
+	c.Flags().Bool(flagWithTest, false, "scaffold a test applying the upgrade to an exported genesis")
+
+	return c
+}
+
+func scaffoldUpgradeHandler(cmd *cobra.Command, args []string) error {
+	var (
+		name            = args[0]
+		appPath         = flagGetPath(cmd)
+		addStores, _    = cmd.Flags().GetStringSlice(flagAddStore)
+		deleteStores, _ = cmd.Flags().GetStringSlice(flagDeleteStore)
+		withTest, _     = cmd.Flags().GetBool(flagWithTest)
+		options         = []scaffolder.UpgradeOption{}
+	)
+
+	if len(addStores) > 0 {
+		options = append(options, scaffolder.UpgradeWithAddedStores(addStores...))
+	}
+	if len(deleteStores) > 0 {
+		options = append(options, scaffolder.UpgradeWithDeletedStores(deleteStores...))
+	}
+	if withTest {
+		options = append(options, scaffolder.UpgradeWithTest())
+	}
+
+	session := cliui.New(
+		cliui.StartSpinnerWithText(statusScaffolding),
+		cliui.WithoutUserInteraction(getYes(cmd)),
+	)
+	defer session.End()
+
+	cfg, _, err := getChainConfig(cmd)
+	if err != nil {
+		return err
+	}
+
+	sc, err := scaffolder.New(cmd.Context(), appPath, cfg.Build.Proto.Path)
+	if err != nil {
+		return err
+	}
+
+	if err := sc.CreateUpgrade(name, options...); err != nil {
+		return err
+	}
+
+	sm, err := sc.ApplyModifications(xgenny.ApplyPreRun(scaffolder.AskOverwriteFiles(session)))
+	if err != nil {
+		return err
+	}
+
+	if err := sc.PostScaffold(cmd.Context(), cache.Storage{}, true); err != nil {
+		return err
+	}
+
+	modificationsStr, err := sm.String()
+	if err != nil {
+		return err
+	}
+
+	session.Println(modificationsStr)
+	session.Printf("\n🎉 Upgrade %s added to the app.\n\n", name)
+
+	return nil
+}
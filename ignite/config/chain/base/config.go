@@ -85,6 +85,83 @@ type Faucet struct {
 
 	// TxFee is the tx fee the faucet needs to pay for each transaction.
 	TxFee string `yaml:"tx_fee,omitempty" doc:"Tx fee the faucet needs to pay for each transaction."`
+
+	// BatchWindow is the time window during which transfer requests are gathered to be sent in a single transaction.
+	BatchWindow string `yaml:"batch_window,omitempty" doc:"Time window to gather transfer requests in a single multi-send transaction (batching is disabled when empty)."`
+
+	// BatchSize is the maximum number of transfer requests sent in a single transaction.
+	BatchSize uint `yaml:"batch_size,omitempty" doc:"Maximum number of transfer requests sent in a single multi-send transaction."`
+
+	// Ledger configures where the faucet records its transfers to enforce the limits.
+	Ledger FaucetLedger `yaml:"ledger,omitempty" doc:"Storage used to record the faucet transfers to enforce the limits."`
+
+	// Challenge configures the challenge that clients must solve to request tokens.
+	Challenge FaucetChallenge `yaml:"challenge,omitempty" doc:"Challenge that clients must solve to request tokens."`
+
+	// IPQuota configures the maximum number of requests per client IP and subnet.
+	IPQuota FaucetIPQuota `yaml:"ip_quota,omitempty" doc:"Maximum number of requests per client IP and subnet."`
+}
+
+// Faucet challenge types.
+const (
+	FaucetChallengePoW     = "pow"
+	FaucetChallengeCaptcha = "captcha"
+)
+
+// FaucetChallenge configures the challenge that clients must solve to request tokens from the faucet.
+type FaucetChallenge struct {
+	// Type is the type of challenge: pow or captcha.
+	Type string `yaml:"type,omitempty" doc:"Type of challenge (pow or captcha)."`
+
+	// Difficulty is the number of leading zero bits required by the proof-of-work challenge.
+	Difficulty uint `yaml:"difficulty,omitempty" doc:"Number of leading zero bits required by the proof-of-work challenge."`
+
+	// TTL is the time to solve a proof-of-work challenge.
+	TTL string `yaml:"ttl,omitempty" doc:"Time to solve a proof-of-work challenge."`
+
+	// VerifyURL is the verification endpoint of the captcha provider.
+	VerifyURL string `yaml:"verify_url,omitempty" doc:"Verification endpoint of the captcha provider."`
+
+	// Secret is the secret key of the captcha provider. Environment variables are expanded.
+	Secret string `yaml:"secret,omitempty" doc:"Secret key of the captcha provider (environment variables are expanded)."`
+}
+
+// FaucetIPQuota configures the maximum number of requests accepted by the faucet per client IP and subnet.
+type FaucetIPQuota struct {
+	// Requests is the maximum number of requests per client IP.
+	Requests uint `yaml:"requests,omitempty" doc:"Maximum number of requests per client IP."`
+
+	// SubnetRequests is the maximum number of requests per client subnet.
+	SubnetRequests uint `yaml:"subnet_requests,omitempty" doc:"Maximum number of requests per client subnet."`
+
+	// IPv4SubnetBits is the prefix length of the IPv4 subnets.
+	IPv4SubnetBits uint `yaml:"ipv4_subnet_bits,omitempty" doc:"Prefix length of the IPv4 subnets (default is 24)."`
+
+	// IPv6SubnetBits is the prefix length of the IPv6 subnets.
+	IPv6SubnetBits uint `yaml:"ipv6_subnet_bits,omitempty" doc:"Prefix length of the IPv6 subnets (default is 64)."`
+
+	// Window is the timeframe of the quotas.
+	Window string `yaml:"window,omitempty" doc:"Timeframe of the quotas (default is 24h)."`
+}
+
+// Faucet ledger types.
+const (
+	FaucetLedgerMemory   = "memory"
+	FaucetLedgerBolt     = "bolt"
+	FaucetLedgerJSON     = "json"
+	FaucetLedgerPostgres = "postgres"
+)
+
+// FaucetLedger configures the storage used by the faucet to record its transfers.
+type FaucetLedger struct {
+	// Type is the type of ledger: memory, bolt, json or postgres.
+	Type string `yaml:"type,omitempty" doc:"Type of the ledger (memory, bolt, json or postgres)."`
+
+	// Path is the path of the ledger file for the bolt and json ledgers.
+	Path string `yaml:"path,omitempty" doc:"Path of the ledger file for the bolt and json ledgers."`
+
+	// DSN is the data source name of the database for the postgres ledger.
+	DSN string `yaml:"dsn,omitempty" doc:"Data source name of the database for the postgres ledger."`
 }
 
 // Init overwrites sdk configurations with given values.
@@ -130,15 +207,16 @@ const (
 
 // Config defines a struct with the fields that are common to all config versions.
 type Config struct {
-	Include      []string        `yaml:"include,omitempty" doc:"Include incorporate a separate config.yml file directly in your current config file."`
-	Validation   Validation      `yaml:"validation,omitempty" doc:"Specifies the type of validation the blockchain uses (e.g., sovereign)."`
-	Version      version.Version `yaml:"version" doc:"Defines the configuration version number."`
-	Build        Build           `yaml:"build,omitempty" doc:"Contains build configuration options."`
-	Accounts     []Account       `yaml:"accounts" doc:"Lists the options for setting up Cosmos Accounts."`
-	Faucet       Faucet          `yaml:"faucet,omitempty" doc:"Configuration for the faucet."`
-	Client       Client          `yaml:"client,omitempty" doc:"Configures client code generation."`
-	Genesis      xyaml.Map       `yaml:"genesis,omitempty" doc:"Custom genesis block modifications. Follow the nesting of the genesis file here to access all the parameters."`
-	DefaultDenom string          `yaml:"default_denom,omitempty" doc:"Default staking denom (default is stake)."`
+	Include      []string             `yaml:"include,omitempty" doc:"Include incorporate a separate config.yml file directly in your current config file."`
+	Validation   Validation           `yaml:"validation,omitempty" doc:"Specifies the type of validation the blockchain uses (e.g., sovereign)."`
+	Version      version.Version      `yaml:"version" doc:"Defines the configuration version number."`
+	Build        Build                `yaml:"build,omitempty" doc:"Contains build configuration options."`
+	Accounts     []Account            `yaml:"accounts" doc:"Lists the options for setting up Cosmos Accounts."`
+	Faucet       Faucet               `yaml:"faucet,omitempty" doc:"Configuration for the faucet."`
+	Client       Client               `yaml:"client,omitempty" doc:"Configures client code generation."`
+	Genesis      xyaml.Map            `yaml:"genesis,omitempty" doc:"Custom genesis block modifications. Follow the nesting of the genesis file here to access all the parameters."`
+	DefaultDenom string               `yaml:"default_denom,omitempty" doc:"Default staking denom (default is stake)."`
+	Profiles     map[string]xyaml.Map `yaml:"profiles,omitempty" doc:"Config overlays deep merged over the config when a profile is selected with --profile."`
 }
 
 // GetVersion returns the config version.
@@ -19,6 +19,23 @@ func (e ValidationError) Error() string {
 	return fmt.Sprintf("config is not valid: %s", e.Message)
 }
 
+// OverlayError is returned when a config overlay of a profile is not valid.
+type OverlayError struct {
+	// Overlay is the name of the overlay that introduced the error.
+	Overlay string
+
+	// Err is the overlay error.
+	Err error
+}
+
+func (e OverlayError) Error() string {
+	return fmt.Sprintf("config overlay '%s': %s", e.Overlay, e.Err)
+}
+
+func (e OverlayError) Unwrap() error {
+	return e.Err
+}
+
 // UnsupportedVersionError is returned when the version of the config is not supported.
 type UnsupportedVersionError struct {
 	Version version.Version
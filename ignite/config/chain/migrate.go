@@ -0,0 +1,197 @@
+package chain
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	v0 "github.com/ignite/cli/v29/ignite/config/chain/v0"
+	v1 "github.com/ignite/cli/v29/ignite/config/chain/v1"
+	"github.com/ignite/cli/v29/ignite/config/chain/version"
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+)
+
+// nodeMigration migrates the root YAML node of a config file to the next version.
+type nodeMigration func(root *yaml.Node) error
+
+// nodeMigrations holds the YAML node migrations of each config version to the next one.
+// A migration must be added here when a new config version is added.
+var nodeMigrations = map[version.Version]nodeMigration{
+	0: migrateNodeV0,
+}
+
+// Migrate migrates a config file to the latest version.
+// Unlike MigrateLatest, only the keys that changed between the config versions are
+// rewritten, which preserves the comments and the order of the other keys.
+// The config file is returned unchanged when it already uses the latest version.
+func Migrate(current []byte) ([]byte, error) {
+	v, err := ReadConfigVersion(bytes.NewReader(current))
+	if err != nil {
+		return nil, err
+	}
+
+	if v == LatestVersion {
+		return current, nil
+	}
+
+	if _, ok := Versions[v]; !ok {
+		return nil, &UnsupportedVersionError{v}
+	}
+
+	var doc yaml.Node
+	if err := yaml.Unmarshal(current, &doc); err != nil {
+		return nil, err
+	}
+
+	if len(doc.Content) == 0 || doc.Content[0].Kind != yaml.MappingNode {
+		return nil, errors.New("config file must be a YAML mapping")
+	}
+
+	root := doc.Content[0]
+	for ; v < LatestVersion; v++ {
+		migrate, ok := nodeMigrations[v]
+		if !ok {
+			return nil, errors.Errorf("config migration from version %s is not supported", v)
+		}
+
+		if err := migrate(root); err != nil {
+			return nil, errors.Errorf("config migration from version %s failed: %w", v, err)
+		}
+	}
+
+	setVersionNode(root, LatestVersion)
+
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(&doc); err != nil {
+		return nil, err
+	}
+
+	// Make sure the migrated config can be decoded with the latest version
+	if _, err := decodeConfig(bytes.NewReader(buf.Bytes()), LatestVersion); err != nil {
+		return nil, errors.Errorf("migrated config is not valid: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// migrateNodeV0 replaces the validator, init and host keys of a version 0 config
+// with the validators key of version 1.
+func migrateNodeV0(root *yaml.Node) error {
+	var c v0.Config
+	if err := root.Decode(&c); err != nil {
+		return err
+	}
+
+	next, err := c.ConvertNext()
+	if err != nil {
+		return err
+	}
+
+	var validators yaml.Node
+	if err := validators.Encode(next.(*v1.Config).Validators); err != nil {
+		return err
+	}
+
+	key := &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: "validators"}
+
+	// The validators key replaces the validator key and keeps its comments
+	i := mappingKeyIndex(root, "validator")
+	if i < 0 {
+		i = len(root.Content)
+	} else {
+		key.HeadComment = root.Content[i].HeadComment
+		key.LineComment = root.Content[i].LineComment
+	}
+
+	// The comments of the init and host keys are kept on the validator keys replacing them
+	validator := validators.Content[0]
+	moveKeyComments(root, "init", validator, key, "home", "client", "app", "config")
+	moveKeyComments(root, "host", validator, key, "app", "config")
+
+	content := make([]*yaml.Node, 0, len(root.Content)+2)
+	content = append(content, root.Content[:i]...)
+	content = append(content, key, &validators)
+	content = append(content, root.Content[i:]...)
+	root.Content = content
+
+	for _, name := range []string{"validator", "init", "host"} {
+		removeMappingKey(root, name)
+	}
+
+	return nil
+}
+
+// moveKeyComments moves the comments of a key of the root mapping to the first of the
+// replacement keys found in the target mapping, or to the fallback key when none is found.
+func moveKeyComments(root *yaml.Node, name string, target, fallback *yaml.Node, replacements ...string) {
+	i := mappingKeyIndex(root, name)
+	if i < 0 {
+		return
+	}
+
+	to := fallback
+	for _, r := range replacements {
+		if j := mappingKeyIndex(target, r); j >= 0 {
+			to = target.Content[j]
+			break
+		}
+	}
+
+	from := root.Content[i]
+	to.HeadComment = joinComments("\n", to.HeadComment, from.HeadComment)
+	to.LineComment = joinComments(" ", to.LineComment, from.LineComment)
+}
+
+func joinComments(sep string, comments ...string) string {
+	var nonEmpty []string
+	for _, c := range comments {
+		if c != "" {
+			nonEmpty = append(nonEmpty, c)
+		}
+	}
+	return strings.Join(nonEmpty, sep)
+}
+
+// setVersionNode sets the version of the config, the key is added first when it doesn't exist.
+func setVersionNode(root *yaml.Node, v version.Version) {
+	value := strconv.FormatUint(uint64(v), 10)
+
+	if i := mappingKeyIndex(root, "version"); i >= 0 {
+		root.Content[i+1].Value = value
+		root.Content[i+1].Tag = "!!int"
+		return
+	}
+
+	key := &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: "version"}
+
+	// Keep the comment at the top of the file before the version key
+	if len(root.Content) > 0 {
+		key.HeadComment = root.Content[0].HeadComment
+		root.Content[0].HeadComment = ""
+	}
+
+	root.Content = append([]*yaml.Node{
+		key,
+		{Kind: yaml.ScalarNode, Tag: "!!int", Value: value},
+	}, root.Content...)
+}
+
+// mappingKeyIndex returns the index of a key in the content of a mapping node or -1 when it doesn't exist.
+func mappingKeyIndex(mapping *yaml.Node, key string) int {
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value == key {
+			return i
+		}
+	}
+	return -1
+}
+
+func removeMappingKey(mapping *yaml.Node, key string) {
+	if i := mappingKeyIndex(mapping, key); i >= 0 {
+		mapping.Content = append(mapping.Content[:i], mapping.Content[i+2:]...)
+	}
+}
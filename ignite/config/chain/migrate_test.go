@@ -0,0 +1,89 @@
+package chain_test
+
+import (
+	"bytes"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	chainconfig "github.com/ignite/cli/v29/ignite/config/chain"
+	"github.com/ignite/cli/v29/ignite/config/testdata"
+)
+
+func TestMigrate(t *testing.T) {
+	// Arrange
+	current := []byte(`# Chain config
+accounts:
+  - name: alice
+    coins: ["100token"]
+# The validator of the chain
+validator:
+  name: alice
+  staked: "100token"
+init:
+  home: "$HOME/.appd"
+host:
+  rpc: ":26659"
+faucet:
+  name: alice # faucet account
+`)
+	want := `# Chain config
+version: 1
+accounts:
+  - name: alice
+    coins: ["100token"]
+# The validator of the chain
+validators:
+  - name: alice
+    bonded: 100token
+    config:
+      rpc:
+        laddr: :26659
+    home: $HOME/.appd
+faucet:
+  name: alice # faucet account
+`
+
+	// Act
+	latest, err := chainconfig.Migrate(current)
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, want, string(latest))
+}
+
+func TestMigrateConvertsLikeParse(t *testing.T) {
+	// Act
+	latest, err := chainconfig.Migrate(testdata.Versions[chainconfig.LatestVersion-1])
+	require.NoError(t, err)
+
+	// Assert
+	cfg, err := chainconfig.Parse(bytes.NewReader(latest))
+	require.NoError(t, err)
+	require.Equal(t, testdata.GetLatestConfig(t), cfg)
+}
+
+func TestMigrateLatestVersion(t *testing.T) {
+	current := testdata.Versions[chainconfig.LatestVersion]
+
+	latest, err := chainconfig.Migrate(current)
+
+	require.NoError(t, err)
+	require.Equal(t, current, latest)
+}
+
+func TestMigrateKeepsHostAndInitComments(t *testing.T) {
+	// Arrange
+	current, err := os.ReadFile("testdata/migrate_comments.yml")
+	require.NoError(t, err)
+	want, err := os.ReadFile("testdata/migrate_comments_migrated.yml")
+	require.NoError(t, err)
+
+	// Act
+	latest, err := chainconfig.Migrate(current)
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, string(want), string(latest))
+}
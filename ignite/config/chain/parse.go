@@ -2,6 +2,7 @@ package chain
 
 import (
 	"bytes"
+	"fmt"
 	"io"
 	"net/http"
 	"net/url"
@@ -14,6 +15,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/types/bech32"
 
+	"github.com/ignite/cli/v29/ignite/config/chain/base"
 	"github.com/ignite/cli/v29/ignite/config/chain/defaults"
 	"github.com/ignite/cli/v29/ignite/config/chain/version"
 	"github.com/ignite/cli/v29/ignite/pkg/errors"
@@ -168,6 +170,34 @@ func validateConfig(c *Config) error {
 		}
 	}
 
+	switch ledger := c.Faucet.Ledger; ledger.Type {
+	case "", base.FaucetLedgerMemory, base.FaucetLedgerBolt, base.FaucetLedgerJSON:
+	case base.FaucetLedgerPostgres:
+		if ledger.DSN == "" {
+			return &ValidationError{"faucet ledger 'dsn' is required for the postgres ledger"}
+		}
+	default:
+		return &ValidationError{fmt.Sprintf("invalid faucet ledger type %q", ledger.Type)}
+	}
+
+	switch challenge := c.Faucet.Challenge; challenge.Type {
+	case "", base.FaucetChallengePoW:
+	case base.FaucetChallengeCaptcha:
+		if challenge.VerifyURL == "" {
+			return &ValidationError{"faucet challenge 'verify_url' is required for the captcha challenge"}
+		}
+	default:
+		return &ValidationError{fmt.Sprintf("invalid faucet challenge type %q", challenge.Type)}
+	}
+
+	if bits := c.Faucet.IPQuota.IPv4SubnetBits; bits > 32 {
+		return &ValidationError{fmt.Sprintf("invalid faucet quota IPv4 subnet bits %d", bits)}
+	}
+
+	if bits := c.Faucet.IPQuota.IPv6SubnetBits; bits > 128 {
+		return &ValidationError{fmt.Sprintf("invalid faucet quota IPv6 subnet bits %d", bits)}
+	}
+
 	return nil
 }
 
@@ -0,0 +1,209 @@
+package chain
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"dario.cat/mergo"
+	"gopkg.in/yaml.v3"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/pkg/xyaml"
+)
+
+// envVarPattern matches the "${NAME}" and "${NAME:-default}" environment variable references.
+var envVarPattern = regexp.MustCompile(`\$\{([A-Za-z_][A-Za-z0-9_]*)(:-([^}]*))?\}`)
+
+// Overlay is a partial config that is deep merged over the base config when a profile is selected.
+type Overlay struct {
+	// Name identifies the overlay in error messages, e.g. "profiles.ci" or "config.ci.yml".
+	Name string
+
+	// Values are the overlay config values.
+	Values xyaml.Map
+}
+
+// ParseFileWithProfile parses a config from a file path and applies the overlays of a profile.
+// The overlays of a profile are the "profiles.<profile>" section of the config file followed
+// by the "config.<profile>.yml" file next to the config file, when they exist.
+// An empty profile parses the config file without applying overlays.
+func ParseFileWithProfile(path, profile string) (*Config, error) {
+	if profile == "" {
+		return ParseFile(path)
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return DefaultChainConfig(), err
+	}
+	defer file.Close()
+
+	cfg, err := parse(file)
+	if err != nil {
+		return cfg, errors.Errorf("error parsing config file: %w", err)
+	}
+
+	overlays, err := ProfileOverlays(cfg, path, profile)
+	if err != nil {
+		return cfg, err
+	}
+
+	return cfg, applyOverlays(cfg, overlays)
+}
+
+// ProfileOverlays returns the overlays of a profile for a config parsed from a file path.
+// An error is returned when the profile doesn't exist.
+func ProfileOverlays(cfg *Config, path, profile string) ([]Overlay, error) {
+	var overlays []Overlay
+
+	if values, ok := cfg.Profiles[profile]; ok {
+		overlays = append(overlays, Overlay{
+			Name:   fmt.Sprintf("profiles.%s", profile),
+			Values: values,
+		})
+	}
+
+	overlayPath := ProfilePath(path, profile)
+	data, err := os.ReadFile(overlayPath)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	if err == nil {
+		var values xyaml.Map
+		if err := yaml.Unmarshal(data, &values); err != nil {
+			return nil, errors.Wrapf(err, "failed to parse config overlay '%s'", filepath.Base(overlayPath))
+		}
+
+		overlays = append(overlays, Overlay{
+			Name:   filepath.Base(overlayPath),
+			Values: values,
+		})
+	}
+
+	if len(overlays) == 0 {
+		return nil, errors.Errorf("config profile '%s' not found", profile)
+	}
+
+	return overlays, nil
+}
+
+// ProfilePath returns the path of the overlay file of a profile for a config file path,
+// e.g. "config.ci.yml" for the "ci" profile and the "config.yml" file.
+func ProfilePath(path, profile string) string {
+	ext := filepath.Ext(path)
+	return fmt.Sprintf("%s.%s%s", strings.TrimSuffix(path, ext), profile, ext)
+}
+
+// applyOverlays deep merges the overlays over the config in order and validates the result.
+// A validation error reports the overlay that introduced the invalid value.
+func applyOverlays(cfg *Config, overlays []Overlay) error {
+	validationErr := validateConfig(cfg)
+
+	var introducedBy string
+	for _, o := range overlays {
+		if err := applyOverlay(cfg, o); err != nil {
+			return err
+		}
+
+		err := validateConfig(cfg)
+		if err != nil && (validationErr == nil || err.Error() != validationErr.Error()) {
+			introducedBy = o.Name
+		}
+		validationErr = err
+	}
+
+	if validationErr == nil {
+		return nil
+	}
+
+	if introducedBy != "" {
+		return &OverlayError{Overlay: introducedBy, Err: validationErr}
+	}
+
+	return validationErr
+}
+
+func applyOverlay(cfg *Config, o Overlay) error {
+	values, err := expandEnv(o.Values)
+	if err != nil {
+		return &OverlayError{Overlay: o.Name, Err: err}
+	}
+
+	data, err := yaml.Marshal(values)
+	if err != nil {
+		return &OverlayError{Overlay: o.Name, Err: err}
+	}
+
+	var overlay Config
+	if err := overlay.Decode(bytes.NewReader(data)); err != nil {
+		return &OverlayError{Overlay: o.Name, Err: err}
+	}
+
+	if overlay.Version != 0 && overlay.Version != cfg.Version {
+		return &OverlayError{
+			Overlay: o.Name,
+			Err:     errors.Errorf("config version '%d' does not match with chain config version '%d'", overlay.Version, cfg.Version),
+		}
+	}
+
+	if err := mergo.Merge(cfg, overlay, mergo.WithOverride); err != nil {
+		return &OverlayError{Overlay: o.Name, Err: err}
+	}
+
+	if err := cfg.SetDefaults(); err != nil {
+		return &OverlayError{Overlay: o.Name, Err: err}
+	}
+
+	return nil
+}
+
+// expandEnv replaces the environment variable references in the string values of an overlay.
+// An error is returned when a referenced variable is not set and has no default value.
+func expandEnv(v interface{}) (interface{}, error) {
+	switch value := v.(type) {
+	case xyaml.Map:
+		return expandEnv(map[string]interface{}(value))
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(value))
+		for k, v := range value {
+			expanded, err := expandEnv(v)
+			if err != nil {
+				return nil, err
+			}
+			m[k] = expanded
+		}
+		return m, nil
+	case []interface{}:
+		s := make([]interface{}, len(value))
+		for i, v := range value {
+			expanded, err := expandEnv(v)
+			if err != nil {
+				return nil, err
+			}
+			s[i] = expanded
+		}
+		return s, nil
+	case string:
+		var err error
+		expanded := envVarPattern.ReplaceAllStringFunc(value, func(ref string) string {
+			match := envVarPattern.FindStringSubmatch(ref)
+			if env, ok := os.LookupEnv(match[1]); ok {
+				return env
+			}
+			if match[2] != "" {
+				return match[3]
+			}
+			if err == nil {
+				err = errors.Errorf("environment variable '%s' is not set", match[1])
+			}
+			return ref
+		})
+		return expanded, err
+	default:
+		return v, nil
+	}
+}
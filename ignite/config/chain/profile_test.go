@@ -0,0 +1,145 @@
+package chain_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	chainconfig "github.com/ignite/cli/v29/ignite/config/chain"
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+)
+
+const profileBaseConfig = `
+version: 1
+accounts:
+  - name: alice
+    coins:
+      - 10000token
+faucet:
+  name: alice
+  coins:
+    - 5token
+validators:
+  - name: alice
+    bonded: 100stake
+profiles:
+  ci:
+    faucet:
+      coins:
+        - ${FAUCET_COINS:-10token}
+  devnet:
+    default_denom: ${DENOM}
+`
+
+func writeProfileConfig(t *testing.T, files map[string]string) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	for name, content := range files {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600))
+	}
+
+	return filepath.Join(dir, "config.yml")
+}
+
+func TestParseFileWithProfile(t *testing.T) {
+	path := writeProfileConfig(t, map[string]string{
+		"config.yml": profileBaseConfig,
+		"config.ci.yml": `
+faucet:
+  name: bob
+accounts:
+  - name: bob
+    coins:
+      - 20token
+`,
+	})
+
+	// Act
+	cfg, err := chainconfig.ParseFileWithProfile(path, "ci")
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, "bob", *cfg.Faucet.Name)
+	require.Equal(t, []string{"10token"}, cfg.Faucet.Coins)
+	require.Len(t, cfg.Accounts, 1)
+	require.Equal(t, "bob", cfg.Accounts[0].Name)
+	require.Equal(t, "alice", cfg.Validators[0].Name)
+}
+
+func TestParseFileWithProfileEnv(t *testing.T) {
+	path := writeProfileConfig(t, map[string]string{"config.yml": profileBaseConfig})
+
+	t.Setenv("FAUCET_COINS", "42token")
+	t.Setenv("DENOM", "utoken")
+
+	cfg, err := chainconfig.ParseFileWithProfile(path, "ci")
+	require.NoError(t, err)
+	require.Equal(t, []string{"42token"}, cfg.Faucet.Coins)
+
+	cfg, err = chainconfig.ParseFileWithProfile(path, "devnet")
+	require.NoError(t, err)
+	require.Equal(t, "utoken", cfg.DefaultDenom)
+}
+
+func TestParseFileWithProfileErrors(t *testing.T) {
+	cases := []struct {
+		name    string
+		files   map[string]string
+		profile string
+		err     string
+	}{
+		{
+			name:    "unknown profile",
+			files:   map[string]string{"config.yml": profileBaseConfig},
+			profile: "mainnet",
+			err:     "config profile 'mainnet' not found",
+		},
+		{
+			name:    "missing environment variable",
+			files:   map[string]string{"config.yml": profileBaseConfig},
+			profile: "devnet",
+			err:     "config overlay 'profiles.devnet': environment variable 'DENOM' is not set",
+		},
+		{
+			name: "invalid overlay value",
+			files: map[string]string{
+				"config.yml": profileBaseConfig,
+				"config.ci.yml": `
+validators:
+  - name: bob
+`,
+			},
+			profile: "ci",
+			err:     "config overlay 'config.ci.yml': config is not valid: validator 'bonded' is required",
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			path := writeProfileConfig(t, tt.files)
+
+			_, err := chainconfig.ParseFileWithProfile(path, tt.profile)
+
+			require.EqualError(t, err, tt.err)
+		})
+	}
+
+	t.Run("validation error type", func(t *testing.T) {
+		path := writeProfileConfig(t, map[string]string{
+			"config.yml":    profileBaseConfig,
+			"config.ci.yml": "faucet:\n  ledger:\n    type: foo\n",
+		})
+
+		_, err := chainconfig.ParseFileWithProfile(path, "ci")
+
+		var overlayErr *chainconfig.OverlayError
+		require.True(t, errors.As(err, &overlayErr))
+		require.Equal(t, "config.ci.yml", overlayErr.Overlay)
+
+		var validationErr *chainconfig.ValidationError
+		require.True(t, errors.As(err, &validationErr))
+	})
+}
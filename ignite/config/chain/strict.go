@@ -0,0 +1,160 @@
+package chain
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/ignite/cli/v29/ignite/config/chain/version"
+	"github.com/ignite/cli/v29/ignite/pkg/clidoc"
+)
+
+// UnknownField is a config key that is not defined by the config version.
+type UnknownField struct {
+	// Path is the path of the key in the config, e.g. "faucet.rate_limit_windw".
+	Path string
+
+	// Line and Column are the position of the key in the config file.
+	Line, Column int
+}
+
+// UnknownFieldsError is returned by the strict parse when the config contains unknown keys.
+type UnknownFieldsError struct {
+	Fields []UnknownField
+}
+
+func (e UnknownFieldsError) Error() string {
+	var sb strings.Builder
+	sb.WriteString("config contains unknown fields:")
+	for _, f := range e.Fields {
+		sb.WriteString(fmt.Sprintf("\n  line %d, column %d: unknown field %q", f.Line, f.Column, f.Path))
+	}
+	return sb.String()
+}
+
+// Schema returns the JSON Schema of a config version.
+func Schema(v version.Version) (*clidoc.Schema, error) {
+	c, ok := Versions[v]
+	if !ok {
+		return nil, &UnsupportedVersionError{v}
+	}
+
+	s := clidoc.GenSchema(reflect.ValueOf(c).Elem().Interface())
+	s.Schema = clidoc.SchemaDraft
+	s.Title = fmt.Sprintf("Ignite chain config %s", v)
+
+	return s, nil
+}
+
+// ParseStrict reads a config file like Parse but rejects the keys that are not
+// defined by the config version instead of ignoring them.
+func ParseStrict(configFile io.Reader) (*Config, error) {
+	data, err := io.ReadAll(configFile)
+	if err != nil {
+		return DefaultChainConfig(), err
+	}
+
+	if err := CheckUnknownFields(bytes.NewReader(data)); err != nil {
+		return DefaultChainConfig(), err
+	}
+
+	return Parse(bytes.NewReader(data))
+}
+
+// ParseFileStrict parses a config from a file path in strict mode.
+func ParseFileStrict(path string) (*Config, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return DefaultChainConfig(), err
+	}
+
+	defer file.Close()
+
+	return ParseStrict(file)
+}
+
+// CheckUnknownFields checks that a config file only contains the keys defined by
+// its config version. An UnknownFieldsError with the position of the unknown keys
+// is returned otherwise.
+func CheckUnknownFields(configFile io.Reader) error {
+	var buf bytes.Buffer
+
+	v, err := ReadConfigVersion(io.TeeReader(configFile, &buf))
+	if err != nil {
+		return err
+	}
+
+	s, err := Schema(v)
+	if err != nil {
+		return err
+	}
+
+	var doc yaml.Node
+	if err := yaml.NewDecoder(&buf).Decode(&doc); err != nil {
+		return err
+	}
+
+	fields := unknownFields(&doc, s, "")
+	if len(fields) > 0 {
+		return &UnknownFieldsError{fields}
+	}
+
+	return nil
+}
+
+func unknownFields(node *yaml.Node, s *clidoc.Schema, path string) (fields []UnknownField) {
+	switch node.Kind {
+	case yaml.DocumentNode:
+		for _, n := range node.Content {
+			fields = append(fields, unknownFields(n, s, path)...)
+		}
+	case yaml.AliasNode:
+		fields = append(fields, unknownFields(node.Alias, s, path)...)
+	case yaml.MappingNode:
+		if s.Type != "object" {
+			return nil
+		}
+
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key, value := node.Content[i], node.Content[i+1]
+
+			// merge keys are resolved by the YAML decoder
+			if key.Tag == "!!merge" {
+				fields = append(fields, unknownFields(value, s, path)...)
+				continue
+			}
+
+			keyPath := key.Value
+			if path != "" {
+				keyPath = path + "." + key.Value
+			}
+
+			p, ok := s.Property(key.Value)
+			if !ok {
+				fields = append(fields, UnknownField{
+					Path:   keyPath,
+					Line:   key.Line,
+					Column: key.Column,
+				})
+				continue
+			}
+
+			fields = append(fields, unknownFields(value, p, keyPath)...)
+		}
+	case yaml.SequenceNode:
+		if s.Items == nil {
+			return nil
+		}
+
+		for i, n := range node.Content {
+			fields = append(fields, unknownFields(n, s.Items, fmt.Sprintf("%s[%d]", path, i))...)
+		}
+	}
+
+	return fields
+}
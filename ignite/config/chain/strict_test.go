@@ -0,0 +1,96 @@
+package chain_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	chainconfig "github.com/ignite/cli/v29/ignite/config/chain"
+	"github.com/ignite/cli/v29/ignite/config/testdata"
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+)
+
+func TestParseStrict(t *testing.T) {
+	cases := []struct {
+		name   string
+		config string
+		fields []chainconfig.UnknownField
+	}{
+		{
+			name: "valid config",
+			config: `
+version: 1
+accounts:
+  - name: alice
+    coins: ["100token"]
+faucet:
+  name: alice
+  rate_limit_window: 1h
+validators:
+  - name: alice
+    bonded: 100stake
+    app:
+      any_key: true
+genesis:
+  chain_id: test-1
+`,
+		},
+		{
+			name: "unknown fields",
+			config: `
+version: 1
+accounts:
+  - name: alice
+    coin: ["100token"]
+faucet:
+  name: alice
+  rate_limit_windw: 1h
+validators:
+  - name: alice
+    bonded: 100stake
+`,
+			fields: []chainconfig.UnknownField{
+				{Path: "accounts[0].coin", Line: 5, Column: 5},
+				{Path: "faucet.rate_limit_windw", Line: 8, Column: 3},
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := chainconfig.ParseStrict(strings.NewReader(tt.config))
+
+			if tt.fields == nil {
+				require.NoError(t, err)
+				return
+			}
+
+			var fieldsErr *chainconfig.UnknownFieldsError
+			require.True(t, errors.As(err, &fieldsErr))
+			require.Equal(t, tt.fields, fieldsErr.Fields)
+		})
+	}
+}
+
+func TestParseStrictWithTestdata(t *testing.T) {
+	for v, data := range testdata.Versions {
+		err := chainconfig.CheckUnknownFields(bytes.NewReader(data))
+		require.NoErrorf(t, err, "config %s", v)
+	}
+}
+
+func TestSchema(t *testing.T) {
+	s, err := chainconfig.Schema(chainconfig.LatestVersion)
+	require.NoError(t, err)
+
+	data, err := json.Marshal(s)
+	require.NoError(t, err)
+	require.Contains(t, string(data), `"rate_limit_window"`)
+	require.Contains(t, string(data), `"validators"`)
+
+	_, err = chainconfig.Schema(42)
+	require.Error(t, err)
+}
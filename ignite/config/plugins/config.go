@@ -43,6 +43,15 @@ type Plugin struct {
 	// With holds arguments passed to the plugin interface
 	With map[string]string `yaml:"with,omitempty"`
 
+	// Index holds the location of a release index listing prebuilt binaries of
+	// the plugin, either a URL or a local file path. A relative file path is
+	// resolved from the directory of the config file. When set, it takes
+	// precedence over the releases declared by the plugin repository.
+	// For example:
+	//
+	// index: /mnt/mirror/apps/hermes/index.yml
+	Index string `yaml:"index,omitempty"`
+
 	// Global holds whether the plugin is installed globally
 	// (default: $HOME/.ignite/apps/igniteapps.yml) or locally for a chain.
 	Global bool `yaml:"-"`
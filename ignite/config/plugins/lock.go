@@ -0,0 +1,137 @@
+package plugins
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+)
+
+// LockFilename is the name of the lock file written next to the plugin config file.
+const LockFilename = "igniteapps.lock"
+
+// Lock pins the remote plugins of a config to the commit they were built from.
+type Lock struct {
+	path string
+
+	// Apps holds the locked Ignite Apps.
+	Apps []LockedPlugin `yaml:"apps"`
+}
+
+// LockedPlugin keeps the resolved version of a remote plugin.
+type LockedPlugin struct {
+	// Path holds the location of the plugin as declared in the config,
+	// including the optional `@` reference.
+	Path string `yaml:"path"`
+
+	// Commit holds the hash of the commit the reference resolved to.
+	Commit string `yaml:"commit"`
+
+	// Checksum holds the SHA256 checksum of the plugin source tree at Commit.
+	Checksum string `yaml:"checksum"`
+}
+
+// ParseLock reads the plugin lock file in dir.
+// If the file doesn't exist, an empty lock is returned, w/o errors.
+func ParseLock(dir string) (*Lock, error) {
+	errf := func(err error) error {
+		return errors.Errorf("plugin lock parse: %w", err)
+	}
+	l := Lock{
+		path: filepath.Join(dir, LockFilename),
+	}
+
+	f, err := os.Open(l.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return &l, nil
+		}
+		return nil, errf(err)
+	}
+	defer f.Close()
+
+	if err := yaml.NewDecoder(f).Decode(&l); err != nil && !errors.Is(err, io.EOF) {
+		return nil, errf(err)
+	}
+	return &l, nil
+}
+
+// Path return the path of the lock file.
+func (l Lock) Path() string {
+	return l.path
+}
+
+// Get returns the locked plugin with the given path.
+// Unlike Plugin.HasPath, the reference is part of the comparison, so changing
+// the reference of a plugin in the config invalidates its lock.
+func (l Lock) Get(path string) (LockedPlugin, bool) {
+	i := slices.IndexFunc(l.Apps, func(lp LockedPlugin) bool {
+		return lp.Path == path
+	})
+	if i == -1 {
+		return LockedPlugin{}, false
+	}
+	return l.Apps[i], true
+}
+
+// Set adds or replaces a locked plugin.
+func (l *Lock) Set(lp LockedPlugin) {
+	i := slices.IndexFunc(l.Apps, func(p LockedPlugin) bool {
+		return p.Path == lp.Path
+	})
+	if i == -1 {
+		l.Apps = append(l.Apps, lp)
+	} else {
+		l.Apps[i] = lp
+	}
+	slices.SortFunc(l.Apps, func(a, b LockedPlugin) int {
+		return strings.Compare(a.Path, b.Path)
+	})
+}
+
+// Remove removes the locked plugins with the given path, regardless of version.
+func (l *Lock) Remove(path string) {
+	l.Apps = slices.DeleteFunc(l.Apps, func(lp LockedPlugin) bool {
+		return Plugin{Path: lp.Path}.HasPath(path)
+	})
+}
+
+// Retain removes the locked plugins that are not declared in plugins.
+func (l *Lock) Retain(plugins []Plugin) {
+	l.Apps = slices.DeleteFunc(l.Apps, func(lp LockedPlugin) bool {
+		return !slices.ContainsFunc(plugins, func(p Plugin) bool {
+			return p.Path == lp.Path
+		})
+	})
+}
+
+// Save persists the lock file to disk.
+// The file is removed when the lock is empty.
+func (l *Lock) Save() error {
+	errf := func(err error) error {
+		return errors.Errorf("plugin lock save: %w", err)
+	}
+	if l.path == "" {
+		return errf(errors.New("empty path"))
+	}
+	if len(l.Apps) == 0 {
+		if err := os.Remove(l.path); err != nil && !os.IsNotExist(err) {
+			return errf(err)
+		}
+		return nil
+	}
+	file, err := os.Create(l.path)
+	if err != nil {
+		return errf(err)
+	}
+	defer file.Close()
+	if err := yaml.NewEncoder(file).Encode(l); err != nil {
+		return errf(err)
+	}
+	return nil
+}
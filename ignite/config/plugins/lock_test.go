@@ -0,0 +1,61 @@
+package plugins_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	pluginsconfig "github.com/ignite/cli/v29/ignite/config/plugins"
+)
+
+func TestParseLock(t *testing.T) {
+	dir := t.TempDir()
+
+	// no lock file
+	lock, err := pluginsconfig.ParseLock(dir)
+	require.NoError(t, err)
+	require.Empty(t, lock.Apps)
+	require.Equal(t, filepath.Join(dir, pluginsconfig.LockFilename), lock.Path())
+
+	// invalid lock file
+	require.NoError(t, os.WriteFile(lock.Path(), []byte("not yaml !"), 0o644))
+	_, err = pluginsconfig.ParseLock(dir)
+	require.ErrorContains(t, err, "plugin lock parse")
+}
+
+func TestLockSave(t *testing.T) {
+	dir := t.TempDir()
+	lock, err := pluginsconfig.ParseLock(dir)
+	require.NoError(t, err)
+
+	lock.Set(pluginsconfig.LockedPlugin{Path: "github.com/foo/bar@v1", Commit: "a", Checksum: "1"})
+	lock.Set(pluginsconfig.LockedPlugin{Path: "github.com/foo/baz", Commit: "b", Checksum: "2"})
+	lock.Set(pluginsconfig.LockedPlugin{Path: "github.com/foo/bar@v1", Commit: "c", Checksum: "3"})
+	require.NoError(t, lock.Save())
+
+	lock, err = pluginsconfig.ParseLock(dir)
+	require.NoError(t, err)
+	require.Equal(t, []pluginsconfig.LockedPlugin{
+		{Path: "github.com/foo/bar@v1", Commit: "c", Checksum: "3"},
+		{Path: "github.com/foo/baz", Commit: "b", Checksum: "2"},
+	}, lock.Apps)
+
+	_, ok := lock.Get("github.com/foo/bar@v2")
+	require.False(t, ok)
+	lp, ok := lock.Get("github.com/foo/baz")
+	require.True(t, ok)
+	require.Equal(t, "b", lp.Commit)
+
+	lock.Retain([]pluginsconfig.Plugin{{Path: "github.com/foo/bar@v1"}, {Path: "github.com/foo/qux"}})
+	require.Equal(t, []pluginsconfig.LockedPlugin{
+		{Path: "github.com/foo/bar@v1", Commit: "c", Checksum: "3"},
+	}, lock.Apps)
+
+	// removing all the plugins removes the lock file
+	lock.Remove("github.com/foo/bar")
+	require.Empty(t, lock.Apps)
+	require.NoError(t, lock.Save())
+	require.NoFileExists(t, lock.Path())
+}
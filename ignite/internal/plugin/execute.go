@@ -10,6 +10,7 @@ import (
 	pluginsconfig "github.com/ignite/cli/v29/ignite/config/plugins"
 	"github.com/ignite/cli/v29/ignite/pkg/errors"
 	"github.com/ignite/cli/v29/ignite/services/plugin"
+	"github.com/ignite/cli/v29/ignite/services/plugin/host"
 )
 
 type synchronizedBuffer struct {
@@ -32,7 +33,7 @@ func (b *synchronizedBuffer) String() string {
 }
 
 // Execute starts and executes a plugin, then shutdowns it.
-func Execute(ctx context.Context, path string, args []string, options ...plugin.APIOption) (string, error) {
+func Execute(ctx context.Context, path string, args []string, options ...host.APIOption) (string, error) {
 	var buf synchronizedBuffer
 	plugins, err := plugin.Load(
 		ctx,
@@ -49,7 +50,7 @@ func Execute(ctx context.Context, path string, args []string, options ...plugin.
 	err = plugins[0].Interface.Execute(
 		ctx,
 		&plugin.ExecutedCommand{Args: args},
-		plugin.NewClientAPI(options...),
+		host.NewClientAPI(options...),
 	)
 	if err != nil {
 		// Extract the rpc status message and create a simple error from it.
@@ -9,7 +9,8 @@ import (
 
 	"github.com/stretchr/testify/require"
 
-	"github.com/ignite/cli/v29/ignite/services/plugin"
+	chainconfig "github.com/ignite/cli/v29/ignite/config/chain"
+	"github.com/ignite/cli/v29/ignite/services/plugin/host"
 	"github.com/ignite/cli/v29/ignite/services/plugin/mocks"
 )
 
@@ -30,6 +31,7 @@ func TestPluginExecute(t *testing.T) {
 			pluginPath: "testdata/execute_ok",
 			expectedOutput: `ok args=\[arg1 arg2\] chainid=id appPath=apppath configPath=configpath home=home rpcAddress=rpcPublicAddress
 ok args=\[arg1 arg2\] cliVersion=.* goVersion=.* sdkVersion=.* bufVersion=.* buildDate=.* sourceHash=.* configVersion=.* os=.* arch=.* buildFromSource=.*
+ok args=\[arg1 arg2\] version=1 path=configpath
 `,
 		},
 		{
@@ -53,12 +55,13 @@ ok args=\[arg1 arg2\] cliVersion=.* goVersion=.* sdkVersion=.* bufVersion=.* bui
 			chainer.EXPECT().ConfigPath().Return("configpath").Maybe()
 			chainer.EXPECT().Home().Return("home", nil).Maybe()
 			chainer.EXPECT().RPCPublicAddress().Return("rpcPublicAddress", nil).Maybe()
+			chainer.EXPECT().Config().Return(chainconfig.DefaultChainConfig(), nil).Maybe()
 
 			out, err := Execute(
 				context.Background(),
 				pluginPath,
 				[]string{"arg1", "arg2"},
-				plugin.WithChain(chainer),
+				host.WithChain(chainer),
 			)
 
 			if tt.expectedError != "" {
@@ -38,6 +38,12 @@ func (app) Execute(ctx context.Context, cmd *plugin.ExecutedCommand, api plugin.
 	if err != nil {
 		return errors.Errorf("failed to get ignite info: %w", err)
 	}
+
+	cfg, err := api.GetChainConfig(ctx)
+	if err != nil {
+		return errors.Errorf("failed to get chain config: %w", err)
+	}
+	fmt.Printf("ok args=%s version=%d path=%s\n", cmd.Args, cfg.Version, cfg.Path)
 	return nil
 }
 
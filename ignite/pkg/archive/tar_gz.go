@@ -6,27 +6,91 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"time"
 
 	"github.com/ignite/cli/v29/ignite/pkg/errors"
 )
 
+type archiveOptions struct {
+	reproducible bool
+	modTime      time.Time
+}
+
+// Option configures the archive creation.
+type Option func(*archiveOptions)
+
+// Reproducible makes the archive byte-for-byte reproducible. Entries are named
+// relative to the archived directory, their modification time is set to modTime,
+// owner information is stripped and permissions are normalized.
+// modTime is usually read from SOURCE_DATE_EPOCH.
+func Reproducible(modTime time.Time) Option {
+	return func(o *archiveOptions) {
+		o.reproducible = true
+		o.modTime = modTime.UTC().Truncate(time.Second)
+	}
+}
+
 // CreateArchive creates a tar.gz archive from a list of files.
-func CreateArchive(dir string, buf io.Writer) error {
+func CreateArchive(dir string, buf io.Writer, options ...Option) error {
+	var o archiveOptions
+	for _, apply := range options {
+		apply(&o)
+	}
+
 	// Create new Writers for gzip and tar
 	// These writers are chained. Writing to the tar writer will
 	// write to the gzip writer which in turn will write to
 	// the "buf" writer
 	gw := gzip.NewWriter(buf)
 	defer gw.Close()
+	if o.reproducible {
+		gw.ModTime = o.modTime
+	}
 	tw := tar.NewWriter(gw)
 	defer tw.Close()
 
 	return filepath.WalkDir(dir, func(path string, _ os.DirEntry, _ error) error {
-		return addToArchive(tw, path)
+		if !o.reproducible {
+			return addToArchive(tw, path)
+		}
+
+		name, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		if name == "." {
+			return nil
+		}
+
+		return addToArchive(tw, path, func(hdr *tar.Header) {
+			normalizeHeader(hdr, filepath.ToSlash(name), o.modTime)
+		})
 	})
 }
 
-func addToArchive(tw *tar.Writer, filename string) error {
+// normalizeHeader removes from the header any data that depends on the
+// environment where the archive is created.
+func normalizeHeader(hdr *tar.Header, name string, modTime time.Time) {
+	hdr.Name = name
+	hdr.ModTime = modTime
+	hdr.AccessTime = time.Time{}
+	hdr.ChangeTime = time.Time{}
+	hdr.Uid, hdr.Gid = 0, 0
+	hdr.Uname, hdr.Gname = "", ""
+	hdr.Format = tar.FormatPAX
+
+	switch {
+	case hdr.Typeflag == tar.TypeDir:
+		hdr.Name += "/"
+		hdr.Mode = 0o755
+	case hdr.Mode&0o111 != 0:
+		hdr.Mode = 0o755
+	default:
+		hdr.Mode = 0o644
+	}
+}
+
+func addToArchive(tw *tar.Writer, filename string, edits ...func(*tar.Header)) error {
 	// Open the file which will be written into the archive
 	file, err := os.Open(filename)
 	if err != nil {
@@ -47,6 +111,9 @@ func addToArchive(tw *tar.Writer, filename string) error {
 			return err
 		}
 		hdr.Name = filename
+		for _, edit := range edits {
+			edit(hdr)
+		}
 		if err := tw.WriteHeader(hdr); err != nil {
 			return err
 		}
@@ -64,6 +131,9 @@ func addToArchive(tw *tar.Writer, filename string) error {
 	// not be preserved
 	// https://golang.org/src/archive/tar/common.go?#L626
 	header.Name = filename
+	for _, edit := range edits {
+		edit(header)
+	}
 
 	// Write file header to the tar archive
 	err = tw.WriteHeader(header)
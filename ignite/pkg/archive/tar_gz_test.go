@@ -1,12 +1,19 @@
 package archive
 
 import (
+	"archive/tar"
 	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
 )
 
 func TestCreateArchiveAndExtractArchive(t *testing.T) {
@@ -46,3 +53,41 @@ func TestAddToArchiveReturnsErrorForMissingFile(t *testing.T) {
 	err := addToArchive(nil, filepath.Join(t.TempDir(), "does-not-exist"))
 	require.Error(t, err)
 }
+
+func TestCreateArchiveReproducible(t *testing.T) {
+	modTime := time.Unix(1700000000, 0)
+
+	create := func(fileTime time.Time) []byte {
+		src := t.TempDir()
+		require.NoError(t, os.MkdirAll(filepath.Join(src, "nested"), 0o700))
+		require.NoError(t, os.WriteFile(filepath.Join(src, "a.txt"), []byte("alpha"), 0o600))
+		require.NoError(t, os.WriteFile(filepath.Join(src, "nested", "b"), []byte("beta"), 0o700))
+		require.NoError(t, os.Chtimes(filepath.Join(src, "a.txt"), fileTime, fileTime))
+
+		var buf bytes.Buffer
+		require.NoError(t, CreateArchive(src, &buf, Reproducible(modTime)))
+		return buf.Bytes()
+	}
+
+	first := create(time.Unix(100, 0))
+	second := create(time.Unix(200, 0))
+	require.Equal(t, first, second)
+
+	gr, err := gzip.NewReader(bytes.NewReader(first))
+	require.NoError(t, err)
+	require.Equal(t, modTime.UTC(), gr.ModTime.UTC())
+
+	var names []string
+	tr := tar.NewReader(gr)
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		require.NoError(t, err)
+		require.Equal(t, modTime.UTC(), hdr.ModTime.UTC())
+		require.Zero(t, hdr.Uid)
+		names = append(names, fmt.Sprintf("%s %o", hdr.Name, hdr.Mode))
+	}
+	require.Equal(t, []string{"a.txt 644", "nested/ 755", "nested/b 755"}, names)
+}
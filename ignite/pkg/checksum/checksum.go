@@ -5,8 +5,10 @@ import (
 	"crypto/sha256"
 	"fmt"
 	"io"
+	"io/fs"
 	"os"
 	"path/filepath"
+	"slices"
 
 	"github.com/ignite/cli/v29/ignite/pkg/xexec"
 )
@@ -45,14 +47,9 @@ func Sum(dirPath, outPath string) error {
 	return os.WriteFile(outPath, b.Bytes(), 0o600)
 }
 
-// Binary returns SHA256 hash of executable file, file is searched by name in PATH.
-func Binary(binaryName string) (string, error) {
-	// get binary path
-	binaryPath, err := xexec.ResolveAbsPath(binaryName)
-	if err != nil {
-		return "", err
-	}
-	f, err := os.Open(binaryPath)
+// File returns SHA256 hash of the file at path.
+func File(path string) (string, error) {
+	f, err := os.Open(path)
 	if err != nil {
 		return "", err
 	}
@@ -66,6 +63,64 @@ func Binary(binaryName string) (string, error) {
 	return fmt.Sprintf("%x", h.Sum(nil)), nil
 }
 
+// Dir returns SHA256 hash of the files of a directory tree, including their
+// paths relative to dir. Entries whose name is in skip are ignored.
+func Dir(dir string, skip ...string) (string, error) {
+	h := sha256.New()
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if path == dir {
+			return nil
+		}
+		if slices.Contains(skip, d.Name()) {
+			if d.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if d.IsDir() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+
+		var sum string
+		if d.Type()&fs.ModeSymlink != 0 {
+			target, err := os.Readlink(path)
+			if err != nil {
+				return err
+			}
+			sum = Strings(target)
+		} else if sum, err = File(path); err != nil {
+			return err
+		}
+
+		_, err = fmt.Fprintf(h, "%s  %s\n", sum, filepath.ToSlash(rel))
+		return err
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%x", h.Sum(nil)), nil
+}
+
+// Binary returns SHA256 hash of executable file, file is searched by name in PATH.
+func Binary(binaryName string) (string, error) {
+	// get binary path
+	binaryPath, err := xexec.ResolveAbsPath(binaryName)
+	if err != nil {
+		return "", err
+	}
+
+	return File(binaryPath)
+}
+
 // Strings concatenates all inputs and returns SHA256 hash of them.
 func Strings(inputs ...string) string {
 	h := sha256.New()
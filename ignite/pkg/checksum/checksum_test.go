@@ -31,6 +31,42 @@ func TestSum(t *testing.T) {
 	require.Contains(t, text, "  b.txt\n")
 }
 
+func TestFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "a.txt")
+	require.NoError(t, os.WriteFile(path, []byte("alpha"), 0o600))
+
+	want := sha256.Sum256([]byte("alpha"))
+	got, err := File(path)
+	require.NoError(t, err)
+	require.Equal(t, fmt.Sprintf("%x", want[:]), got)
+
+	_, err = File(filepath.Join(t.TempDir(), "missing"))
+	require.Error(t, err)
+}
+
+func TestDir(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "sub", ".git"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "a.txt"), []byte("alpha"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "sub", "b.txt"), []byte("beta"), 0o600))
+
+	sum, err := Dir(dir, ".git", "app.ign")
+	require.NoError(t, err)
+
+	// skipped entries don't change the checksum
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "sub", ".git", "HEAD"), []byte("ref"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "app.ign"), []byte("binary"), 0o600))
+	got, err := Dir(dir, ".git", "app.ign")
+	require.NoError(t, err)
+	require.Equal(t, sum, got)
+
+	// file paths are part of the checksum
+	require.NoError(t, os.Rename(filepath.Join(dir, "sub", "b.txt"), filepath.Join(dir, "sub", "c.txt")))
+	got, err = Dir(dir, ".git", "app.ign")
+	require.NoError(t, err)
+	require.NotEqual(t, sum, got)
+}
+
 func TestBinary(t *testing.T) {
 	bin := filepath.Join(t.TempDir(), "fake-bin")
 	data := []byte("#!/bin/sh\necho test\n")
@@ -0,0 +1,119 @@
+package clidoc
+
+import (
+	"reflect"
+	"strings"
+)
+
+// SchemaDraft is the JSON Schema draft used by the generated schemas.
+const SchemaDraft = "http://json-schema.org/draft-07/schema#"
+
+// Schema represents a JSON Schema generated from the yaml and doc tags of a struct.
+type Schema struct {
+	Schema               string             `json:"$schema,omitempty"`
+	Title                string             `json:"title,omitempty"`
+	Description          string             `json:"description,omitempty"`
+	Type                 string             `json:"type,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	AdditionalProperties interface{}        `json:"additionalProperties,omitempty"`
+}
+
+// Property returns the schema of an object property and true when the property is allowed.
+// Properties that are not explicitly defined are allowed when the object accepts additional
+// properties, in which case the schema of the additional properties is returned.
+func (s *Schema) Property(name string) (*Schema, bool) {
+	if p, ok := s.Properties[name]; ok {
+		return p, true
+	}
+
+	switch additional := s.AdditionalProperties.(type) {
+	case *Schema:
+		return additional, true
+	case bool:
+		return &Schema{}, additional
+	}
+
+	// Objects without properties accept any property.
+	return &Schema{}, s.Properties == nil
+}
+
+// GenSchema generates a JSON Schema from a struct using the yaml tags of its fields
+// as property names and the doc tags as property descriptions.
+// Structs don't allow additional properties.
+func GenSchema(v interface{}) *Schema {
+	return typeSchema(reflect.TypeOf(v))
+}
+
+func typeSchema(t reflect.Type) *Schema {
+	if t == nil {
+		return &Schema{}
+	}
+
+	switch t.Kind() { //nolint
+	case reflect.Ptr:
+		return typeSchema(t.Elem())
+	case reflect.Struct:
+		s := &Schema{
+			Type:                 "object",
+			Properties:           make(map[string]*Schema),
+			AdditionalProperties: false,
+		}
+		addStructProperties(s, t)
+		return s
+	case reflect.Map:
+		return &Schema{
+			Type:                 "object",
+			AdditionalProperties: typeSchema(t.Elem()),
+		}
+	case reflect.Slice, reflect.Array:
+		return &Schema{
+			Type:  "array",
+			Items: typeSchema(t.Elem()),
+		}
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return &Schema{Type: "integer"}
+	case reflect.Float32, reflect.Float64:
+		return &Schema{Type: "number"}
+	default:
+		// Interfaces accept any value.
+		return &Schema{}
+	}
+}
+
+func addStructProperties(s *Schema, t reflect.Type) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() && !field.Anonymous {
+			continue
+		}
+
+		tags := strings.Split(field.Tag.Get("yaml"), ",")
+		name := tags[0]
+		if name == "-" {
+			continue
+		}
+
+		if len(tags) > 1 && strings.Contains(tags[1], "inline") {
+			ft := field.Type
+			if ft.Kind() == reflect.Ptr {
+				ft = ft.Elem()
+			}
+			addStructProperties(s, ft)
+			continue
+		}
+
+		if name == "" {
+			name = strings.ToLower(field.Name)
+		}
+
+		p := typeSchema(field.Type)
+		p.Description = field.Tag.Get("doc")
+		s.Properties[name] = p
+	}
+}
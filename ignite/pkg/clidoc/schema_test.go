@@ -0,0 +1,62 @@
+package clidoc
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenSchema(t *testing.T) {
+	type config struct {
+		build   `yaml:",inline"`
+		Build   build                  `yaml:"build" doc:"doc of build"`
+		Version uint                   `yaml:"version" doc:"doc of version"`
+		Genesis map[string]interface{} `yaml:"genesis" doc:"doc of genesis"`
+		Skipped string                 `yaml:"-"`
+	}
+
+	// Act
+	s := GenSchema(config{})
+
+	// Assert
+	data, err := json.Marshal(s.Properties["build"].Properties["proto"])
+	require.NoError(t, err)
+	require.JSONEq(t, `{
+		"type": "object",
+		"description": "doc of proto",
+		"additionalProperties": false,
+		"properties": {
+			"path": {"type": "string", "description": "path of proto file"},
+			"third_party_paths": {
+				"type": "array",
+				"description": "doc of third party paths",
+				"items": {"type": "string"}
+			}
+		}
+	}`, string(data))
+
+	require.Equal(t, "object", s.Type)
+	require.Equal(t, "integer", s.Properties["version"].Type)
+	require.Equal(t, "array", s.Properties["build"].Properties["protos"].Type)
+	require.Equal(t, "object", s.Properties["build"].Properties["ptr_proto"].Type)
+	require.NotContains(t, s.Properties, "skipped")
+
+	// the inline struct fields are properties of the parent struct
+	require.Equal(t, "doc of main", s.Properties["main"].Description)
+}
+
+func TestSchemaProperty(t *testing.T) {
+	s := GenSchema(build{})
+
+	p, ok := s.Property("main")
+	require.True(t, ok)
+	require.Equal(t, "string", p.Type)
+
+	_, ok = s.Property("mian")
+	require.False(t, ok)
+
+	m := GenSchema(map[string]interface{}{})
+	_, ok = m.Property("foo")
+	require.True(t, ok)
+}
@@ -17,8 +17,11 @@ import (
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 
 	"github.com/ignite/cli/v29/ignite/pkg/errors"
 )
@@ -41,6 +44,15 @@ const (
 	AccountPrefixCosmos = "cosmos"
 )
 
+const (
+	// SigningAlgoSecp256k1 is the default signing algorithm of accounts.
+	SigningAlgoSecp256k1 = string(hd.Secp256k1Type)
+
+	// SigningAlgoEd25519 is the signing algorithm of ed25519 keys, used to
+	// sign artifacts rather than transactions.
+	SigningAlgoEd25519 = string(hd.Ed25519Type)
+)
+
 // KeyringBackend is the backend for where keys are stored.
 type KeyringBackend string
 
@@ -64,6 +76,7 @@ type Registry struct {
 	keyringBackend     KeyringBackend
 	addressCodec       addresscodec.Codec
 	coinType           uint32
+	signingAlgo        string
 
 	Keyring keyring.Keyring
 }
@@ -101,6 +114,13 @@ func WithCoinType(coinType uint32) Option {
 	}
 }
 
+// WithSigningAlgo sets the signing algorithm of the accounts created by the registry.
+func WithSigningAlgo(algo string) Option {
+	return func(c *Registry) {
+		c.signingAlgo = algo
+	}
+}
+
 // New creates a new registry to manage accounts.
 func New(options ...Option) (Registry, error) {
 	r := Registry{
@@ -109,6 +129,7 @@ func New(options ...Option) (Registry, error) {
 		homePath:           KeyringHome,
 		addressCodec:       address.NewBech32Codec(AccountPrefixCosmos),
 		coinType:           CoinTypeCosmos,
+		signingAlgo:        SigningAlgoSecp256k1,
 	}
 
 	for _, apply := range options {
@@ -120,7 +141,9 @@ func New(options ...Option) (Registry, error) {
 	interfaceRegistry := types.NewInterfaceRegistry()
 	cryptocodec.RegisterInterfaces(interfaceRegistry)
 	cdc := codec.NewProtoCodec(interfaceRegistry)
-	r.Keyring, err = keyring.New(r.keyringServiceName, string(r.keyringBackend), r.homePath, inBuf, cdc)
+	r.Keyring, err = keyring.New(r.keyringServiceName, string(r.keyringBackend), r.homePath, inBuf, cdc, func(o *keyring.Options) {
+		o.SupportedAlgos = keyring.SigningAlgoList{hd.Secp256k1, ed25519Algo{}}
+	})
 	if err != nil {
 		return Registry{}, err
 	}
@@ -342,6 +365,15 @@ func (r Registry) List() ([]Account, error) {
 	return accounts, nil
 }
 
+// Sign signs msg with the key of the account and returns the signature with the public key.
+func (r Registry) Sign(name string, msg []byte) ([]byte, cryptotypes.PubKey, error) {
+	if _, err := r.GetByName(name); err != nil {
+		return nil, nil, err
+	}
+
+	return r.Keyring.Sign(name, msg, signing.SignMode_SIGN_MODE_DIRECT)
+}
+
 // DeleteByName deletes an account by name.
 func (r Registry) DeleteByName(name string) error {
 	err := r.Keyring.Delete(name)
@@ -357,7 +389,25 @@ func (r Registry) hdPath() string {
 
 func (r Registry) algo() (keyring.SignatureAlgo, error) {
 	algos, _ := r.Keyring.SupportedAlgorithms()
-	return keyring.NewSigningAlgoFromString(string(hd.Secp256k1Type), algos)
+	return keyring.NewSigningAlgoFromString(r.signingAlgo, algos)
+}
+
+// ed25519Algo derives ed25519 keys from mnemonics. The key is generated from the
+// secret derived on the account's HD path.
+type ed25519Algo struct{}
+
+func (ed25519Algo) Name() hd.PubKeyType {
+	return hd.Ed25519Type
+}
+
+func (ed25519Algo) Derive() hd.DeriveFn {
+	return hd.Secp256k1.Derive()
+}
+
+func (ed25519Algo) Generate() hd.GenerateFn {
+	return func(bz []byte) cryptotypes.PrivKey {
+		return ed25519.GenPrivKeyFromSecret(bz)
+	}
 }
 
 type AccountDoesNotExistError struct {
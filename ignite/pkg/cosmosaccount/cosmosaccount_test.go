@@ -1,6 +1,7 @@
 package cosmosaccount_test
 
 import (
+	"crypto/ed25519"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -70,3 +71,38 @@ func TestRegistry(t *testing.T) {
 	_, err = registry.GetByAddress(addr)
 	require.ErrorAs(t, err, &expectedErr)
 }
+
+func TestRegistryEd25519(t *testing.T) {
+	registry, err := cosmosaccount.New(
+		cosmosaccount.WithHome(t.TempDir()),
+		cosmosaccount.WithSigningAlgo(cosmosaccount.SigningAlgoEd25519),
+	)
+	require.NoError(t, err)
+
+	account, mnemonic, err := registry.Create(testAccountName)
+	require.NoError(t, err)
+
+	pubKey, err := account.Record.GetPubKey()
+	require.NoError(t, err)
+	require.Equal(t, cosmosaccount.SigningAlgoEd25519, pubKey.Type())
+
+	msg := []byte("release")
+	sig, signPubKey, err := registry.Sign(testAccountName, msg)
+	require.NoError(t, err)
+	require.True(t, signPubKey.Equals(pubKey))
+	require.True(t, ed25519.Verify(pubKey.Bytes(), msg, sig))
+
+	_, _, err = registry.Sign("missing", msg)
+	var accErr *cosmosaccount.AccountDoesNotExistError
+	require.ErrorAs(t, err, &accErr)
+
+	// importing the mnemonic derives the same key.
+	otherRegistry, err := cosmosaccount.New(
+		cosmosaccount.WithHome(t.TempDir()),
+		cosmosaccount.WithSigningAlgo(cosmosaccount.SigningAlgoEd25519),
+	)
+	require.NoError(t, err)
+	imported, err := otherRegistry.Import(testAccountName, mnemonic, "")
+	require.NoError(t, err)
+	require.Equal(t, account.Record.PubKey, imported.Record.PubKey)
+}
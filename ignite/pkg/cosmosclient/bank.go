@@ -39,3 +39,24 @@ func (c Client) BankSendTx(ctx context.Context, fromAccount cosmosaccount.Accoun
 
 	return c.CreateTx(ctx, fromAccount, msg)
 }
+
+// BankMultiSendTx creates a transaction that sends coins from fromAccount to multiple
+// recipients in a single bank multi-send message.
+func (c Client) BankMultiSendTx(ctx context.Context, fromAccount cosmosaccount.Account, outputs []banktypes.Output) (TxService, error) {
+	addr, err := fromAccount.Address(c.bech32Prefix)
+	if err != nil {
+		return TxService{}, err
+	}
+
+	total := sdk.NewCoins()
+	for _, o := range outputs {
+		total = total.Add(o.Coins...)
+	}
+
+	msg := &banktypes.MsgMultiSend{
+		Inputs:  []banktypes.Input{{Address: addr, Coins: total}},
+		Outputs: outputs,
+	}
+
+	return c.CreateTx(ctx, fromAccount, msg)
+}
@@ -35,6 +35,7 @@ import (
 	rpcclient "github.com/cometbft/cometbft/rpc/client"
 	rpchttp "github.com/cometbft/cometbft/rpc/client/http"
 	ctypes "github.com/cometbft/cometbft/rpc/core/types"
+	tmtypes "github.com/cometbft/cometbft/types"
 
 	"github.com/ignite/cli/v29/ignite/pkg/cosmosaccount"
 	"github.com/ignite/cli/v29/ignite/pkg/cosmosfaucet"
@@ -69,6 +70,8 @@ const (
 	searchHeight = "tx.height"
 
 	orderAsc = "asc"
+
+	blockSubscriber = "ignite-cosmosclient"
 )
 
 // FaucetClient allows to mock the cosmosfaucet.Client.
@@ -380,6 +383,33 @@ func (c Client) LatestBlockHeight(ctx context.Context) (int64, error) {
 	return resp.SyncInfo.LatestBlockHeight, nil
 }
 
+// AverageBlockTime returns the average time between blocks, computed over the last
+// n blocks from the time of the latest block and of the block committed n blocks before.
+// The window is limited to the blocks available on the node, which might be pruned.
+func (c Client) AverageBlockTime(ctx context.Context, n int64) (time.Duration, error) {
+	status, err := c.Status(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	var (
+		latest   = status.SyncInfo.LatestBlockHeight
+		earliest = max(status.SyncInfo.EarliestBlockHeight, 1)
+	)
+	n = min(n, latest-earliest)
+	if n < 1 {
+		return 0, errors.Errorf("not enough blocks to compute the block time at height %d", latest)
+	}
+
+	height := latest - n
+	r, err := c.RPC.Block(ctx, &height)
+	if err != nil {
+		return 0, errors.Errorf("failed to fetch block %d: %w", height, err)
+	}
+
+	return status.SyncInfo.LatestBlockTime.Sub(r.Block.Time) / time.Duration(n), nil
+}
+
 // WaitForNextBlock waits until next block is committed.
 // It reads the current block height and then waits for another block to be
 // committed, or returns an error if ctx is canceled.
@@ -729,6 +759,57 @@ func (c Client) CollectTXs(ctx context.Context, fromHeight int64, tc chan<- []TX
 	return nil
 }
 
+// SubscribeNewBlocks subscribes to new blocks using the RPC websocket.
+// The returned channel receives the height of each new block and it is
+// closed when the context is done or when the websocket subscription is
+// closed. Events might be lost if the websocket connection is interrupted,
+// so callers must not rely on receiving every block height.
+func (c Client) SubscribeNewBlocks(ctx context.Context) (<-chan int64, error) {
+	if !c.RPC.IsRunning() {
+		if err := c.RPC.Start(); err != nil {
+			return nil, errors.Errorf("failed to start websocket: %w", err)
+		}
+	}
+
+	query := tmtypes.QueryForEvent(tmtypes.EventNewBlockHeader).String()
+	events, err := c.RPC.Subscribe(ctx, blockSubscriber, query)
+	if err != nil {
+		return nil, errors.Errorf("failed to subscribe to new blocks: %w", err)
+	}
+
+	heights := make(chan int64)
+
+	go func() {
+		defer close(heights)
+		defer c.RPC.UnsubscribeAll(context.Background(), blockSubscriber) //nolint:errcheck
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case e, ok := <-events:
+				// the events channel is closed when the RPC client stops
+				if !ok {
+					return
+				}
+
+				data, ok := e.Data.(tmtypes.EventDataNewBlockHeader)
+				if !ok {
+					continue
+				}
+
+				select {
+				case <-ctx.Done():
+					return
+				case heights <- data.Header.Height:
+				}
+			}
+		}
+	}()
+
+	return heights, nil
+}
+
 // makeSureAccountHasTokens makes sure the address has a positive balance.
 // It requests funds from the faucet if the address has an empty balance.
 func (c *Client) makeSureAccountHasTokens(ctx context.Context, address string) error {
@@ -396,6 +396,91 @@ func TestClientStatus(t *testing.T) {
 	}
 }
 
+func TestClientAverageBlockTime(t *testing.T) {
+	var (
+		ctx    = context.Background()
+		latest = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	)
+	tests := []struct {
+		name          string
+		blocks        int64
+		expected      time.Duration
+		expectedError string
+		setup         func(suite)
+	}{
+		{
+			name:     "ok",
+			blocks:   100,
+			expected: 5 * time.Second,
+			setup: func(s suite) {
+				s.rpcClient.EXPECT().Status(ctx).Return(&ctypes.ResultStatus{
+					SyncInfo: ctypes.SyncInfo{LatestBlockHeight: 1000, LatestBlockTime: latest},
+				}, nil).Once()
+				height := int64(900)
+				s.rpcClient.EXPECT().Block(ctx, &height).Return(&ctypes.ResultBlock{
+					Block: &tmtypes.Block{Header: tmtypes.Header{Height: 900, Time: latest.Add(-500 * time.Second)}},
+				}, nil).Once()
+			},
+		},
+		{
+			name:     "window larger than the chain",
+			blocks:   100,
+			expected: 2 * time.Second,
+			setup: func(s suite) {
+				s.rpcClient.EXPECT().Status(ctx).Return(&ctypes.ResultStatus{
+					SyncInfo: ctypes.SyncInfo{LatestBlockHeight: 11, LatestBlockTime: latest},
+				}, nil).Once()
+				height := int64(1)
+				s.rpcClient.EXPECT().Block(ctx, &height).Return(&ctypes.ResultBlock{
+					Block: &tmtypes.Block{Header: tmtypes.Header{Height: 1, Time: latest.Add(-20 * time.Second)}},
+				}, nil).Once()
+			},
+		},
+		{
+			name:     "pruned node",
+			blocks:   100,
+			expected: 4 * time.Second,
+			setup: func(s suite) {
+				s.rpcClient.EXPECT().Status(ctx).Return(&ctypes.ResultStatus{
+					SyncInfo: ctypes.SyncInfo{
+						EarliestBlockHeight: 950,
+						LatestBlockHeight:   1000,
+						LatestBlockTime:     latest,
+					},
+				}, nil).Once()
+				height := int64(950)
+				s.rpcClient.EXPECT().Block(ctx, &height).Return(&ctypes.ResultBlock{
+					Block: &tmtypes.Block{Header: tmtypes.Header{Height: 950, Time: latest.Add(-200 * time.Second)}},
+				}, nil).Once()
+			},
+		},
+		{
+			name:          "not enough blocks",
+			blocks:        100,
+			expectedError: "not enough blocks to compute the block time at height 1",
+			setup: func(s suite) {
+				s.rpcClient.EXPECT().Status(ctx).Return(&ctypes.ResultStatus{
+					SyncInfo: ctypes.SyncInfo{LatestBlockHeight: 1, LatestBlockTime: latest},
+				}, nil).Once()
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newClient(t, tt.setup)
+
+			blockTime, err := c.AverageBlockTime(ctx, tt.blocks)
+
+			if tt.expectedError != "" {
+				require.EqualError(t, err, tt.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, blockTime)
+		})
+	}
+}
+
 func TestClientCreateTx(t *testing.T) {
 	var (
 		ctx         = context.Background()
@@ -1038,3 +1123,101 @@ func createTestBlock(height int64) tmtypes.Block {
 		},
 	}
 }
+
+func TestSubscribeNewBlocks(t *testing.T) {
+	m := testutil.NewTendermintClientMock(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// Mock the websocket subscription to send the header of a new block
+	events := make(chan ctypes.ResultEvent, 1)
+	events <- ctypes.ResultEvent{
+		Data: tmtypes.EventDataNewBlockHeader{
+			Header: tmtypes.Header{Height: 42},
+		},
+	}
+
+	query := "tm.event = 'NewBlockHeader'"
+	unsubscribed := make(chan struct{})
+
+	m.On("IsRunning").Return(false)
+	m.On("Start").Return(nil)
+	m.On("Subscribe", ctx, "ignite-cosmosclient", query).Return((<-chan ctypes.ResultEvent)(events), nil)
+	m.On("UnsubscribeAll", mock.Anything, "ignite-cosmosclient").
+		Run(func(mock.Arguments) { close(unsubscribed) }).
+		Return(nil)
+
+	// Create a cosmos client that uses the RPC mock
+	client := cosmosclient.Client{RPC: m}
+
+	heights, err := client.SubscribeNewBlocks(ctx)
+	require.NoError(t, err)
+
+	var height int64
+	select {
+	case <-time.After(time.Second):
+		t.Fatal("expected a new block height")
+	case height = <-heights:
+	}
+
+	// Cancel the context to close the subscription
+	cancel()
+
+	select {
+	case <-time.After(time.Second):
+		t.Fatal("expected the subscription to be closed")
+	case <-unsubscribed:
+	}
+
+	// Assert
+	require.Equal(t, int64(42), height)
+
+	_, open := <-heights
+	require.False(t, open, "expected heights channel to be closed")
+}
+
+func TestSubscribeNewBlocksWithClosedSubscription(t *testing.T) {
+	m := testutil.NewTendermintClientMock(t)
+	ctx := context.Background()
+
+	// Mock a websocket subscription that is closed by the RPC client
+	events := make(chan ctypes.ResultEvent)
+	close(events)
+
+	query := "tm.event = 'NewBlockHeader'"
+
+	m.On("IsRunning").Return(true)
+	m.On("Subscribe", ctx, "ignite-cosmosclient", query).Return((<-chan ctypes.ResultEvent)(events), nil)
+	m.On("UnsubscribeAll", mock.Anything, "ignite-cosmosclient").Return(nil)
+
+	// Create a cosmos client that uses the RPC mock
+	client := cosmosclient.Client{RPC: m}
+
+	heights, err := client.SubscribeNewBlocks(ctx)
+	require.NoError(t, err)
+
+	// Assert
+	select {
+	case <-time.After(time.Second):
+		t.Fatal("expected heights channel to be closed")
+	case _, open := <-heights:
+		require.False(t, open, "expected heights channel to be closed")
+	}
+}
+
+func TestSubscribeNewBlocksWithStartError(t *testing.T) {
+	m := testutil.NewTendermintClientMock(t)
+	wantErr := errors.New("expected error")
+
+	m.On("IsRunning").Return(false)
+	m.On("Start").Return(wantErr)
+
+	// Create a cosmos client that uses the RPC mock
+	client := cosmosclient.Client{RPC: m}
+
+	_, err := client.SubscribeNewBlocks(context.Background())
+
+	// Assert
+	require.ErrorIs(t, err, wantErr)
+	m.AssertNotCalled(t, "Subscribe")
+}
@@ -0,0 +1,189 @@
+package cosmosfaucet
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+const (
+	// DefaultBatchSize is the default maximum number of transfer requests sent in a single transaction.
+	DefaultBatchSize = 50
+
+	// batchSendTimeout is the maximum time to send a batch transaction.
+	batchSendTimeout = time.Minute
+)
+
+// MultiSender sends the coins of a batch of transfers from the faucet account
+// to multiple recipients in a single bank multi-send transaction.
+type MultiSender interface {
+	// MultiSend signs and broadcasts the multi-send transaction and returns its hash.
+	MultiSend(ctx context.Context, outputs []banktypes.Output) (txHash string, err error)
+}
+
+// batcher gathers transfer requests to send them in a single transaction.
+type batcher struct {
+	sender MultiSender
+	window time.Duration
+	size   int
+
+	// mu protects the pending and sending requests and the flush timer.
+	mu      sync.Mutex
+	pending []*batchRequest
+	sending map[*batchRequest]struct{}
+	timer   *time.Timer
+
+	// sendMu serializes the batch transactions to avoid account sequence mismatches.
+	sendMu sync.Mutex
+
+	// onSent is called after a batch transaction is sent for each request of the batch.
+	onSent func(ctx context.Context, req batchRequest, txHash string)
+}
+
+type batchRequest struct {
+	address  string
+	clientIP string
+	coins    sdk.Coins
+	result   chan batchResult
+}
+
+type batchResult struct {
+	txHash string
+	err    error
+}
+
+func newBatcher(sender MultiSender, window time.Duration, size int) *batcher {
+	if size <= 0 {
+		size = DefaultBatchSize
+	}
+
+	return &batcher{
+		sender:  sender,
+		window:  window,
+		size:    size,
+		sending: make(map[*batchRequest]struct{}),
+	}
+}
+
+// pendingAmounts returns the coins of the pending and sending requests for an account address
+// and a client IP. These amounts are not yet recorded and must be considered when checking
+// the transfer limits.
+func (b *batcher) pendingAmounts(address, clientIP string) (addressTotal, ipTotal sdk.Coins) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	addressTotal, ipTotal = sdk.NewCoins(), sdk.NewCoins()
+	add := func(r *batchRequest) {
+		if r.address == address {
+			addressTotal = addressTotal.Add(r.coins...)
+		}
+		if clientIP != "" && r.clientIP == clientIP {
+			ipTotal = ipTotal.Add(r.coins...)
+		}
+	}
+
+	for _, r := range b.pending {
+		add(r)
+	}
+	for r := range b.sending {
+		add(r)
+	}
+
+	return addressTotal, ipTotal
+}
+
+// add queues a transfer request and returns a channel to receive the hash of the
+// transaction that includes it. The batch is sent when it is full or when the batch
+// window is over.
+func (b *batcher) add(address, clientIP string, coins sdk.Coins) <-chan batchResult {
+	req := &batchRequest{
+		address:  address,
+		clientIP: clientIP,
+		coins:    coins,
+		result:   make(chan batchResult, 1),
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.pending = append(b.pending, req)
+
+	switch {
+	case len(b.pending) >= b.size:
+		b.flushLocked()
+	case b.timer == nil:
+		b.timer = time.AfterFunc(b.window, b.flush)
+	}
+
+	return req.result
+}
+
+func (b *batcher) flush() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.flushLocked()
+}
+
+// flushLocked sends the pending requests in the background.
+// It must be called with the mutex locked.
+func (b *batcher) flushLocked() {
+	if b.timer != nil {
+		b.timer.Stop()
+		b.timer = nil
+	}
+
+	if len(b.pending) == 0 {
+		return
+	}
+
+	requests := b.pending
+	b.pending = nil
+
+	for _, r := range requests {
+		b.sending[r] = struct{}{}
+	}
+
+	go b.send(requests)
+}
+
+func (b *batcher) send(requests []*batchRequest) {
+	b.sendMu.Lock()
+	defer b.sendMu.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), batchSendTimeout)
+	defer cancel()
+
+	// merge the coins of the requests made to the same address
+	var (
+		outputs []banktypes.Output
+		indexes = make(map[string]int)
+	)
+	for _, r := range requests {
+		if i, ok := indexes[r.address]; ok {
+			outputs[i].Coins = outputs[i].Coins.Add(r.coins...)
+			continue
+		}
+
+		indexes[r.address] = len(outputs)
+		outputs = append(outputs, banktypes.Output{Address: r.address, Coins: r.coins})
+	}
+
+	txHash, err := b.sender.MultiSend(ctx, outputs)
+	for _, r := range requests {
+		if err == nil && b.onSent != nil {
+			b.onSent(ctx, *r, txHash)
+		}
+		r.result <- batchResult{txHash: txHash, err: err}
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for _, r := range requests {
+		delete(b.sending, r)
+	}
+}
@@ -0,0 +1,89 @@
+package cosmosfaucet
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+type testMultiSender struct {
+	mu      sync.Mutex
+	batches [][]banktypes.Output
+	release chan struct{}
+}
+
+func (s *testMultiSender) MultiSend(_ context.Context, outputs []banktypes.Output) (string, error) {
+	if s.release != nil {
+		<-s.release
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.batches = append(s.batches, outputs)
+	return "hash", nil
+}
+
+func TestBatcherSize(t *testing.T) {
+	var (
+		sender = &testMultiSender{}
+		b      = newBatcher(sender, time.Hour, 3)
+		coins  = sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+	)
+
+	results := []<-chan batchResult{
+		b.add("cosmos1a", "10.0.0.1", coins),
+		b.add("cosmos1b", "10.0.0.1", coins),
+		b.add("cosmos1a", "10.0.0.2", coins),
+	}
+
+	for _, result := range results {
+		res := <-result
+		require.NoError(t, res.err)
+		require.Equal(t, "hash", res.txHash)
+	}
+
+	require.Len(t, sender.batches, 1)
+	require.Equal(t, []banktypes.Output{
+		{Address: "cosmos1a", Coins: sdk.NewCoins(sdk.NewInt64Coin("stake", 20))},
+		{Address: "cosmos1b", Coins: coins},
+	}, sender.batches[0])
+}
+
+func TestBatcherWindow(t *testing.T) {
+	var (
+		sender = &testMultiSender{release: make(chan struct{})}
+		b      = newBatcher(sender, 10*time.Millisecond, 0)
+		coins  = sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+		sent   []string
+	)
+
+	b.onSent = func(_ context.Context, req batchRequest, txHash string) {
+		sent = append(sent, req.address+"@"+txHash)
+	}
+
+	result := b.add("cosmos1a", "10.0.0.1", coins)
+
+	// the request is pending until the batch transaction is sent
+	addressTotal, ipTotal := b.pendingAmounts("cosmos1a", "10.0.0.1")
+	require.Equal(t, coins, addressTotal)
+	require.Equal(t, coins, ipTotal)
+
+	addressTotal, ipTotal = b.pendingAmounts("cosmos1b", "")
+	require.True(t, addressTotal.IsZero())
+	require.True(t, ipTotal.IsZero())
+
+	close(sender.release)
+
+	res := <-result
+	require.NoError(t, res.err)
+	require.Equal(t, "hash", res.txHash)
+	require.Equal(t, []string{"cosmos1a@hash"}, sent)
+	require.Len(t, sender.batches, 1)
+}
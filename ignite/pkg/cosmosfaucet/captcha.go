@@ -0,0 +1,80 @@
+package cosmosfaucet
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+)
+
+// CaptchaVerifier is a challenge verifier that validates captcha response tokens with the
+// verification endpoint of an external provider. It supports the "siteverify" API shared
+// by providers like hCaptcha, reCAPTCHA and Cloudflare Turnstile.
+type CaptchaVerifier struct {
+	verifyURL string
+	secret    string
+	client    *http.Client
+}
+
+// NewCaptchaVerifier creates a new captcha verifier that uses the provider verification URL
+// and the secret key of the site.
+func NewCaptchaVerifier(verifyURL, secret string) CaptchaVerifier {
+	return CaptchaVerifier{
+		verifyURL: verifyURL,
+		secret:    secret,
+		client:    http.DefaultClient,
+	}
+}
+
+// Type implements ChallengeVerifier.
+func (CaptchaVerifier) Type() string {
+	return ChallengeCaptcha
+}
+
+// Verify implements ChallengeVerifier.
+func (v CaptchaVerifier) Verify(ctx context.Context, solution ChallengeSolution, clientIP string) error {
+	if solution.Token == "" {
+		return errors.Wrap(ErrChallengeFailed, "captcha response is required")
+	}
+
+	form := url.Values{
+		"secret":   {v.secret},
+		"response": {solution.Token},
+	}
+	if clientIP != "" {
+		form.Set("remoteip", clientIP)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, v.verifyURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	res, err := v.client.Do(req)
+	if err != nil {
+		return errors.Errorf("captcha verification request failed: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return errors.Errorf("captcha verification request failed: %s", res.Status)
+	}
+
+	var result struct {
+		Success    bool     `json:"success"`
+		ErrorCodes []string `json:"error-codes"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&result); err != nil {
+		return err
+	}
+
+	if !result.Success {
+		return errors.Wrapf(ErrChallengeFailed, "invalid captcha response %v", result.ErrorCodes)
+	}
+
+	return nil
+}
@@ -0,0 +1,225 @@
+package cosmosfaucet
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"math/bits"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+)
+
+const (
+	// ChallengeProofOfWork is the type of the built-in proof-of-work challenge.
+	ChallengeProofOfWork = "pow"
+
+	// ChallengeCaptcha is the type of challenge verified by an external captcha provider.
+	ChallengeCaptcha = "captcha"
+
+	// DefaultProofOfWorkDifficulty is the default number of leading zero bits
+	// required in the proof-of-work hash.
+	DefaultProofOfWorkDifficulty = 20
+
+	// DefaultProofOfWorkTTL is the default time to solve a proof-of-work challenge.
+	DefaultProofOfWorkTTL = 5 * time.Minute
+
+	// proofOfWorkAlgorithm is the hash algorithm used by the proof-of-work challenge.
+	proofOfWorkAlgorithm = "sha256"
+)
+
+// ErrChallengeFailed is returned when the challenge solution of a transfer request is not valid.
+var ErrChallengeFailed = errors.New("challenge verification failed")
+
+// ChallengeSolution is the solution of the challenge sent with a transfer request.
+type ChallengeSolution struct {
+	// Token is the proof-of-work challenge token or the captcha response token.
+	Token string `json:"token"`
+
+	// Nonce is the proof-of-work nonce. It is not used by captcha challenges.
+	Nonce string `json:"nonce,omitempty"`
+}
+
+// ChallengeVerifier verifies the challenge solutions of transfer requests.
+// It allows using external captcha providers to protect the faucet.
+type ChallengeVerifier interface {
+	// Type returns the type of challenge, e.g. "pow" or "captcha".
+	Type() string
+
+	// Verify checks the challenge solution sent by a client.
+	// ErrChallengeFailed must be returned when the solution is not valid.
+	Verify(ctx context.Context, solution ChallengeSolution, clientIP string) error
+}
+
+// ProofOfWorkChallenge is a hashcash-style challenge to solve before requesting tokens.
+type ProofOfWorkChallenge struct {
+	// Token identifies the challenge and must be sent back with the solution.
+	Token string `json:"token"`
+
+	// Algorithm is the hash algorithm to use.
+	Algorithm string `json:"algorithm"`
+
+	// Difficulty is the number of leading zero bits required in the hash of "token:nonce".
+	Difficulty uint `json:"difficulty"`
+
+	// ExpiresAt is the time after which the challenge can't be used anymore.
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// ProofOfWork is a challenge verifier that issues hashcash-style challenges.
+// A solution is a nonce for which the SHA-256 hash of "token:nonce" has the
+// required number of leading zero bits. Challenge tokens are signed so no state
+// is kept for issued challenges, and each token can only be used once.
+type ProofOfWork struct {
+	secret     []byte
+	difficulty uint
+	ttl        time.Duration
+
+	mu   *sync.Mutex
+	used map[string]time.Time
+}
+
+// NewProofOfWork creates a new proof-of-work challenge verifier.
+func NewProofOfWork(difficulty uint, ttl time.Duration) (ProofOfWork, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return ProofOfWork{}, err
+	}
+
+	if difficulty == 0 {
+		difficulty = DefaultProofOfWorkDifficulty
+	}
+
+	if ttl == 0 {
+		ttl = DefaultProofOfWorkTTL
+	}
+
+	return ProofOfWork{
+		secret:     secret,
+		difficulty: difficulty,
+		ttl:        ttl,
+		mu:         &sync.Mutex{},
+		used:       make(map[string]time.Time),
+	}, nil
+}
+
+// Type implements ChallengeVerifier.
+func (ProofOfWork) Type() string {
+	return ChallengeProofOfWork
+}
+
+// NewChallenge issues a new proof-of-work challenge.
+func (p ProofOfWork) NewChallenge() (ProofOfWorkChallenge, error) {
+	salt := make([]byte, 16)
+	if _, err := rand.Read(salt); err != nil {
+		return ProofOfWorkChallenge{}, err
+	}
+
+	expiresAt := time.Now().Add(p.ttl).Truncate(time.Second)
+	payload := fmt.Sprintf("%d.%d.%s", expiresAt.Unix(), p.difficulty, hex.EncodeToString(salt))
+
+	return ProofOfWorkChallenge{
+		Token:      payload + "." + p.sign(payload),
+		Algorithm:  proofOfWorkAlgorithm,
+		Difficulty: p.difficulty,
+		ExpiresAt:  expiresAt.UTC(),
+	}, nil
+}
+
+// Verify implements ChallengeVerifier.
+func (p ProofOfWork) Verify(_ context.Context, solution ChallengeSolution, _ string) error {
+	i := strings.LastIndex(solution.Token, ".")
+	if i < 0 {
+		return errors.Wrap(ErrChallengeFailed, "malformed token")
+	}
+
+	payload, signature := solution.Token[:i], solution.Token[i+1:]
+	if !hmac.Equal([]byte(signature), []byte(p.sign(payload))) {
+		return errors.Wrap(ErrChallengeFailed, "invalid token signature")
+	}
+
+	parts := strings.SplitN(payload, ".", 3)
+	if len(parts) != 3 {
+		return errors.Wrap(ErrChallengeFailed, "malformed token")
+	}
+
+	expiry, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return errors.Wrap(ErrChallengeFailed, "malformed token expiry")
+	}
+
+	difficulty, err := strconv.ParseUint(parts[1], 10, 32)
+	if err != nil {
+		return errors.Wrap(ErrChallengeFailed, "malformed token difficulty")
+	}
+
+	now := time.Now()
+	expiresAt := time.Unix(expiry, 0)
+	if now.After(expiresAt) {
+		return errors.Wrap(ErrChallengeFailed, "challenge expired")
+	}
+
+	if LeadingZeroBits(proofOfWorkHash(solution.Token, solution.Nonce)) < uint(difficulty) {
+		return errors.Wrap(ErrChallengeFailed, "invalid proof of work")
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	// forget the tokens that expired since they can't be used anymore
+	for token, exp := range p.used {
+		if now.After(exp) {
+			delete(p.used, token)
+		}
+	}
+
+	if _, ok := p.used[solution.Token]; ok {
+		return errors.Wrap(ErrChallengeFailed, "challenge already used")
+	}
+	p.used[solution.Token] = expiresAt
+
+	return nil
+}
+
+func (p ProofOfWork) sign(payload string) string {
+	mac := hmac.New(sha256.New, p.secret)
+	mac.Write([]byte(payload))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// SolveProofOfWork finds a nonce that solves a proof-of-work challenge.
+func SolveProofOfWork(ctx context.Context, challenge ProofOfWorkChallenge) (ChallengeSolution, error) {
+	for nonce := uint64(0); ; nonce++ {
+		if nonce%4096 == 0 && ctx.Err() != nil {
+			return ChallengeSolution{}, ctx.Err()
+		}
+
+		n := strconv.FormatUint(nonce, 10)
+		if LeadingZeroBits(proofOfWorkHash(challenge.Token, n)) >= challenge.Difficulty {
+			return ChallengeSolution{Token: challenge.Token, Nonce: n}, nil
+		}
+	}
+}
+
+// LeadingZeroBits returns the number of leading zero bits of a hash.
+func LeadingZeroBits(hash []byte) uint {
+	var n uint
+	for _, b := range hash {
+		if b != 0 {
+			return n + uint(bits.LeadingZeros8(b))
+		}
+		n += 8
+	}
+	return n
+}
+
+func proofOfWorkHash(token, nonce string) []byte {
+	h := sha256.Sum256([]byte(token + ":" + nonce))
+	return h[:]
+}
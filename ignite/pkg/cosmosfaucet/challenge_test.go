@@ -0,0 +1,118 @@
+package cosmosfaucet_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/v29/ignite/pkg/cosmosfaucet"
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+)
+
+func TestProofOfWork(t *testing.T) {
+	ctx := context.Background()
+
+	pow, err := cosmosfaucet.NewProofOfWork(8, time.Minute)
+	require.NoError(t, err)
+
+	challenge, err := pow.NewChallenge()
+	require.NoError(t, err)
+	require.EqualValues(t, 8, challenge.Difficulty)
+
+	solution, err := cosmosfaucet.SolveProofOfWork(ctx, challenge)
+	require.NoError(t, err)
+
+	// Act
+	err = pow.Verify(ctx, solution, "")
+
+	// Assert
+	require.NoError(t, err)
+
+	// the challenge can only be used once
+	err = pow.Verify(ctx, solution, "")
+	require.True(t, errors.Is(err, cosmosfaucet.ErrChallengeFailed))
+}
+
+func TestProofOfWorkInvalidSolution(t *testing.T) {
+	ctx := context.Background()
+
+	pow, err := cosmosfaucet.NewProofOfWork(8, time.Minute)
+	require.NoError(t, err)
+
+	other, err := cosmosfaucet.NewProofOfWork(8, time.Minute)
+	require.NoError(t, err)
+
+	challenge, err := other.NewChallenge()
+	require.NoError(t, err)
+
+	solution, err := cosmosfaucet.SolveProofOfWork(ctx, challenge)
+	require.NoError(t, err)
+
+	cases := []struct {
+		name     string
+		solution cosmosfaucet.ChallengeSolution
+	}{
+		{
+			name:     "malformed token",
+			solution: cosmosfaucet.ChallengeSolution{Token: "foo"},
+		},
+		{
+			name:     "token from another issuer",
+			solution: solution,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			err := pow.Verify(ctx, tt.solution, "")
+			require.True(t, errors.Is(err, cosmosfaucet.ErrChallengeFailed))
+		})
+	}
+}
+
+func TestProofOfWorkExpired(t *testing.T) {
+	ctx := context.Background()
+
+	pow, err := cosmosfaucet.NewProofOfWork(1, time.Nanosecond)
+	require.NoError(t, err)
+
+	challenge, err := pow.NewChallenge()
+	require.NoError(t, err)
+
+	solution, err := cosmosfaucet.SolveProofOfWork(ctx, challenge)
+	require.NoError(t, err)
+
+	time.Sleep(time.Until(challenge.ExpiresAt) + time.Second)
+
+	err = pow.Verify(ctx, solution, "")
+	require.True(t, errors.Is(err, cosmosfaucet.ErrChallengeFailed))
+}
+
+func TestCaptchaVerifier(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		require.Equal(t, "secret", r.PostForm.Get("secret"))
+		require.Equal(t, "10.0.0.1", r.PostForm.Get("remoteip"))
+
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"success": r.PostForm.Get("response") == "valid",
+		})
+	}))
+	defer server.Close()
+
+	var (
+		ctx      = context.Background()
+		verifier = cosmosfaucet.NewCaptchaVerifier(server.URL, "secret")
+	)
+
+	err := verifier.Verify(ctx, cosmosfaucet.ChallengeSolution{Token: "valid"}, "10.0.0.1")
+	require.NoError(t, err)
+
+	err = verifier.Verify(ctx, cosmosfaucet.ChallengeSolution{Token: "invalid"}, "10.0.0.1")
+	require.True(t, errors.Is(err, cosmosfaucet.ErrChallengeFailed))
+}
@@ -62,6 +62,29 @@ func (c HTTPClient) Transfer(ctx context.Context, req TransferRequest) (Transfer
 	return res, nil
 }
 
+// Challenge requests a new proof-of-work challenge to solve before requesting tokens.
+// Use SolveProofOfWork to find the challenge solution to send with the transfer request.
+func (c HTTPClient) Challenge(ctx context.Context) (ProofOfWorkChallenge, error) {
+	hreq, err := http.NewRequestWithContext(ctx, http.MethodGet, c.addr+"/challenge", nil)
+	if err != nil {
+		return ProofOfWorkChallenge{}, err
+	}
+
+	hres, err := http.DefaultClient.Do(hreq)
+	if err != nil {
+		return ProofOfWorkChallenge{}, err
+	}
+	defer hres.Body.Close()
+
+	if hres.StatusCode != http.StatusOK {
+		return ProofOfWorkChallenge{}, errors.New(http.StatusText(hres.StatusCode))
+	}
+
+	var res ProofOfWorkChallenge
+	err = json.NewDecoder(hres.Body).Decode(&res)
+	return res, err
+}
+
 // FaucetInfo fetch the faucet info for clients to determine if this is a real faucet and
 // what is the chain id of the chain that faucet is operating for.
 func (c HTTPClient) FaucetInfo(ctx context.Context) (FaucetInfoResponse, error) {
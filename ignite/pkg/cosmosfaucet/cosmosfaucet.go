@@ -3,6 +3,7 @@ package cosmosfaucet
 
 import (
 	"context"
+	"io"
 	"time"
 
 	sdkmath "cosmossdk.io/math"
@@ -77,6 +78,18 @@ type Faucet struct {
 
 	// indexerDisabled tells whether the indexing is disabled on the node.
 	indexerDisabled bool
+
+	// ledger records the transfers to check the limits without querying the chain.
+	ledger Ledger
+
+	// batcher gathers the transfer requests to send them in a single transaction.
+	batcher *batcher
+
+	// challenge verifies the challenge solutions sent with the transfer requests.
+	challenge ChallengeVerifier
+
+	// ipQuota limits the number of transfer requests per client IP and subnet.
+	ipQuota *ipQuota
 }
 
 // Option configures the faucetOptions.
@@ -150,6 +163,41 @@ func IndexerDisabled() Option {
 	}
 }
 
+// WithLedger sets the ledger used to record the transfers and enforce the max amounts of coins
+// per account address and per client IP. When a ledger is set the limits are checked
+// without querying the transactions from the node, so they also apply when the indexer is disabled.
+func WithLedger(ledger Ledger) Option {
+	return func(f *Faucet) {
+		f.ledger = ledger
+	}
+}
+
+// Batch enables the batching of transfer requests. The requests received within the batch
+// window are sent together in a single bank multi-send transaction using the sender.
+// A batch is sent before the end of the window when it reaches the batch size.
+// All requests of a batch get the hash of the same transaction.
+func Batch(sender MultiSender, window time.Duration, size int) Option {
+	return func(f *Faucet) {
+		f.batcher = newBatcher(sender, window, size)
+	}
+}
+
+// Challenge requires the HTTP transfer requests to include a valid solution to a challenge.
+// Use a ProofOfWork verifier to serve the built-in proof-of-work challenges or
+// a custom verifier to use an external captcha provider.
+func Challenge(verifier ChallengeVerifier) Option {
+	return func(f *Faucet) {
+		f.challenge = verifier
+	}
+}
+
+// IPQuota limits the number of HTTP transfer requests accepted per client IP and subnet.
+func IPQuota(limits IPQuotaLimits) Option {
+	return func(f *Faucet) {
+		f.ipQuota = newIPQuota(limits)
+	}
+}
+
 // New creates a new faucet with ccr (to access and use blockchain's CLI) and given options.
 func New(ctx context.Context, ccr chaincmdrunner.Runner, options ...Option) (Faucet, error) {
 	f := Faucet{
@@ -172,6 +220,12 @@ func New(ctx context.Context, ccr chaincmdrunner.Runner, options ...Option) (Fau
 		RefreshWindow(DefaultRefreshWindow)(&f)
 	}
 
+	if f.batcher != nil {
+		f.batcher.onSent = func(ctx context.Context, req batchRequest, txHash string) {
+			f.recordGrant(ctx, req.address, req.clientIP, req.coins, txHash)
+		}
+	}
+
 	// import the account if mnemonic is provided.
 	if f.accountMnemonic != "" {
 		_, err := f.runner.AddAccount(
@@ -199,3 +253,11 @@ func New(ctx context.Context, ccr chaincmdrunner.Runner, options ...Option) (Fau
 
 	return f, nil
 }
+
+// Close closes the ledger of the faucet when it holds resources, like a database connection.
+func (f Faucet) Close() error {
+	if c, ok := f.ledger.(io.Closer); ok {
+		return c.Close()
+	}
+	return nil
+}
@@ -32,6 +32,14 @@ func (f Faucet) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		}
 	})))
 
+	mux.Handle("/challenge", cors.Default().Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet || r.Method == http.MethodOptions {
+			f.challengeHandler(w, r)
+		} else {
+			http.NotFound(w, r)
+		}
+	})))
+
 	mux.HandleFunc("/openapi.yml", func(w http.ResponseWriter, r *http.Request) {
 		if r.Method == http.MethodGet {
 			f.openAPISpecHandler(w, r)
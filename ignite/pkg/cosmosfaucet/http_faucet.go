@@ -3,6 +3,7 @@ package cosmosfaucet
 import (
 	"context"
 	"encoding/json"
+	"net"
 	"net/http"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -18,6 +19,9 @@ type TransferRequest struct {
 	// Coins that are requested.
 	// default ones used when this one isn't provided.
 	Coins []string `json:"coins,omitempty"`
+
+	// Challenge is the solution of the challenge required by the faucet.
+	Challenge *ChallengeSolution `json:"challenge,omitempty"`
 }
 
 func NewTransferRequest(accountAddress string, coins []string) TransferRequest {
@@ -48,8 +52,36 @@ func (f Faucet) faucetHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	ip := clientIP(r)
+
+	// verify the challenge solution.
+	if f.challenge != nil {
+		if req.Challenge == nil {
+			responseError(w, http.StatusForbidden, errors.Errorf("%s challenge solution is required", f.challenge.Type()))
+			return
+		}
+
+		if err := f.challenge.Verify(r.Context(), *req.Challenge, ip); err != nil {
+			code := http.StatusInternalServerError
+			if errors.Is(err, ErrChallengeFailed) {
+				code = http.StatusForbidden
+			}
+			responseError(w, code, err)
+			return
+		}
+	}
+
+	// check the quotas of the client IP and subnet once the challenge is solved,
+	// so requests without a valid solution don't use the quotas of other clients.
+	if f.ipQuota != nil {
+		if err := f.ipQuota.allow(ip); err != nil {
+			responseError(w, http.StatusTooManyRequests, err)
+			return
+		}
+	}
+
 	// try performing the transfer
-	hash, err := f.Transfer(r.Context(), req.AccountAddress, coins)
+	hash, err := f.Transfer(r.Context(), req.AccountAddress, coins, TransferClientIP(ip))
 	if err != nil {
 		if errors.Is(err, context.Canceled) {
 			return
@@ -68,13 +100,37 @@ type FaucetInfoResponse struct {
 
 	// ChainID is chain id of the chain that faucet is running for.
 	ChainID string `json:"chain_id"`
+
+	// Challenge is the type of challenge required to request tokens, if any.
+	Challenge string `json:"challenge,omitempty"`
 }
 
 func (f Faucet) faucetInfoHandler(w http.ResponseWriter, _ *http.Request) {
-	_ = xhttp.ResponseJSON(w, http.StatusOK, FaucetInfoResponse{
+	res := FaucetInfoResponse{
 		IsAFaucet: true,
 		ChainID:   f.chainID,
-	})
+	}
+	if f.challenge != nil {
+		res.Challenge = f.challenge.Type()
+	}
+
+	_ = xhttp.ResponseJSON(w, http.StatusOK, res)
+}
+
+func (f Faucet) challengeHandler(w http.ResponseWriter, _ *http.Request) {
+	pow, ok := f.challenge.(ProofOfWork)
+	if !ok {
+		responseError(w, http.StatusNotFound, errors.New("proof-of-work challenge is not enabled"))
+		return
+	}
+
+	challenge, err := pow.NewChallenge()
+	if err != nil {
+		responseError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	_ = xhttp.ResponseJSON(w, http.StatusOK, challenge)
 }
 
 // coinsFromRequest determines tokens to transfer from transfer request.
@@ -95,6 +151,15 @@ func (f Faucet) coinsFromRequest(req TransferRequest) (sdk.Coins, error) {
 	return coins, nil
 }
 
+// clientIP returns the IP address of the client that sent the request.
+func clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
 func responseSuccess(w http.ResponseWriter, hash string) {
 	_ = xhttp.ResponseJSON(w, http.StatusOK, TransferResponse{
 		Hash: hash,
@@ -3,6 +3,7 @@ package cosmosfaucet_test
 import (
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -25,6 +26,11 @@ func TestServeHTTPCORS(t *testing.T) {
 			method: "GET",
 			path:   "/info",
 		},
+		{
+			name:   "challenge endpoint",
+			method: "GET",
+			path:   "/challenge",
+		},
 	}
 
 	for _, tt := range cases {
@@ -45,3 +51,27 @@ func TestServeHTTPCORS(t *testing.T) {
 		})
 	}
 }
+
+func TestServeHTTPAntiAbuse(t *testing.T) {
+	pow, err := cosmosfaucet.NewProofOfWork(1, 0)
+	require.NoError(t, err)
+
+	f := cosmosfaucet.Faucet{}
+	cosmosfaucet.Challenge(pow)(&f)
+	cosmosfaucet.IPQuota(cosmosfaucet.IPQuotaLimits{Requests: 1})(&f)
+
+	send := func(body string) int {
+		res := httptest.NewRecorder()
+		req, _ := http.NewRequest("POST", "/", strings.NewReader(body))
+		req.RemoteAddr = "10.0.0.1:1234"
+
+		f.ServeHTTP(res, req)
+		return res.Result().StatusCode
+	}
+
+	// requests without a valid challenge solution are rejected and don't use the client IP quota
+	for i := 0; i < 3; i++ {
+		require.Equal(t, http.StatusForbidden, send(`{"address":"cosmos1a"}`))
+		require.Equal(t, http.StatusForbidden, send(`{"address":"cosmos1a","challenge":{"token":"invalid","nonce":"1"}}`))
+	}
+}
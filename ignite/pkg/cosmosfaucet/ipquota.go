@@ -0,0 +1,143 @@
+package cosmosfaucet
+
+import (
+	"net"
+	"sync"
+	"time"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+)
+
+const (
+	// DefaultIPv4SubnetBits is the default prefix length of the IPv4 subnets used for subnet quotas.
+	DefaultIPv4SubnetBits = 24
+
+	// DefaultIPv6SubnetBits is the default prefix length of the IPv6 subnets used for subnet quotas.
+	DefaultIPv6SubnetBits = 64
+
+	// DefaultIPQuotaWindow is the default time window of the IP quotas.
+	DefaultIPQuotaWindow = 24 * time.Hour
+)
+
+// ErrQuotaExceeded is returned when a client IP or its subnet made too many transfer requests.
+var ErrQuotaExceeded = errors.New("too many requests")
+
+// IPQuotaLimits configures the maximum number of transfer requests accepted per client IP
+// and per client subnet within a time window. A zero limit disables the quota.
+type IPQuotaLimits struct {
+	// Requests is the maximum number of requests per client IP.
+	Requests int
+
+	// SubnetRequests is the maximum number of requests per client subnet.
+	SubnetRequests int
+
+	// IPv4SubnetBits is the prefix length of the IPv4 subnets.
+	IPv4SubnetBits int
+
+	// IPv6SubnetBits is the prefix length of the IPv6 subnets.
+	IPv6SubnetBits int
+
+	// Window is the time window of the quotas.
+	Window time.Duration
+}
+
+// ipQuota counts the requests made by client IPs and subnets within a sliding window.
+type ipQuota struct {
+	limits IPQuotaLimits
+
+	mu       sync.Mutex
+	requests map[string][]time.Time
+}
+
+func newIPQuota(limits IPQuotaLimits) *ipQuota {
+	if limits.IPv4SubnetBits == 0 {
+		limits.IPv4SubnetBits = DefaultIPv4SubnetBits
+	}
+
+	if limits.IPv6SubnetBits == 0 {
+		limits.IPv6SubnetBits = DefaultIPv6SubnetBits
+	}
+
+	if limits.Window == 0 {
+		limits.Window = DefaultIPQuotaWindow
+	}
+
+	return &ipQuota{
+		limits:   limits,
+		requests: make(map[string][]time.Time),
+	}
+}
+
+// allow checks that the quotas of the client IP and its subnet are not exceeded and
+// counts the request when they are not.
+func (q *ipQuota) allow(clientIP string) error {
+	ip := net.ParseIP(clientIP)
+	if ip == nil {
+		return errors.Errorf("invalid client IP %q", clientIP)
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	now := time.Now()
+	since := now.Add(-q.limits.Window)
+
+	type quota struct {
+		key   string
+		limit int
+		name  string
+	}
+
+	quotas := []quota{
+		{"ip/" + ip.String(), q.limits.Requests, "client IP"},
+		{"subnet/" + q.subnet(ip), q.limits.SubnetRequests, "client subnet"},
+	}
+
+	for _, qt := range quotas {
+		if qt.limit <= 0 {
+			continue
+		}
+
+		if len(q.prune(qt.key, since)) >= qt.limit {
+			return errors.Wrapf(ErrQuotaExceeded, "%s has reached the limit of %d requests", qt.name, qt.limit)
+		}
+	}
+
+	for _, qt := range quotas {
+		if qt.limit > 0 {
+			q.requests[qt.key] = append(q.requests[qt.key], now)
+		}
+	}
+
+	return nil
+}
+
+// prune removes the requests made before since and returns the remaining ones.
+func (q *ipQuota) prune(key string, since time.Time) []time.Time {
+	requests := q.requests[key]
+
+	i := 0
+	for i < len(requests) && requests[i].Before(since) {
+		i++
+	}
+
+	requests = requests[i:]
+	if len(requests) == 0 {
+		delete(q.requests, key)
+	} else {
+		q.requests[key] = requests
+	}
+
+	return requests
+}
+
+// subnet returns the subnet of an IP address.
+func (q *ipQuota) subnet(ip net.IP) string {
+	if ip4 := ip.To4(); ip4 != nil {
+		mask := net.CIDRMask(q.limits.IPv4SubnetBits, 32)
+		return (&net.IPNet{IP: ip4.Mask(mask), Mask: mask}).String()
+	}
+
+	mask := net.CIDRMask(q.limits.IPv6SubnetBits, 128)
+	return (&net.IPNet{IP: ip.Mask(mask), Mask: mask}).String()
+}
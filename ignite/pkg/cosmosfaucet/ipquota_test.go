@@ -0,0 +1,40 @@
+package cosmosfaucet
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+)
+
+func TestIPQuota(t *testing.T) {
+	q := newIPQuota(IPQuotaLimits{Requests: 2, SubnetRequests: 3})
+
+	require.NoError(t, q.allow("10.0.0.1"))
+	require.NoError(t, q.allow("10.0.0.1"))
+
+	// the client IP reached its quota
+	err := q.allow("10.0.0.1")
+	require.True(t, errors.Is(err, ErrQuotaExceeded))
+
+	// the subnet reached its quota
+	require.NoError(t, q.allow("10.0.0.2"))
+	err = q.allow("10.0.0.3")
+	require.True(t, errors.Is(err, ErrQuotaExceeded))
+
+	// other subnets are not affected
+	require.NoError(t, q.allow("10.0.1.1"))
+	require.NoError(t, q.allow("2001:db8::1"))
+
+	// invalid client IPs are rejected
+	require.Error(t, q.allow("foo"))
+}
+
+func TestIPQuotaIPv6Subnet(t *testing.T) {
+	q := newIPQuota(IPQuotaLimits{SubnetRequests: 1})
+
+	require.NoError(t, q.allow("2001:db8::1"))
+	require.True(t, errors.Is(q.allow("2001:db8::2"), ErrQuotaExceeded))
+	require.NoError(t, q.allow("2001:db8:0:1::1"))
+}
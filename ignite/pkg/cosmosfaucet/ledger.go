@@ -0,0 +1,162 @@
+package cosmosfaucet
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Grant is a transfer of coins made by the faucet.
+type Grant struct {
+	// Address is the recipient account address.
+	Address string `json:"address"`
+
+	// IP is the IP address of the client that requested the transfer.
+	// It is empty when the transfer wasn't requested over HTTP.
+	IP string `json:"ip,omitempty"`
+
+	// Coins are the transferred coins.
+	Coins sdk.Coins `json:"coins"`
+
+	// TxHash is the hash of the transfer transaction.
+	TxHash string `json:"tx_hash"`
+
+	// Time is the time of the transfer.
+	Time time.Time `json:"time"`
+}
+
+// Ledger records the grants made by the faucet to enforce the maximum amounts
+// of coins that can be transferred to a recipient address or a client IP.
+// Using a ledger avoids querying the transfer history from the chain tx indexer.
+type Ledger interface {
+	// Record saves a grant in the ledger.
+	Record(ctx context.Context, grant Grant) error
+
+	// AddressTotal returns the total amount of coins granted to an account address since the given time.
+	AddressTotal(ctx context.Context, address string, since time.Time) (sdk.Coins, error)
+
+	// IPTotal returns the total amount of coins granted to a client IP since the given time.
+	IPTotal(ctx context.Context, ip string, since time.Time) (sdk.Coins, error)
+
+	// Prune removes the grants made before the given time.
+	// The faucet prunes the grants that are outside the limit refresh window.
+	Prune(ctx context.Context, before time.Time) error
+}
+
+// MemoryLedger is a ledger that keeps the grants in memory.
+// Grants are lost when the faucet is restarted.
+type MemoryLedger struct {
+	mu     sync.RWMutex
+	grants grantIndex
+}
+
+// NewMemoryLedger creates a new in-memory ledger.
+func NewMemoryLedger() *MemoryLedger {
+	return &MemoryLedger{grants: newGrantIndex()}
+}
+
+// Record implements Ledger.
+func (l *MemoryLedger) Record(_ context.Context, grant Grant) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.grants.add(grant)
+	return nil
+}
+
+// AddressTotal implements Ledger.
+func (l *MemoryLedger) AddressTotal(_ context.Context, address string, since time.Time) (sdk.Coins, error) {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	return sumGrants(l.grants.byAddress[address], since), nil
+}
+
+// IPTotal implements Ledger.
+func (l *MemoryLedger) IPTotal(_ context.Context, ip string, since time.Time) (sdk.Coins, error) {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	return sumGrants(l.grants.byIP[ip], since), nil
+}
+
+// Prune implements Ledger.
+func (l *MemoryLedger) Prune(_ context.Context, before time.Time) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.grants.prune(before)
+	return nil
+}
+
+// grantIndex indexes the grants by recipient address and by client IP.
+type grantIndex struct {
+	byAddress map[string][]Grant
+	byIP      map[string][]Grant
+}
+
+func newGrantIndex() grantIndex {
+	return grantIndex{
+		byAddress: make(map[string][]Grant),
+		byIP:      make(map[string][]Grant),
+	}
+}
+
+func (i grantIndex) add(g Grant) {
+	i.byAddress[g.Address] = append(i.byAddress[g.Address], g)
+	if g.IP != "" {
+		i.byIP[g.IP] = append(i.byIP[g.IP], g)
+	}
+}
+
+// prune removes the grants made before the given time and returns true when grants were removed.
+func (i grantIndex) prune(before time.Time) bool {
+	pruned := pruneGrants(i.byAddress, before)
+	pruneGrants(i.byIP, before)
+	return pruned
+}
+
+// list returns the indexed grants sorted by time.
+func (i grantIndex) list() []Grant {
+	var grants []Grant
+	for _, g := range i.byAddress {
+		grants = append(grants, g...)
+	}
+	sort.SliceStable(grants, func(a, b int) bool {
+		return grants[a].Time.Before(grants[b].Time)
+	})
+	return grants
+}
+
+func pruneGrants(index map[string][]Grant, before time.Time) (pruned bool) {
+	for key, grants := range index {
+		kept := slices.DeleteFunc(grants, func(g Grant) bool { return g.Time.Before(before) })
+		if len(kept) == len(grants) {
+			continue
+		}
+
+		pruned = true
+		if len(kept) == 0 {
+			delete(index, key)
+		} else {
+			index[key] = kept
+		}
+	}
+	return pruned
+}
+
+// sumGrants returns the total amount of coins of the grants made since the given time.
+func sumGrants(grants []Grant, since time.Time) sdk.Coins {
+	total := sdk.NewCoins()
+	for _, g := range grants {
+		if g.Time.Before(since) {
+			continue
+		}
+		total = total.Add(g.Coins...)
+	}
+	return total
+}
@@ -0,0 +1,184 @@
+package cosmosfaucet
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bolt "go.etcd.io/bbolt"
+)
+
+var (
+	// boltLedgerAddressBucket is the name of the bucket where grants are indexed by address.
+	boltLedgerAddressBucket = []byte("address")
+
+	// boltLedgerIPBucket is the name of the bucket where grants are indexed by client IP.
+	boltLedgerIPBucket = []byte("ip")
+)
+
+// BoltLedger is a ledger that saves the grants on disk in a BoltDB database.
+// The grants are indexed in a bucket per address and per client IP, with keys ordered
+// by time, so the totals are computed from the grants of the limit window only.
+// The database is locked by the ledger until it is closed.
+type BoltLedger struct {
+	db *bolt.DB
+}
+
+// NewBoltLedger creates a new on-disk ledger using the BoltDB database at path.
+// The database file is created when it doesn't exist.
+func NewBoltLedger(path string) (BoltLedger, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return BoltLedger{}, err
+	}
+
+	db, err := bolt.Open(path, 0o640, &bolt.Options{Timeout: 1 * time.Second})
+	if err != nil {
+		return BoltLedger{}, err
+	}
+
+	return BoltLedger{db: db}, nil
+}
+
+// Record implements Ledger.
+func (l BoltLedger) Record(_ context.Context, grant Grant) error {
+	value, err := json.Marshal(grant)
+	if err != nil {
+		return err
+	}
+
+	return l.db.Update(func(tx *bolt.Tx) error {
+		if err := putGrant(tx, boltLedgerAddressBucket, grant.Address, grant.Time, value); err != nil {
+			return err
+		}
+		if grant.IP == "" {
+			return nil
+		}
+		return putGrant(tx, boltLedgerIPBucket, grant.IP, grant.Time, value)
+	})
+}
+
+// AddressTotal implements Ledger.
+func (l BoltLedger) AddressTotal(_ context.Context, address string, since time.Time) (sdk.Coins, error) {
+	return l.total(boltLedgerAddressBucket, address, since)
+}
+
+// IPTotal implements Ledger.
+func (l BoltLedger) IPTotal(_ context.Context, ip string, since time.Time) (sdk.Coins, error) {
+	return l.total(boltLedgerIPBucket, ip, since)
+}
+
+// Prune implements Ledger.
+func (l BoltLedger) Prune(_ context.Context, before time.Time) error {
+	return l.db.Update(func(tx *bolt.Tx) error {
+		for _, name := range [][]byte{boltLedgerAddressBucket, boltLedgerIPBucket} {
+			if err := pruneBucket(tx.Bucket(name), before); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (l BoltLedger) total(bucket []byte, key string, since time.Time) (sdk.Coins, error) {
+	total := sdk.NewCoins()
+	err := l.db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket(bucket)
+		if b == nil {
+			return nil
+		}
+		if b = b.Bucket([]byte(key)); b == nil {
+			return nil
+		}
+
+		// the keys are ordered by time so only the grants made since the given time are read
+		c := b.Cursor()
+		for k, v := c.Seek(timeKey(since)); k != nil; k, v = c.Next() {
+			var g Grant
+			if err := json.Unmarshal(v, &g); err != nil {
+				return err
+			}
+			total = total.Add(g.Coins...)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return total, nil
+}
+
+// Close closes the database of the ledger.
+func (l BoltLedger) Close() error {
+	return l.db.Close()
+}
+
+// putGrant saves a grant in the bucket of the key, with a key prefixed by the grant time.
+func putGrant(tx *bolt.Tx, bucket []byte, key string, t time.Time, value []byte) error {
+	b, err := tx.CreateBucketIfNotExists(bucket)
+	if err != nil {
+		return err
+	}
+	if b, err = b.CreateBucketIfNotExists([]byte(key)); err != nil {
+		return err
+	}
+
+	seq, err := b.NextSequence()
+	if err != nil {
+		return err
+	}
+
+	k := timeKey(t)
+	k = binary.BigEndian.AppendUint64(k, seq)
+
+	return b.Put(k, value)
+}
+
+// pruneBucket removes the grants made before the given time from the sub buckets of a bucket.
+// The sub buckets left without grants are deleted.
+func pruneBucket(b *bolt.Bucket, before time.Time) error {
+	if b == nil {
+		return nil
+	}
+
+	var names [][]byte
+	if err := b.ForEachBucket(func(name []byte) error {
+		names = append(names, bytes.Clone(name))
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	end := timeKey(before)
+	for _, name := range names {
+		sub := b.Bucket(name)
+
+		var keys [][]byte
+		c := sub.Cursor()
+		for k, _ := c.First(); k != nil && bytes.Compare(k, end) < 0; k, _ = c.Next() {
+			keys = append(keys, bytes.Clone(k))
+		}
+		for _, k := range keys {
+			if err := sub.Delete(k); err != nil {
+				return err
+			}
+		}
+
+		if k, _ := sub.Cursor().First(); k == nil {
+			if err := b.DeleteBucket(name); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// timeKey returns the key prefix of the grants made at the given time.
+func timeKey(t time.Time) []byte {
+	return binary.BigEndian.AppendUint64(nil, uint64(t.UnixNano()))
+}
@@ -0,0 +1,154 @@
+package cosmosfaucet
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// JSONLedger is a ledger that appends the grants to a JSON lines file on disk.
+// The grants are loaded from the file once and indexed in memory by address and client IP.
+type JSONLedger struct {
+	mu     sync.Mutex
+	path   string
+	grants *grantIndex
+}
+
+// NewJSONLedger creates a new on-disk ledger using the JSON lines file at path.
+// The file is created when it doesn't exist.
+func NewJSONLedger(path string) (*JSONLedger, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, err
+	}
+
+	return &JSONLedger{path: path}, nil
+}
+
+// Record implements Ledger.
+func (l *JSONLedger) Record(_ context.Context, grant Grant) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	grants, err := l.index()
+	if err != nil {
+		return err
+	}
+
+	line, err := json.Marshal(grant)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.OpenFile(l.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o640)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if _, err = f.Write(append(line, '\n')); err != nil {
+		return err
+	}
+
+	grants.add(grant)
+	return nil
+}
+
+// AddressTotal implements Ledger.
+func (l *JSONLedger) AddressTotal(_ context.Context, address string, since time.Time) (sdk.Coins, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	grants, err := l.index()
+	if err != nil {
+		return nil, err
+	}
+	return sumGrants(grants.byAddress[address], since), nil
+}
+
+// IPTotal implements Ledger.
+func (l *JSONLedger) IPTotal(_ context.Context, ip string, since time.Time) (sdk.Coins, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	grants, err := l.index()
+	if err != nil {
+		return nil, err
+	}
+	return sumGrants(grants.byIP[ip], since), nil
+}
+
+// Prune implements Ledger.
+// The file is rewritten without the pruned grants.
+func (l *JSONLedger) Prune(_ context.Context, before time.Time) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	grants, err := l.index()
+	if err != nil {
+		return err
+	}
+	if !grants.prune(before) {
+		return nil
+	}
+
+	var buf bytes.Buffer
+	for _, g := range grants.list() {
+		line, err := json.Marshal(g)
+		if err != nil {
+			return err
+		}
+		buf.Write(append(line, '\n'))
+	}
+
+	// write the grants to a temporary file first to not lose the ledger on failure
+	tmp := l.path + ".tmp"
+	if err := os.WriteFile(tmp, buf.Bytes(), 0o640); err != nil {
+		return err
+	}
+	return os.Rename(tmp, l.path)
+}
+
+// index returns the grants of the file indexed by address and client IP.
+// The file is read the first time the ledger is used.
+func (l *JSONLedger) index() (*grantIndex, error) {
+	if l.grants != nil {
+		return l.grants, nil
+	}
+
+	grants := newGrantIndex()
+
+	f, err := os.Open(l.path)
+	if os.IsNotExist(err) {
+		l.grants = &grants
+		return l.grants, nil
+	} else if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+
+		var g Grant
+		if err := json.Unmarshal(scanner.Bytes(), &g); err != nil {
+			return nil, err
+		}
+		grants.add(g)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	l.grants = &grants
+	return l.grants, nil
+}
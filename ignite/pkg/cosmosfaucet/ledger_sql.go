@@ -0,0 +1,145 @@
+package cosmosfaucet
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultSQLLedgerTable is the default name of the table where the SQL ledger saves the grants.
+const DefaultSQLLedgerTable = "faucet_grant"
+
+const (
+	tplSQLLedgerTableDDL = `
+		CREATE TABLE IF NOT EXISTS %[1]s (
+			address     TEXT NOT NULL,
+			ip          TEXT NOT NULL DEFAULT '',
+			coins       TEXT NOT NULL,
+			tx_hash     TEXT NOT NULL,
+			created_at  TIMESTAMP NOT NULL
+		)
+	`
+	tplSQLLedgerAddressIndexDDL = `CREATE INDEX IF NOT EXISTS %[1]s_address_idx ON %[1]s (address, created_at)`
+	tplSQLLedgerIPIndexDDL      = `CREATE INDEX IF NOT EXISTS %[1]s_ip_idx ON %[1]s (ip, created_at)`
+	tplSQLLedgerTimeIndexDDL    = `CREATE INDEX IF NOT EXISTS %[1]s_created_at_idx ON %[1]s (created_at)`
+	tplSQLLedgerInsert          = `
+		INSERT INTO %s (address, ip, coins, tx_hash, created_at)
+		VALUES ($1, $2, $3, $4, $5)
+	`
+	tplSQLLedgerSelectCoins = `
+		SELECT coins FROM %s
+		WHERE %s = $1 AND created_at >= $2
+	`
+	tplSQLLedgerDelete = `
+		DELETE FROM %s
+		WHERE created_at < $1
+	`
+)
+
+// SQLLedgerOption configures the SQL ledger.
+type SQLLedgerOption func(*SQLLedger)
+
+// SQLLedgerTable sets the name of the table where the grants are saved.
+func SQLLedgerTable(name string) SQLLedgerOption {
+	return func(l *SQLLedger) {
+		l.table = name
+	}
+}
+
+// SQLLedger is a ledger that saves the grants in a SQL database.
+// Queries use numbered placeholders ($1, $2, ...) which are supported by PostgreSQL and SQLite.
+// The ledger owns the database connection, which is closed when the ledger is closed.
+type SQLLedger struct {
+	db    *sql.DB
+	table string
+}
+
+// NewSQLLedger creates a new SQL ledger using an open database connection.
+// The grants table and its indexes are created when they don't exist.
+func NewSQLLedger(ctx context.Context, db *sql.DB, options ...SQLLedgerOption) (SQLLedger, error) {
+	l := SQLLedger{
+		db:    db,
+		table: DefaultSQLLedgerTable,
+	}
+
+	for _, apply := range options {
+		apply(&l)
+	}
+
+	ddl := []string{
+		tplSQLLedgerTableDDL,
+		tplSQLLedgerAddressIndexDDL,
+		tplSQLLedgerIPIndexDDL,
+		tplSQLLedgerTimeIndexDDL,
+	}
+	for _, tpl := range ddl {
+		if _, err := db.ExecContext(ctx, fmt.Sprintf(tpl, l.table)); err != nil {
+			return SQLLedger{}, err
+		}
+	}
+
+	return l, nil
+}
+
+// Record implements Ledger.
+func (l SQLLedger) Record(ctx context.Context, grant Grant) error {
+	_, err := l.db.ExecContext(
+		ctx,
+		fmt.Sprintf(tplSQLLedgerInsert, l.table),
+		grant.Address,
+		grant.IP,
+		grant.Coins.String(),
+		grant.TxHash,
+		grant.Time.UTC(),
+	)
+	return err
+}
+
+// AddressTotal implements Ledger.
+func (l SQLLedger) AddressTotal(ctx context.Context, address string, since time.Time) (sdk.Coins, error) {
+	return l.total(ctx, "address", address, since)
+}
+
+// IPTotal implements Ledger.
+func (l SQLLedger) IPTotal(ctx context.Context, ip string, since time.Time) (sdk.Coins, error) {
+	return l.total(ctx, "ip", ip, since)
+}
+
+// Prune implements Ledger.
+func (l SQLLedger) Prune(ctx context.Context, before time.Time) error {
+	_, err := l.db.ExecContext(ctx, fmt.Sprintf(tplSQLLedgerDelete, l.table), before.UTC())
+	return err
+}
+
+// Close closes the database connection of the ledger.
+func (l SQLLedger) Close() error {
+	return l.db.Close()
+}
+
+func (l SQLLedger) total(ctx context.Context, column, value string, since time.Time) (sdk.Coins, error) {
+	rows, err := l.db.QueryContext(ctx, fmt.Sprintf(tplSQLLedgerSelectCoins, l.table, column), value, since.UTC())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	total := sdk.NewCoins()
+	for rows.Next() {
+		var coins string
+		if err := rows.Scan(&coins); err != nil {
+			return nil, err
+		}
+
+		parsed, err := sdk.ParseCoinsNormalized(coins)
+		if err != nil {
+			return nil, err
+		}
+
+		total = total.Add(parsed...)
+	}
+
+	return total, rows.Err()
+}
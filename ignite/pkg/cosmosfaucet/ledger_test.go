@@ -0,0 +1,159 @@
+package cosmosfaucet_test
+
+import (
+	"context"
+	"path/filepath"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sync/errgroup"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ignite/cli/v29/ignite/pkg/cosmosfaucet"
+)
+
+func TestLedgers(t *testing.T) {
+	cases := []struct {
+		name   string
+		ledger func(t *testing.T) cosmosfaucet.Ledger
+	}{
+		{
+			name: "memory",
+			ledger: func(*testing.T) cosmosfaucet.Ledger {
+				return cosmosfaucet.NewMemoryLedger()
+			},
+		},
+		{
+			name: "bolt",
+			ledger: func(t *testing.T) cosmosfaucet.Ledger {
+				l, err := cosmosfaucet.NewBoltLedger(filepath.Join(t.TempDir(), "ledger.db"))
+				require.NoError(t, err)
+				t.Cleanup(func() { require.NoError(t, l.Close()) })
+				return l
+			},
+		},
+		{
+			name: "json",
+			ledger: func(t *testing.T) cosmosfaucet.Ledger {
+				l, err := cosmosfaucet.NewJSONLedger(filepath.Join(t.TempDir(), "ledger.jsonl"))
+				require.NoError(t, err)
+				return l
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				ctx    = context.Background()
+				ledger = tt.ledger(t)
+				now    = time.Now()
+			)
+
+			total, err := ledger.AddressTotal(ctx, "cosmos1a", now.Add(-time.Hour))
+			require.NoError(t, err)
+			require.True(t, total.IsZero())
+
+			grants := []cosmosfaucet.Grant{
+				{Address: "cosmos1a", IP: "10.0.0.1", Coins: sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), Time: now.Add(-2 * time.Hour)},
+				{Address: "cosmos1a", IP: "10.0.0.1", Coins: sdk.NewCoins(sdk.NewInt64Coin("stake", 5), sdk.NewInt64Coin("token", 1)), Time: now},
+				{Address: "cosmos1b", IP: "10.0.0.1", Coins: sdk.NewCoins(sdk.NewInt64Coin("stake", 7)), Time: now},
+			}
+			// the grants are recorded concurrently like the faucet requests
+			var g errgroup.Group
+			for _, grant := range grants {
+				g.Go(func() error { return ledger.Record(ctx, grant) })
+			}
+			require.NoError(t, g.Wait())
+
+			total, err = ledger.AddressTotal(ctx, "cosmos1a", now.Add(-time.Hour))
+			require.NoError(t, err)
+			require.Equal(t, "5stake,1token", total.String())
+
+			total, err = ledger.AddressTotal(ctx, "cosmos1a", now.Add(-3*time.Hour))
+			require.NoError(t, err)
+			require.Equal(t, "15stake,1token", total.String())
+
+			total, err = ledger.IPTotal(ctx, "10.0.0.1", now.Add(-time.Hour))
+			require.NoError(t, err)
+			require.Equal(t, "12stake,1token", total.String())
+
+			total, err = ledger.IPTotal(ctx, "10.0.0.2", now.Add(-time.Hour))
+			require.NoError(t, err)
+			require.True(t, total.IsZero())
+
+			require.NoError(t, ledger.Prune(ctx, now.Add(-time.Hour)))
+
+			total, err = ledger.AddressTotal(ctx, "cosmos1a", now.Add(-3*time.Hour))
+			require.NoError(t, err)
+			require.Equal(t, "5stake,1token", total.String())
+
+			total, err = ledger.IPTotal(ctx, "10.0.0.1", now.Add(-3*time.Hour))
+			require.NoError(t, err)
+			require.Equal(t, "12stake,1token", total.String())
+		})
+	}
+}
+
+func TestSQLLedger(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	var (
+		ctx   = context.Background()
+		now   = time.Now()
+		since = now.Add(-time.Hour)
+	)
+
+	mock.ExpectExec(regexp.QuoteMeta("CREATE TABLE IF NOT EXISTS faucet_grant")).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta("CREATE INDEX IF NOT EXISTS faucet_grant_address_idx")).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta("CREATE INDEX IF NOT EXISTS faucet_grant_ip_idx")).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta("CREATE INDEX IF NOT EXISTS faucet_grant_created_at_idx")).WillReturnResult(sqlmock.NewResult(0, 0))
+
+	ledger, err := cosmosfaucet.NewSQLLedger(ctx, db)
+	require.NoError(t, err)
+
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO faucet_grant")).
+		WithArgs("cosmos1a", "10.0.0.1", "5stake", "hash", now.UTC()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	err = ledger.Record(ctx, cosmosfaucet.Grant{
+		Address: "cosmos1a",
+		IP:      "10.0.0.1",
+		Coins:   sdk.NewCoins(sdk.NewInt64Coin("stake", 5)),
+		TxHash:  "hash",
+		Time:    now,
+	})
+	require.NoError(t, err)
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT coins FROM faucet_grant")).
+		WithArgs("cosmos1a", since.UTC()).
+		WillReturnRows(sqlmock.NewRows([]string{"coins"}).AddRow("5stake").AddRow("3stake,1token"))
+
+	total, err := ledger.AddressTotal(ctx, "cosmos1a", since)
+	require.NoError(t, err)
+	require.Equal(t, "8stake,1token", total.String())
+
+	mock.ExpectQuery(regexp.QuoteMeta("WHERE ip = $1")).
+		WithArgs("10.0.0.1", since.UTC()).
+		WillReturnRows(sqlmock.NewRows([]string{"coins"}))
+
+	total, err = ledger.IPTotal(ctx, "10.0.0.1", since)
+	require.NoError(t, err)
+	require.True(t, total.IsZero())
+
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM faucet_grant WHERE created_at < $1")).
+		WithArgs(since.UTC()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	require.NoError(t, ledger.Prune(ctx, since))
+
+	mock.ExpectClose()
+	require.NoError(t, ledger.Close())
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -2,6 +2,7 @@ package cosmosfaucet
 
 import (
 	"context"
+	"log"
 	"sync"
 	"time"
 
@@ -15,6 +16,11 @@ import (
 	"github.com/ignite/cli/v29/ignite/pkg/errors"
 )
 
+const (
+	holderAccount  = "account"
+	holderClientIP = "client IP"
+)
+
 // transferMutex is a mutex used for keeping transfer requests in a queue so checking account balance and sending tokens is atomic.
 var transferMutex = &sync.Mutex{}
 
@@ -43,21 +49,36 @@ func (f Faucet) TotalTransferredAmount(ctx context.Context, toAccountAddress, de
 		}
 	}
 
-	totalAmount = sdkmath.NewInt(0)
+	return transferredTo(events, toAccountAddress, denom, f.limitRefreshWindow)
+}
+
+// transferredTo sums the amounts of denom transferred to toAccountAddress within the window.
+// A tx can pay many recipients when transfers are batched in a multi-send, so only the amounts
+// of the transfers to toAccountAddress are counted. The recipient attribute of a transfer always
+// precedes its amount, both in separate events and in events flattened by type.
+func transferredTo(events []chaincmdrunner.Event, toAccountAddress, denom string, window time.Duration) (sdkmath.Int, error) {
+	totalAmount := sdkmath.NewInt(0)
 	for _, event := range events {
-		if event.Type == "transfer" {
-			for _, attr := range event.Attributes {
-				if attr.Key == "amount" {
-					coins, err := sdk.ParseCoinsNormalized(attr.Value)
-					if err != nil {
-						return sdkmath.NewInt(0), err
-					}
-
-					amount := coins.AmountOf(denom)
-					if amount.GT(sdkmath.NewInt(0)) && time.Since(event.Time) < f.limitRefreshWindow {
-						totalAmount = totalAmount.Add(amount)
-					}
+		if event.Type != "transfer" || time.Since(event.Time) >= window {
+			continue
+		}
+
+		var recipient string
+		for _, attr := range event.Attributes {
+			switch attr.Key {
+			case "recipient":
+				recipient = attr.Value
+			case "amount":
+				if recipient != toAccountAddress {
+					continue
 				}
+
+				coins, err := sdk.ParseCoinsNormalized(attr.Value)
+				if err != nil {
+					return sdkmath.NewInt(0), err
+				}
+
+				totalAmount = totalAmount.Add(coins.AmountOf(denom))
 			}
 		}
 	}
@@ -65,43 +86,49 @@ func (f Faucet) TotalTransferredAmount(ctx context.Context, toAccountAddress, de
 	return totalAmount, nil
 }
 
-// Transfer transfers amount of tokens from the faucet account to toAccountAddress.
-func (f *Faucet) Transfer(ctx context.Context, toAccountAddress string, coins sdk.Coins) (string, error) {
-	transferMutex.Lock()
-	defer transferMutex.Unlock()
+// TransferOption configures a transfer.
+type TransferOption func(*transferOptions)
 
-	transfer := sdk.NewCoins()
-	// check for each coin, the max transferred amount hasn't been reached
-	for _, c := range coins {
-		if f.indexerDisabled { // we cannot check the transfer history if indexer is disabled
-			transfer = transfer.Add(c)
-			continue
-		}
+type transferOptions struct {
+	clientIP string
+}
 
-		totalSent, err := f.TotalTransferredAmount(ctx, toAccountAddress, c.Denom)
+// TransferClientIP sets the IP address of the client that requested the transfer.
+// The max amounts of coins are also enforced per client IP when the faucet has a ledger.
+func TransferClientIP(ip string) TransferOption {
+	return func(o *transferOptions) {
+		o.clientIP = ip
+	}
+}
+
+// Transfer transfers amount of tokens from the faucet account to toAccountAddress.
+// When batching is enabled the transfer is sent with the other requests of its batch.
+func (f *Faucet) Transfer(ctx context.Context, toAccountAddress string, coins sdk.Coins, options ...TransferOption) (string, error) {
+	var o transferOptions
+	for _, apply := range options {
+		apply(&o)
+	}
+
+	if f.batcher != nil {
+		result, err := f.queueTransfer(ctx, toAccountAddress, o.clientIP, coins)
 		if err != nil {
 			return "", err
 		}
-		coinMax, found := f.coinsMax[c.Denom]
-		if found && !coinMax.IsNil() && !coinMax.Equal(sdkmath.NewInt(0)) {
-			if totalSent.GTE(coinMax) {
-				return "", errors.Errorf(
-					"account has reached to the max. allowed amount (%d) for %q denom",
-					coinMax,
-					c.Denom,
-				)
-			}
 
-			if (totalSent.Add(c.Amount)).GT(coinMax) {
-				return "", errors.Errorf(
-					`ask less amount for %q denom. account is reaching to the limit (%d) that faucet can tolerate`,
-					c.Denom,
-					coinMax,
-				)
-			}
+		select {
+		case res := <-result:
+			return res.txHash, res.err
+		case <-ctx.Done():
+			return "", ctx.Err()
 		}
+	}
 
-		transfer = transfer.Add(c)
+	transferMutex.Lock()
+	defer transferMutex.Unlock()
+
+	transfer, err := f.checkTransfer(ctx, toAccountAddress, o.clientIP, coins)
+	if err != nil {
+		return "", err
 	}
 
 	// perform transfer for all coins
@@ -114,6 +141,8 @@ func (f *Faucet) Transfer(ctx context.Context, toAccountAddress string, coins sd
 		return "", err
 	}
 
+	f.recordGrant(ctx, toAccountAddress, o.clientIP, transfer, txHash)
+
 	if f.indexerDisabled {
 		return txHash, nil // we cannot check the tx status if indexer is disabled
 	}
@@ -121,3 +150,160 @@ func (f *Faucet) Transfer(ctx context.Context, toAccountAddress string, coins sd
 	// wait for send tx to be confirmed
 	return txHash, f.runner.WaitTx(ctx, txHash, time.Second, 30)
 }
+
+// queueTransfer checks the transfer limits and adds the transfer to the current batch.
+func (f *Faucet) queueTransfer(ctx context.Context, toAccountAddress, clientIP string, coins sdk.Coins) (<-chan batchResult, error) {
+	transferMutex.Lock()
+	defer transferMutex.Unlock()
+
+	transfer, err := f.checkTransfer(ctx, toAccountAddress, clientIP, coins)
+	if err != nil {
+		return nil, err
+	}
+
+	return f.batcher.add(toAccountAddress, clientIP, transfer), nil
+}
+
+// checkTransfer checks that the max transferred amounts are not reached and returns the coins to transfer.
+func (f Faucet) checkTransfer(ctx context.Context, toAccountAddress, clientIP string, coins sdk.Coins) (sdk.Coins, error) {
+	limits, err := f.transferredAmounts(ctx, toAccountAddress, clientIP, coins)
+	if err != nil {
+		return nil, err
+	}
+
+	transfer := sdk.NewCoins()
+	// check for each coin, the max transferred amount hasn't been reached
+	for _, c := range coins {
+		for _, l := range limits {
+			if err := f.checkMaxAmount(l.holder, l.total.AmountOf(c.Denom), c); err != nil {
+				return nil, err
+			}
+		}
+
+		transfer = transfer.Add(c)
+	}
+
+	return transfer, nil
+}
+
+// recordGrant records a transfer in the ledger when the faucet uses one, and prunes the
+// grants that are outside the limit refresh window. The coins are already sent so the
+// ledger errors are logged instead of failing the transfer.
+func (f Faucet) recordGrant(ctx context.Context, address, clientIP string, coins sdk.Coins, txHash string) {
+	if f.ledger == nil {
+		return
+	}
+
+	now := time.Now()
+	err := f.ledger.Record(ctx, Grant{
+		Address: address,
+		IP:      clientIP,
+		Coins:   coins,
+		TxHash:  txHash,
+		Time:    now,
+	})
+	if err != nil {
+		log.Printf("faucet: failed to record the transfer %s to %s in the ledger: %v", txHash, address, err)
+		return
+	}
+
+	if err := f.ledger.Prune(ctx, now.Add(-f.limitRefreshWindow)); err != nil {
+		log.Printf("faucet: failed to prune the ledger: %v", err)
+	}
+}
+
+// transferredAmount is the total amount of coins transferred to a holder within the refresh window.
+type transferredAmount struct {
+	holder string
+	total  sdk.Coins
+}
+
+// transferredAmounts returns the amounts of coins transferred within the refresh window
+// to the account address and, when a ledger is used, to the client IP.
+// No amounts are returned when the transfer history is not available.
+func (f Faucet) transferredAmounts(ctx context.Context, toAccountAddress, clientIP string, coins sdk.Coins) ([]transferredAmount, error) {
+	if f.ledger != nil {
+		since := time.Now().Add(-f.limitRefreshWindow)
+
+		total, err := f.ledger.AddressTotal(ctx, toAccountAddress, since)
+		if err != nil {
+			return nil, err
+		}
+
+		amounts := []transferredAmount{{holderAccount, total}}
+		if clientIP != "" {
+			total, err := f.ledger.IPTotal(ctx, clientIP, since)
+			if err != nil {
+				return nil, err
+			}
+
+			amounts = append(amounts, transferredAmount{holderClientIP, total})
+		}
+
+		return f.withPendingAmounts(amounts, toAccountAddress, clientIP), nil
+	}
+
+	if f.indexerDisabled { // we cannot check the transfer history if indexer is disabled
+		return nil, nil
+	}
+
+	total := sdk.NewCoins()
+	for _, c := range coins {
+		totalSent, err := f.TotalTransferredAmount(ctx, toAccountAddress, c.Denom)
+		if err != nil {
+			return nil, err
+		}
+
+		total = total.Add(sdk.NewCoin(c.Denom, totalSent))
+	}
+
+	return f.withPendingAmounts([]transferredAmount{{holderAccount, total}}, toAccountAddress, clientIP), nil
+}
+
+// withPendingAmounts adds the amounts of the batched transfers that are not sent yet
+// to the transferred amounts of the account address and the client IP.
+func (f Faucet) withPendingAmounts(amounts []transferredAmount, toAccountAddress, clientIP string) []transferredAmount {
+	if f.batcher == nil {
+		return amounts
+	}
+
+	addressTotal, ipTotal := f.batcher.pendingAmounts(toAccountAddress, clientIP)
+	for i, a := range amounts {
+		switch a.holder {
+		case holderAccount:
+			amounts[i].total = a.total.Add(addressTotal...)
+		case holderClientIP:
+			amounts[i].total = a.total.Add(ipTotal...)
+		}
+	}
+
+	return amounts
+}
+
+// checkMaxAmount checks that transferring coin to a holder doesn't exceed the max amount for its denom.
+func (f Faucet) checkMaxAmount(holder string, totalSent sdkmath.Int, coin sdk.Coin) error {
+	coinMax, found := f.coinsMax[coin.Denom]
+	if !found || coinMax.IsNil() || coinMax.Equal(sdkmath.NewInt(0)) {
+		return nil
+	}
+
+	if totalSent.GTE(coinMax) {
+		return errors.Errorf(
+			"%s has reached to the max. allowed amount (%d) for %q denom",
+			holder,
+			coinMax,
+			coin.Denom,
+		)
+	}
+
+	if (totalSent.Add(coin.Amount)).GT(coinMax) {
+		return errors.Errorf(
+			`ask less amount for %q denom. %s is reaching to the limit (%d) that faucet can tolerate`,
+			coin.Denom,
+			holder,
+			coinMax,
+		)
+	}
+
+	return nil
+}
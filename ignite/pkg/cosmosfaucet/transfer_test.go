@@ -0,0 +1,72 @@
+package cosmosfaucet
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	chaincmdrunner "github.com/ignite/cli/v29/ignite/pkg/chaincmd/runner"
+)
+
+func TestTransferredTo(t *testing.T) {
+	transfer := func(at time.Time, attrs ...string) chaincmdrunner.Event {
+		e := chaincmdrunner.Event{Type: "transfer", Time: at}
+		for i := 0; i < len(attrs); i += 2 {
+			e.Attributes = append(e.Attributes, chaincmdrunner.EventAttribute{Key: attrs[i], Value: attrs[i+1]})
+		}
+		return e
+	}
+	now := time.Now()
+
+	tests := []struct {
+		name   string
+		events []chaincmdrunner.Event
+		want   int64
+	}{
+		{
+			name: "single transfer",
+			events: []chaincmdrunner.Event{
+				transfer(now, "recipient", "alice", "sender", "faucet", "amount", "10token"),
+				transfer(now, "recipient", "fee_collector", "sender", "faucet", "amount", "1token"),
+			},
+			want: 10,
+		},
+		{
+			name: "multi-recipient batch",
+			events: []chaincmdrunner.Event{
+				{Type: "message", Time: now, Attributes: []chaincmdrunner.EventAttribute{{Key: "sender", Value: "faucet"}}},
+				transfer(now, "recipient", "bob", "amount", "20token"),
+				transfer(now, "recipient", "alice", "amount", "10token,5stake"),
+				transfer(now, "recipient", "carol", "amount", "30token"),
+			},
+			want: 10,
+		},
+		{
+			name: "multi-recipient batch flattened by type",
+			events: []chaincmdrunner.Event{
+				transfer(now,
+					"recipient", "bob", "sender", "faucet", "amount", "20token",
+					"recipient", "alice", "sender", "faucet", "amount", "10token",
+					"recipient", "carol", "sender", "faucet", "amount", "30token",
+				),
+			},
+			want: 10,
+		},
+		{
+			name: "transfers outside of the window",
+			events: []chaincmdrunner.Event{
+				transfer(now.Add(-2*time.Hour), "recipient", "alice", "amount", "10token"),
+				transfer(now, "recipient", "alice", "amount", "5token"),
+			},
+			want: 5,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := transferredTo(tt.events, "alice", "token", time.Hour)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got.Int64())
+		})
+	}
+}
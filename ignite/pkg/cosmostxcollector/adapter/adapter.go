@@ -40,3 +40,15 @@ type Adapter interface {
 	// Query executes a query in the data backend.
 	Query(context.Context, query.Query) (query.Cursor, error)
 }
+
+// Checkpointer defines the interface for data backend adapters that save a checkpoint
+// with the height of the latest collected block.
+// The checkpoint is saved atomically with the block transactions which allows resuming
+// the collection at the next block, even when the latest blocks have no transactions.
+type Checkpointer interface {
+	// SaveBlock saves the transactions of a block and updates the checkpoint to the block height.
+	SaveBlock(ctx context.Context, height int64, txs []cosmosclient.TX) error
+
+	// GetCheckpoint returns the height of the checkpoint or zero when no block was saved.
+	GetCheckpoint(context.Context) (int64, error)
+}
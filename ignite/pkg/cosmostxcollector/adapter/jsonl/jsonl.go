@@ -0,0 +1,272 @@
+// Package jsonl implements an append-only transaction collector sink that writes
+// the collected transactions as JSON lines, one transaction per line.
+package jsonl
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/ignite/cli/v29/ignite/pkg/cosmosclient"
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+)
+
+const (
+	adapterType = "jsonl"
+
+	// SchemaVersion is the version of the JSON lines format written by the sink.
+	SchemaVersion = 1
+
+	// maxLineSize is the maximum size of a line when reading an existing file.
+	maxLineSize = 64 * 1024 * 1024
+)
+
+var (
+	// ErrClosed is returned when the sink output is not open.
+	ErrClosed = errors.New("sink is closed")
+
+	// ErrUnsupportedSchema is returned when an existing file uses an unknown schema version.
+	ErrUnsupportedSchema = errors.New("unsupported JSON lines schema version")
+)
+
+// Header is the first line of the output and contains the schema version of the lines.
+type Header struct {
+	SchemaVersion uint64 `json:"schema_version"`
+}
+
+// Record is a line of the output that contains a collected transaction.
+type Record struct {
+	Hash      string          `json:"hash"`
+	Index     uint32          `json:"index"`
+	Height    int64           `json:"height"`
+	BlockTime time.Time       `json:"block_time"`
+	Events    []Event         `json:"events"`
+	Raw       json.RawMessage `json:"raw"`
+}
+
+// Event is a transaction event.
+type Event struct {
+	Type       string      `json:"type"`
+	Attributes []Attribute `json:"attributes"`
+}
+
+// Attribute is a transaction event attribute with its JSON encoded value.
+type Attribute struct {
+	Key   string          `json:"key"`
+	Value json.RawMessage `json:"value"`
+}
+
+// NewSink creates a new sink that appends the transactions to a file.
+// The file is created when it doesn't exist.
+func NewSink(path string) (*Sink, error) {
+	f, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE|os.O_APPEND, 0o644)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Sink{
+		w:    f,
+		file: f,
+	}, nil
+}
+
+// NewWriterSink creates a new sink that writes the transactions to a writer,
+// for example the standard output to pipe the transactions into other tools.
+func NewWriterSink(w io.Writer) *Sink {
+	return &Sink{w: w}
+}
+
+// Sink is an append-only transaction collector sink that writes JSON lines.
+// It doesn't support queries, the output is meant to be consumed by other tools.
+type Sink struct {
+	mu     sync.Mutex
+	w      io.Writer
+	file   *os.File
+	height int64
+}
+
+func (s *Sink) GetType() string {
+	return adapterType
+}
+
+// Init writes the schema header when the output is empty.
+// When the output is an existing file its schema version is checked and the
+// latest block height is read from the saved transactions.
+func (s *Sink) Init(context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.w == nil {
+		return ErrClosed
+	}
+
+	if s.file != nil {
+		empty, err := s.load()
+		if err != nil {
+			return err
+		}
+
+		if !empty {
+			return nil
+		}
+	}
+
+	return s.writeLines(Header{SchemaVersion: SchemaVersion})
+}
+
+// Save appends the transactions to the output.
+// All the transactions are written at once to avoid writing partial blocks.
+func (s *Sink) Save(_ context.Context, txs []cosmosclient.TX) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.w == nil {
+		return ErrClosed
+	}
+
+	records := make([]any, len(txs))
+	height := s.height
+	for i, tx := range txs {
+		r, err := newRecord(tx)
+		if err != nil {
+			return err
+		}
+
+		records[i] = r
+		height = max(height, r.Height)
+	}
+
+	if err := s.writeLines(records...); err != nil {
+		return err
+	}
+
+	s.height = height
+
+	return nil
+}
+
+// GetLatestHeight returns the height of the latest saved transaction.
+func (s *Sink) GetLatestHeight(context.Context) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.w == nil {
+		return 0, ErrClosed
+	}
+
+	return s.height, nil
+}
+
+// Close closes the output file.
+// Writers are not closed, they are owned by the caller.
+func (s *Sink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.w == nil {
+		return ErrClosed
+	}
+
+	s.w = nil
+
+	if s.file != nil {
+		return s.file.Close()
+	}
+
+	return nil
+}
+
+// load reads the existing output file and returns true when it is empty.
+func (s *Sink) load() (bool, error) {
+	if _, err := s.file.Seek(0, io.SeekStart); err != nil {
+		return false, err
+	}
+
+	scanner := bufio.NewScanner(s.file)
+	scanner.Buffer(nil, maxLineSize)
+
+	if !scanner.Scan() {
+		return true, scanner.Err()
+	}
+
+	var h Header
+	if err := json.Unmarshal(scanner.Bytes(), &h); err != nil {
+		return false, errors.Errorf("failed to read JSON lines header: %w", err)
+	}
+
+	if h.SchemaVersion != SchemaVersion {
+		return false, errors.Wrapf(ErrUnsupportedSchema, "version %d", h.SchemaVersion)
+	}
+
+	for line := 2; scanner.Scan(); line++ {
+		var r struct {
+			Height int64 `json:"height"`
+		}
+
+		if err := json.Unmarshal(scanner.Bytes(), &r); err != nil {
+			return false, errors.Errorf("failed to read JSON lines record at line %d: %w", line, err)
+		}
+
+		s.height = max(s.height, r.Height)
+	}
+
+	return false, scanner.Err()
+}
+
+func (s *Sink) writeLines(values ...any) error {
+	var buf bytes.Buffer
+
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+
+	for _, v := range values {
+		if err := enc.Encode(v); err != nil {
+			return err
+		}
+	}
+
+	_, err := s.w.Write(buf.Bytes())
+	return err
+}
+
+func newRecord(tx cosmosclient.TX) (Record, error) {
+	hash := tx.Raw.Hash.String()
+	raw, err := json.Marshal(tx.Raw)
+	if err != nil {
+		return Record{}, errors.Errorf("failed to encode raw TX %s: %w", hash, err)
+	}
+
+	txEvents, err := tx.GetEvents()
+	if err != nil {
+		return Record{}, err
+	}
+
+	events := make([]Event, len(txEvents))
+	for i, evt := range txEvents {
+		events[i] = Event{
+			Type:       evt.Type,
+			Attributes: make([]Attribute, len(evt.Attributes)),
+		}
+
+		for j, attr := range evt.Attributes {
+			events[i].Attributes[j] = Attribute{
+				Key:   attr.Key,
+				Value: attr.Value,
+			}
+		}
+	}
+
+	return Record{
+		Hash:      hash,
+		Index:     tx.Raw.Index,
+		Height:    tx.Raw.Height,
+		BlockTime: tx.BlockTime.UTC(),
+		Events:    events,
+		Raw:       raw,
+	}, nil
+}
@@ -0,0 +1,167 @@
+package jsonl_test
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	ctypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/v29/ignite/pkg/cosmosclient"
+	"github.com/ignite/cli/v29/ignite/pkg/cosmostxcollector/adapter/jsonl"
+)
+
+const (
+	hashA = "F2564C78071E26643AE9B3E2A19FA0DC10D4D9E873AA0BE808660123F11A1E78"
+	hashB = "0A1B2C3D4E5F60718293A4B5C6D7E8F90A1B2C3D4E5F60718293A4B5C6D7E8F9"
+)
+
+func TestWriterSink(t *testing.T) {
+	// Arrange
+	var buf bytes.Buffer
+
+	ctx := context.Background()
+	sink := jsonl.NewWriterSink(&buf)
+	tx := createTX(t, hashA, 5, abci.Event{
+		Type: "transfer",
+		Attributes: []abci.EventAttribute{
+			{Key: "recipient", Value: "cosmos1foo"},
+			{Key: "amount", Value: "42"},
+		},
+	})
+
+	// Act
+	require.NoError(t, sink.Init(ctx))
+	require.NoError(t, sink.Save(ctx, []cosmosclient.TX{tx}))
+
+	height, err := sink.GetLatestHeight(ctx)
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, int64(5), height)
+
+	lines := readLines(t, &buf)
+	require.Len(t, lines, 2)
+	require.JSONEq(t, `{"schema_version":1}`, lines[0])
+
+	var r jsonl.Record
+	require.NoError(t, json.Unmarshal([]byte(lines[1]), &r))
+	require.Equal(t, hashA, r.Hash)
+	require.Equal(t, int64(5), r.Height)
+	require.Equal(t, tx.BlockTime, r.BlockTime)
+	require.NotEmpty(t, r.Raw)
+	require.Equal(t, []jsonl.Event{
+		{
+			Type: "transfer",
+			Attributes: []jsonl.Attribute{
+				{Key: "recipient", Value: json.RawMessage(`"cosmos1foo"`)},
+				{Key: "amount", Value: json.RawMessage(`42`)},
+			},
+		},
+	}, r.Events)
+}
+
+func TestFileSinkAppend(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "txs.jsonl")
+
+	sink, err := jsonl.NewSink(path)
+	require.NoError(t, err)
+	require.NoError(t, sink.Init(ctx))
+	require.NoError(t, sink.Save(ctx, []cosmosclient.TX{createTX(t, hashA, 3)}))
+	require.NoError(t, sink.Close())
+
+	// Act: Reopen the file and continue appending
+	sink, err = jsonl.NewSink(path)
+	require.NoError(t, err)
+
+	defer sink.Close()
+
+	require.NoError(t, sink.Init(ctx))
+
+	height, err := sink.GetLatestHeight(ctx)
+	require.NoError(t, err)
+
+	require.NoError(t, sink.Save(ctx, []cosmosclient.TX{createTX(t, hashB, 4)}))
+
+	// Assert
+	require.Equal(t, int64(3), height, "expected the latest height to be read from the file")
+
+	f, err := os.Open(path)
+	require.NoError(t, err)
+
+	defer f.Close()
+
+	lines := readLines(t, f)
+	require.Len(t, lines, 3, "expected a single header and two records")
+}
+
+func TestFileSinkUnsupportedSchema(t *testing.T) {
+	// Arrange
+	path := filepath.Join(t.TempDir(), "txs.jsonl")
+	require.NoError(t, os.WriteFile(path, []byte(`{"schema_version":2}`+"\n"), 0o644))
+
+	sink, err := jsonl.NewSink(path)
+	require.NoError(t, err)
+
+	defer sink.Close()
+
+	// Act
+	err = sink.Init(context.Background())
+
+	// Assert
+	require.ErrorIs(t, err, jsonl.ErrUnsupportedSchema)
+}
+
+func TestSinkClosed(t *testing.T) {
+	// Arrange
+	sink := jsonl.NewWriterSink(&bytes.Buffer{})
+	require.NoError(t, sink.Close())
+
+	// Act
+	err := sink.Save(context.Background(), nil)
+
+	// Assert
+	require.ErrorIs(t, err, jsonl.ErrClosed)
+}
+
+func readLines(t *testing.T, r io.Reader) (lines []string) {
+	t.Helper()
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+
+	require.NoError(t, scanner.Err())
+
+	return lines
+}
+
+func createTX(t *testing.T, hash string, height int64, events ...abci.Event) cosmosclient.TX {
+	t.Helper()
+
+	h, err := hex.DecodeString(hash)
+	require.NoError(t, err)
+
+	return cosmosclient.TX{
+		BlockTime: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		Raw: &ctypes.ResultTx{
+			Hash:   h,
+			Height: height,
+			TxResult: abci.ExecTxResult{
+				Events: events,
+			},
+		},
+	}
+}
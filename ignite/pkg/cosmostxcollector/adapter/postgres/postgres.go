@@ -13,6 +13,7 @@ import (
 	ctypes "github.com/cometbft/cometbft/rpc/core/types"
 
 	"github.com/ignite/cli/v29/ignite/pkg/cosmosclient"
+	"github.com/ignite/cli/v29/ignite/pkg/cosmostxcollector/adapter/schema"
 	"github.com/ignite/cli/v29/ignite/pkg/cosmostxcollector/query"
 	"github.com/ignite/cli/v29/ignite/pkg/errors"
 )
@@ -46,6 +47,17 @@ const (
 		INSERT INTO attribute (event_id, name, value)
 		VALUES ($1, $2, $3)
 	`
+	sqlSelectCheckpoint = `
+		SELECT COALESCE(MAX(height), 0)
+		FROM checkpoint
+	`
+	sqlUpsertCheckpoint = `
+		INSERT INTO checkpoint (id, height)
+		VALUES (1, $1)
+		ON CONFLICT (id) DO UPDATE SET
+			height = GREATEST(checkpoint.height, excluded.height),
+			updated_at = CURRENT_TIMESTAMP
+	`
 	sqlInsertRawTX = `
 		INSERT INTO raw_tx (hash, data)
 		VALUES ($1, $2)
@@ -102,7 +114,7 @@ func NewAdapter(database string, options ...Option) (Adapter, error) {
 		host:     DefaultHost,
 		port:     DefaultPort,
 		database: database,
-		schemas:  NewSchemas(fsSchemas, ""),
+		schemas:  schema.New(fsSchemas, ""),
 	}
 
 	for _, o := range options {
@@ -125,12 +137,12 @@ type Adapter struct {
 	port                           uint
 	params                         map[string]string
 	db                             *sql.DB
-	schemas                        Schemas
+	schemas                        schema.Schemas
 }
 
 // UpdateSchema updates the database schema to the latest version available.
 // It applies all available schemas that were not applied already.
-func (a Adapter) UpdateSchema(ctx context.Context, s Schemas) error {
+func (a Adapter) UpdateSchema(ctx context.Context, s schema.Schemas) error {
 	db, err := a.getDB()
 	if err != nil {
 		return err
@@ -165,6 +177,31 @@ func (a Adapter) Init(ctx context.Context) error {
 }
 
 func (a Adapter) Save(ctx context.Context, txs []cosmosclient.TX) error {
+	return a.save(ctx, txs, nil)
+}
+
+// SaveBlock saves the transactions of a block and updates the checkpoint to
+// the block height within the same database transaction.
+func (a Adapter) SaveBlock(ctx context.Context, height int64, txs []cosmosclient.TX) error {
+	return a.save(ctx, txs, &height)
+}
+
+// GetCheckpoint returns the height of the latest block saved with SaveBlock.
+func (a Adapter) GetCheckpoint(ctx context.Context) (height int64, err error) {
+	db, err := a.getDB()
+	if err != nil {
+		return 0, err
+	}
+
+	row := db.QueryRowContext(ctx, sqlSelectCheckpoint)
+	if err = row.Scan(&height); err != nil {
+		return 0, err
+	}
+
+	return height, nil
+}
+
+func (a Adapter) save(ctx context.Context, txs []cosmosclient.TX, checkpoint *int64) error {
 	db, err := a.getDB()
 	if err != nil {
 		return err
@@ -214,6 +251,12 @@ func (a Adapter) Save(ctx context.Context, txs []cosmosclient.TX) error {
 		}
 	}
 
+	if checkpoint != nil {
+		if _, err := sqlTx.ExecContext(ctx, sqlUpsertCheckpoint, *checkpoint); err != nil {
+			return errors.Errorf("error saving checkpoint %d: %w", *checkpoint, err)
+		}
+	}
+
 	return sqlTx.Commit()
 }
 
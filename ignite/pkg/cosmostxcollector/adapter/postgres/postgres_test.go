@@ -17,6 +17,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/ignite/cli/v29/ignite/pkg/cosmosclient"
+	"github.com/ignite/cli/v29/ignite/pkg/cosmostxcollector/adapter/schema"
 	"github.com/ignite/cli/v29/ignite/pkg/cosmostxcollector/query"
 	"github.com/ignite/cli/v29/ignite/pkg/errors"
 )
@@ -43,7 +44,7 @@ func TestUpdateSchema(t *testing.T) {
 		"schemas/1.sql": &fstest.MapFile{Data: []byte(schemasData[0])},
 		"schemas/2.sql": &fstest.MapFile{Data: []byte(schemasData[1])},
 	}
-	s := NewSchemas(fs, "")
+	s := schema.New(fs, "")
 
 	// Arrange: Prepare database adapter
 	adapter := Adapter{
@@ -201,6 +202,87 @@ func TestGetLatestHeight(t *testing.T) {
 	require.NoError(t, mock.ExpectationsWereMet())
 }
 
+func TestSaveBlock(t *testing.T) {
+	// Arrange
+	db, mock := createMatchEqualSQLMock(t)
+	defer db.Close()
+
+	adapter := Adapter{db: db}
+	ctx := context.Background()
+	height := int64(42)
+
+	// Arrange: Database mock and expectations for a block without transactions
+	mock.ExpectBegin()
+	mock.ExpectPrepare(sqlInsertTX)
+	mock.ExpectPrepare(sqlInsertEvent)
+	mock.ExpectPrepare(sqlInsertEventAttr)
+	mock.
+		ExpectExec(sqlUpsertCheckpoint).
+		WithArgs(height).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	// Act
+	err := adapter.SaveBlock(ctx, height, nil)
+
+	// Assert
+	require.NoError(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSaveBlockCheckpointError(t *testing.T) {
+	// Arrange
+	db, mock := createMatchEqualSQLMock(t)
+	defer db.Close()
+
+	adapter := Adapter{db: db}
+	ctx := context.Background()
+	wantErr := errors.New("expected error")
+
+	// Arrange: Database mock and expectations
+	mock.ExpectBegin()
+	mock.ExpectPrepare(sqlInsertTX)
+	mock.ExpectPrepare(sqlInsertEvent)
+	mock.ExpectPrepare(sqlInsertEventAttr)
+	mock.
+		ExpectExec(sqlUpsertCheckpoint).
+		WillReturnError(wantErr)
+	mock.ExpectRollback()
+
+	// Act
+	err := adapter.SaveBlock(ctx, 1, nil)
+
+	// Assert
+	require.ErrorIs(t, err, wantErr)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetCheckpoint(t *testing.T) {
+	// Arrange
+	db, mock := createMatchEqualSQLMock(t)
+	defer db.Close()
+
+	adapter := Adapter{db: db}
+	ctx := context.Background()
+
+	// Arrange: Database mock and expectations
+	wantHeight := int64(42)
+
+	mock.
+		ExpectQuery(sqlSelectCheckpoint).
+		WillReturnRows(
+			sqlmock.NewRows([]string{"height"}).AddRow(wantHeight),
+		)
+
+	// Act
+	height, err := adapter.GetCheckpoint(ctx)
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, wantHeight, height)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
 func TestQuery(t *testing.T) {
 	// Arrange
 	var rowValue string
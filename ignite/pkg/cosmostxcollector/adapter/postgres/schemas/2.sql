@@ -0,0 +1,8 @@
+CREATE TABLE checkpoint (
+    id          SMALLINT NOT NULL,
+    height      BIGINT NOT NULL,
+    updated_at  TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT checkpoint_pk PRIMARY KEY (id),
+    CONSTRAINT checkpoint_single_row CHECK (id = 1)
+);
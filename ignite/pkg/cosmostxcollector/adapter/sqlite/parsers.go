@@ -0,0 +1,154 @@
+package sqlite
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ignite/cli/v29/ignite/pkg/cosmostxcollector/query"
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+)
+
+const (
+	eventAttrPrefix = "attribute."
+
+	sqlSelectAll = "SELECT *"
+	sqlWhereTrue = "WHERE true"
+
+	tplSelectEventsSQL = `
+		SELECT event.id, event."index", event.tx_hash, event."type", event.created_at
+		FROM event INNER JOIN tx ON event.tx_hash = tx.hash
+		%s
+		ORDER BY tx.height, tx."index", event."index"
+	`
+	tplSelectEventsWithAttrSQL = `
+		SELECT DISTINCT event.id, event."index", event.tx_hash, event."type", event.created_at
+		FROM event
+			INNER JOIN tx ON event.tx_hash = tx.hash
+			INNER JOIN attribute ON event.id = attribute.event_id
+		%s
+		ORDER BY tx.height, tx."index", event."index"
+	`
+)
+
+var (
+	ErrInvalidSortOrder = errors.New("invalid query sort order")
+	ErrFunctionQuery    = errors.New("function queries are not supported")
+)
+
+func parseQuery(q query.Query) (string, error) {
+	// SQLite doesn't support user defined functions in SQL
+	if len(q.Args()) > 0 {
+		return "", ErrFunctionQuery
+	}
+
+	sections := []string{
+		// Add SELECT
+		parseFields(q.Fields()),
+		// Add FROM
+		fmt.Sprintf("FROM %s", q.Name()),
+		// Add WHERE
+		parseFilters(q.Filters()),
+	}
+
+	// Add ORDER BY
+	sortBy, err := parseSortBy(q.SortBy())
+	if err != nil {
+		return "", err
+	}
+
+	if sortBy != "" {
+		sections = append(sections, sortBy)
+	}
+
+	// Add LIMIT/OFFSET
+	if s, ok := parsePaging(q); ok {
+		sections = append(sections, s)
+	}
+
+	return strings.Join(sections, " "), nil
+}
+
+func parseEventQuery(q query.EventQuery) string {
+	sql := tplSelectEventsSQL
+	filters := q.Filters()
+
+	// Check if any of the filters references an event attribute
+	// and if so add the required INNER JOIN to the raw SQL query.
+	// The JOIN is not present by default to improve events queries.
+	for _, f := range filters {
+		if strings.HasPrefix(f.Field(), eventAttrPrefix) {
+			sql = tplSelectEventsWithAttrSQL
+
+			break
+		}
+	}
+
+	// Add SELECT
+	sections := []string{
+		fmt.Sprintf(sql, parseFilters(filters)),
+	}
+
+	// Add LIMIT/OFFSET
+	if s, ok := parsePaging(q); ok {
+		sections = append(sections, s)
+	}
+
+	return strings.Join(sections, " ")
+}
+
+func parseFields(fields []string) string {
+	if len(fields) == 0 {
+		// By default select all fields
+		return sqlSelectAll
+	}
+
+	return fmt.Sprintf("SELECT DISTINCT %s", strings.Join(fields, ", "))
+}
+
+func parseFilters(filters []query.Filter) string {
+	if len(filters) == 0 {
+		return sqlWhereTrue
+	}
+
+	items := make([]string, len(filters))
+	for i, f := range filters {
+		items[i] = f.String()
+	}
+
+	return fmt.Sprintf("WHERE %s", strings.Join(items, " AND "))
+}
+
+func parseSortBy(sortInfo []query.SortBy) (string, error) {
+	if len(sortInfo) == 0 {
+		return "", nil
+	}
+
+	var items []string
+
+	for _, s := range sortInfo {
+		if s.Order != query.SortOrderAsc && s.Order != query.SortOrderDesc {
+			return "", ErrInvalidSortOrder
+		}
+
+		items = append(items, fmt.Sprintf("%s %s", s.Field, s.Order))
+	}
+
+	return fmt.Sprintf("ORDER BY %s", strings.Join(items, ", ")), nil
+}
+
+func parsePaging(q query.Pager) (string, bool) {
+	if !q.IsPagingEnabled() {
+		return "", false
+	}
+
+	// Get the current page and make sure that the page number is valid
+	page := q.AtPage()
+	if page == 0 {
+		page = 1
+	}
+
+	limit := q.PageSize()
+	offset := limit * (page - 1)
+
+	return fmt.Sprintf("LIMIT %d OFFSET %d", limit, offset), true
+}
@@ -0,0 +1,437 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"encoding/json"
+	"net/url"
+
+	ctypes "github.com/cometbft/cometbft/rpc/core/types"
+
+	// Register the pure Go SQLite database driver.
+	_ "modernc.org/sqlite"
+
+	"github.com/ignite/cli/v29/ignite/pkg/cosmosclient"
+	"github.com/ignite/cli/v29/ignite/pkg/cosmostxcollector/adapter/schema"
+	"github.com/ignite/cli/v29/ignite/pkg/cosmostxcollector/query"
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+)
+
+const (
+	adapterType = "sqlite"
+
+	sqlSelectBlockHeight = `
+		SELECT COALESCE(MAX(height), 0)
+		FROM tx
+	`
+	sqlSelectEventAttrs = `
+		SELECT event_id, name, value FROM attribute
+		WHERE event_id IN (SELECT value FROM json_each(?))
+		ORDER BY event_id
+	`
+	sqlInsertTX = `
+		INSERT INTO tx (hash, "index", height, block_time)
+		VALUES (?, ?, ?, ?)
+	`
+	sqlInsertEvent = `
+		INSERT INTO event (tx_hash, "type", "index")
+		VALUES (?, ?, ?)
+	`
+	sqlInsertEventAttr = `
+		INSERT INTO attribute (event_id, name, value)
+		VALUES (?, ?, ?)
+	`
+	sqlSelectCheckpoint = `
+		SELECT COALESCE(MAX(height), 0)
+		FROM checkpoint
+	`
+	sqlUpsertCheckpoint = `
+		INSERT INTO checkpoint (id, height)
+		VALUES (1, ?)
+		ON CONFLICT (id) DO UPDATE SET
+			height = MAX(checkpoint.height, excluded.height),
+			updated_at = CURRENT_TIMESTAMP
+	`
+	sqlInsertRawTX = `
+		INSERT INTO raw_tx (hash, data)
+		VALUES (?, ?)
+	`
+)
+
+//go:embed schemas/*
+var fsSchemas embed.FS
+
+// ErrClosed is returned when database connection is not open.
+var ErrClosed = errors.New("no database connection")
+
+// Option defines an option for the adapter.
+type Option func(*Adapter)
+
+// WithParams configures extra database connection parameters,
+// for example "_pragma" values to change the SQLite settings.
+func WithParams(params map[string]string) Option {
+	return func(a *Adapter) {
+		a.params = params
+	}
+}
+
+// NewAdapter creates a new SQLite adapter that saves the data in a database file.
+// The database file is created when it doesn't exist.
+func NewAdapter(path string, options ...Option) (Adapter, error) {
+	adapter := Adapter{
+		path:    path,
+		schemas: schema.New(fsSchemas, ""),
+	}
+
+	for _, o := range options {
+		o(&adapter)
+	}
+
+	db, err := sql.Open("sqlite", createSQLiteDSN(adapter))
+	if err != nil {
+		return Adapter{}, err
+	}
+
+	// SQLite doesn't support concurrent writes so a single connection is used
+	// which also allows using in-memory databases.
+	db.SetMaxOpenConns(1)
+
+	adapter.db = db
+
+	return adapter, nil
+}
+
+// Adapter implements a data backend adapter for SQLite.
+// It is an embedded alternative to the PostgreSQL adapter that doesn't require a database server.
+type Adapter struct {
+	path    string
+	params  map[string]string
+	db      *sql.DB
+	schemas schema.Schemas
+}
+
+// UpdateSchema updates the database schema to the latest version available.
+// It applies all available schemas that were not applied already.
+func (a Adapter) UpdateSchema(ctx context.Context, s schema.Schemas) error {
+	db, err := a.getDB()
+	if err != nil {
+		return err
+	}
+
+	// Create the schema table if it doesn't exist
+	if _, err := db.ExecContext(ctx, s.GetTableDDL()); err != nil {
+		return errors.Errorf("failed to check schema table: %w", err)
+	}
+
+	// Get the current schema version
+	var v uint64
+	if err := db.QueryRowContext(ctx, s.GetSchemaVersionSQL()).Scan(&v); err != nil {
+		return errors.Errorf("failed to read current schema version: %w", err)
+	}
+
+	return s.WalkFrom(v+1, func(version uint64, script []byte) error {
+		if _, err := db.ExecContext(ctx, string(script)); err != nil {
+			return errors.Errorf("error applying schema version %d: %w", version, err)
+		}
+
+		return nil
+	})
+}
+
+// Close closes the database.
+func (a Adapter) Close() error {
+	db, err := a.getDB()
+	if err != nil {
+		return err
+	}
+
+	return db.Close()
+}
+
+func (a Adapter) GetType() string {
+	return adapterType
+}
+
+func (a Adapter) Init(ctx context.Context) error {
+	return a.UpdateSchema(ctx, a.schemas)
+}
+
+func (a Adapter) Save(ctx context.Context, txs []cosmosclient.TX) error {
+	return a.save(ctx, txs, nil)
+}
+
+// SaveBlock saves the transactions of a block and updates the checkpoint to
+// the block height within the same database transaction.
+func (a Adapter) SaveBlock(ctx context.Context, height int64, txs []cosmosclient.TX) error {
+	return a.save(ctx, txs, &height)
+}
+
+// GetCheckpoint returns the height of the latest block saved with SaveBlock.
+func (a Adapter) GetCheckpoint(ctx context.Context) (height int64, err error) {
+	db, err := a.getDB()
+	if err != nil {
+		return 0, err
+	}
+
+	row := db.QueryRowContext(ctx, sqlSelectCheckpoint)
+	if err = row.Scan(&height); err != nil {
+		return 0, err
+	}
+
+	return height, nil
+}
+
+func (a Adapter) save(ctx context.Context, txs []cosmosclient.TX, checkpoint *int64) error {
+	db, err := a.getDB()
+	if err != nil {
+		return err
+	}
+
+	// Start a transaction
+	sqlTx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	// Rollback won't have any effect if the transaction is committed before
+	defer sqlTx.Rollback() //nolint:errcheck
+
+	// Prepare insert statements to speed up "bulk" saving times
+	txStmt, err := sqlTx.PrepareContext(ctx, sqlInsertTX)
+	if err != nil {
+		return err
+	}
+
+	defer txStmt.Close()
+
+	evtStmt, err := sqlTx.PrepareContext(ctx, sqlInsertEvent)
+	if err != nil {
+		return err
+	}
+
+	defer evtStmt.Close()
+
+	attrStmt, err := sqlTx.PrepareContext(ctx, sqlInsertEventAttr)
+	if err != nil {
+		return err
+	}
+
+	defer attrStmt.Close()
+
+	// All the transactions are saved within the context of the same database
+	// transactions and because of that either all block transactions are
+	// saved or none of them.
+	for _, tx := range txs {
+		if err := saveRawTX(ctx, sqlTx, tx.Raw); err != nil {
+			return err
+		}
+
+		if err := saveTX(ctx, txStmt, evtStmt, attrStmt, tx); err != nil {
+			return err
+		}
+	}
+
+	if checkpoint != nil {
+		if _, err := sqlTx.ExecContext(ctx, sqlUpsertCheckpoint, *checkpoint); err != nil {
+			return errors.Errorf("error saving checkpoint %d: %w", *checkpoint, err)
+		}
+	}
+
+	return sqlTx.Commit()
+}
+
+func (a Adapter) GetLatestHeight(ctx context.Context) (height int64, err error) {
+	db, err := a.getDB()
+	if err != nil {
+		return 0, err
+	}
+
+	row := db.QueryRowContext(ctx, sqlSelectBlockHeight)
+	if err = row.Scan(&height); err != nil {
+		return 0, err
+	}
+
+	return height, nil
+}
+
+func (a Adapter) QueryEvents(ctx context.Context, q query.EventQuery) ([]query.Event, error) {
+	db, err := a.getDB()
+	if err != nil {
+		return nil, err
+	}
+
+	sql := parseEventQuery(q)
+	args := extractEventQueryArgs(q)
+	rows, err := db.QueryContext(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var (
+		events   []query.Event
+		eventIDs []int64
+
+		// Keep an index of the event position within the events slice
+		// to find them later when updating their attributes.
+		eventIndexes = make(map[int64]int)
+	)
+
+	for i := 0; rows.Next(); i++ {
+		e := query.Event{}
+		if err := rows.Scan(&e.ID, &e.Index, &e.TXHash, &e.Type, &e.CreatedAt); err != nil {
+			return nil, errors.Errorf("failed to read event: %w", err)
+		}
+
+		events = append(events, e)
+		eventIDs = append(eventIDs, e.ID)
+
+		eventIndexes[e.ID] = i
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	// Don't query attributes when there are no events
+	if len(events) == 0 {
+		return events, nil
+	}
+
+	// Select the attributes for the events that matched the query
+	attrRows, err := db.QueryContext(ctx, sqlSelectEventAttrs, jsonArray(eventIDs))
+	if err != nil {
+		return nil, err
+	}
+
+	defer attrRows.Close()
+
+	// Update the attributes of the selected events
+	for attrRows.Next() {
+		var (
+			eventID int64
+			name    string
+			value   string
+		)
+
+		if err := attrRows.Scan(&eventID, &name, &value); err != nil {
+			return nil, errors.Errorf("failed to read event attribute: %w", err)
+		}
+
+		i := eventIndexes[eventID]
+		events[i].Attributes = append(events[i].Attributes, query.NewAttribute(name, []byte(value)))
+	}
+
+	return events, attrRows.Err()
+}
+
+func (a Adapter) Query(ctx context.Context, q query.Query) (query.Cursor, error) {
+	db, err := a.getDB()
+	if err != nil {
+		return nil, err
+	}
+
+	sql, err := parseQuery(q)
+	if err != nil {
+		return nil, err
+	}
+
+	args := extractQueryArgs(q)
+	rows, err := db.QueryContext(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return rows, nil
+}
+
+func (a Adapter) getDB() (*sql.DB, error) {
+	if a.db == nil {
+		return nil, ErrClosed
+	}
+
+	return a.db, nil
+}
+
+func createSQLiteDSN(a Adapter) string {
+	val := url.Values{}
+
+	// Enable the foreign keys to delete the events and attributes in cascade
+	val.Add("_pragma", "foreign_keys(1)")
+	val.Add("_pragma", "busy_timeout(5000)")
+
+	// Add extra params as query arguments
+	for k, v := range a.params {
+		val.Add(k, v)
+	}
+
+	return "file:" + a.path + "?" + val.Encode()
+}
+
+func saveRawTX(ctx context.Context, sqlTx *sql.Tx, rtx *ctypes.ResultTx) error {
+	hash := rtx.Hash.String()
+	raw, err := json.Marshal(rtx)
+	if err != nil {
+		return errors.Errorf("failed to encode raw TX %s: %w", hash, err)
+	}
+
+	if _, err := sqlTx.ExecContext(ctx, sqlInsertRawTX, hash, string(raw)); err != nil {
+		return errors.Errorf("error saving raw TX %s: %w", hash, err)
+	}
+
+	return nil
+}
+
+func saveTX(ctx context.Context, txStmt, evtStmt, attrStmt *sql.Stmt, tx cosmosclient.TX) error {
+	hash := tx.Raw.Hash.String()
+	if _, err := txStmt.ExecContext(ctx, hash, tx.Raw.Index, tx.Raw.Height, tx.BlockTime.UTC()); err != nil {
+		return errors.Errorf("error saving TX %s: %w", hash, err)
+	}
+
+	events, err := tx.GetEvents()
+	if err != nil {
+		return err
+	}
+
+	for i, evt := range events {
+		res, err := evtStmt.ExecContext(ctx, hash, evt.Type, i)
+		if err != nil {
+			return errors.Errorf("error saving event '%s': %w", evt.Type, err)
+		}
+
+		evtID, err := res.LastInsertId()
+		if err != nil {
+			return errors.Errorf("error reading event ID: %w", err)
+		}
+
+		for _, attr := range evt.Attributes {
+			if _, err := attrStmt.ExecContext(ctx, evtID, attr.Key, string(attr.Value)); err != nil {
+				return errors.Errorf("error saving event attr '%s.%s': %w", evt.Type, attr.Key, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func extractQueryArgs(q query.Query) (args []any) {
+	for _, f := range q.Filters() {
+		if a := f.Value(); a != nil {
+			args = append(args, a)
+		}
+	}
+
+	return args
+}
+
+func extractEventQueryArgs(q query.EventQuery) (args []any) {
+	for _, f := range q.Filters() {
+		if a := f.Value(); a != nil {
+			args = append(args, a)
+		}
+	}
+
+	return args
+}
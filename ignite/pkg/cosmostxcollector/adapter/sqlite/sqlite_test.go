@@ -0,0 +1,412 @@
+package sqlite
+
+import (
+	"context"
+	"encoding/hex"
+	"path/filepath"
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	ctypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/v29/ignite/pkg/cosmosclient"
+	"github.com/ignite/cli/v29/ignite/pkg/cosmostxcollector/query"
+)
+
+const (
+	hashA = "F2564C78071E26643AE9B3E2A19FA0DC10D4D9E873AA0BE808660123F11A1E78"
+	hashB = "0A1B2C3D4E5F60718293A4B5C6D7E8F90A1B2C3D4E5F60718293A4B5C6D7E8F9"
+)
+
+func TestUpdateSchema(t *testing.T) {
+	// Arrange
+	adapter := createAdapter(t)
+	ctx := context.Background()
+
+	// Act
+	err := adapter.Init(ctx)
+
+	// Assert: Applying the schemas again must not fail
+	require.NoError(t, err)
+
+	var version uint64
+	err = adapter.db.QueryRowContext(ctx, adapter.schemas.GetSchemaVersionSQL()).Scan(&version)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), version)
+}
+
+func TestSave(t *testing.T) {
+	// Arrange
+	adapter := createAdapter(t)
+	ctx := context.Background()
+	txs := []cosmosclient.TX{
+		createTX(t, hashA, 1, abci.Event{
+			Type: "transfer",
+			Attributes: []abci.EventAttribute{
+				{Key: "recipient", Value: "cosmos1crje20aj4gxdtyct7z3knxqry2jqt2fuaey6u5"},
+				{Key: "amount", Value: "42"},
+			},
+		}),
+	}
+
+	// Act
+	err := adapter.Save(ctx, txs)
+
+	// Assert
+	require.NoError(t, err)
+
+	var count int
+	err = adapter.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM raw_tx").Scan(&count)
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+
+	err = adapter.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM attribute").Scan(&count)
+	require.NoError(t, err)
+	require.Equal(t, 2, count)
+}
+
+func TestSaveRollback(t *testing.T) {
+	// Arrange
+	adapter := createAdapter(t)
+	ctx := context.Background()
+
+	// Arrange: The same TX twice fails because of the TX primary key
+	tx := createTX(t, hashA, 1)
+
+	// Act
+	err := adapter.Save(ctx, []cosmosclient.TX{tx, tx})
+
+	// Assert
+	require.Error(t, err)
+
+	height, err := adapter.GetLatestHeight(ctx)
+	require.NoError(t, err)
+	require.Zero(t, height, "expected no transactions to be saved")
+}
+
+func TestGetLatestHeight(t *testing.T) {
+	// Arrange
+	adapter := createAdapter(t)
+	ctx := context.Background()
+
+	// Act
+	emptyHeight, err := adapter.GetLatestHeight(ctx)
+	require.NoError(t, err)
+
+	err = adapter.Save(ctx, []cosmosclient.TX{
+		createTX(t, hashA, 7),
+		createTX(t, hashB, 3),
+	})
+	require.NoError(t, err)
+
+	height, err := adapter.GetLatestHeight(ctx)
+
+	// Assert
+	require.NoError(t, err)
+	require.Zero(t, emptyHeight)
+	require.Equal(t, int64(7), height)
+}
+
+func TestSaveBlock(t *testing.T) {
+	// Arrange
+	adapter := createAdapter(t)
+	ctx := context.Background()
+
+	// Act: Save a block with transactions followed by a block without them
+	err := adapter.SaveBlock(ctx, 3, []cosmosclient.TX{createTX(t, hashA, 3)})
+	require.NoError(t, err)
+
+	err = adapter.SaveBlock(ctx, 5, nil)
+	require.NoError(t, err)
+
+	checkpoint, err := adapter.GetCheckpoint(ctx)
+	require.NoError(t, err)
+
+	height, err := adapter.GetLatestHeight(ctx)
+	require.NoError(t, err)
+
+	// Assert
+	require.Equal(t, int64(5), checkpoint)
+	require.Equal(t, int64(3), height)
+}
+
+func TestSaveBlockRollback(t *testing.T) {
+	// Arrange
+	adapter := createAdapter(t)
+	ctx := context.Background()
+	tx := createTX(t, hashA, 1)
+
+	err := adapter.SaveBlock(ctx, 1, []cosmosclient.TX{tx})
+	require.NoError(t, err)
+
+	// Act: Saving the same TX again fails
+	err = adapter.SaveBlock(ctx, 2, []cosmosclient.TX{tx})
+
+	// Assert: The checkpoint is not updated
+	require.Error(t, err)
+
+	checkpoint, err := adapter.GetCheckpoint(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), checkpoint)
+}
+
+func TestQuery(t *testing.T) {
+	// Arrange
+	adapter := createAdapter(t)
+	ctx := context.Background()
+
+	err := adapter.Save(ctx, []cosmosclient.TX{
+		createTX(t, hashA, 1),
+		createTX(t, hashB, 2),
+	})
+	require.NoError(t, err)
+
+	qry := query.New(
+		"tx",
+		query.Fields("height"),
+		query.SortByFields(query.SortOrderDesc, "height"),
+		query.WithPageSize(1),
+		query.AtPage(2),
+	)
+
+	// Act
+	cr, err := adapter.Query(ctx, qry)
+	require.NoError(t, err)
+
+	defer cr.Close()
+
+	var heights []int64
+	for cr.Next() {
+		var h int64
+		require.NoError(t, cr.Scan(&h))
+		heights = append(heights, h)
+	}
+
+	// Assert
+	require.NoError(t, cr.Err())
+	require.Equal(t, []int64{1}, heights)
+}
+
+func TestQueryWithFilter(t *testing.T) {
+	// Arrange
+	adapter := createAdapter(t)
+	ctx := context.Background()
+
+	err := adapter.Save(ctx, []cosmosclient.TX{
+		createTX(t, hashA, 1),
+		createTX(t, hashB, 2),
+	})
+	require.NoError(t, err)
+
+	qry := query.New(
+		"tx",
+		query.Fields("hash"),
+		query.WithFilters(NewFilter("height", 2)),
+	)
+
+	// Act
+	cr, err := adapter.Query(ctx, qry)
+	require.NoError(t, err)
+
+	defer cr.Close()
+
+	var hashes []string
+	for cr.Next() {
+		var h string
+		require.NoError(t, cr.Scan(&h))
+		hashes = append(hashes, h)
+	}
+
+	// Assert
+	require.NoError(t, cr.Err())
+	require.Equal(t, []string{hashB}, hashes)
+}
+
+func TestQueryErrors(t *testing.T) {
+	// Arrange
+	adapter := createAdapter(t)
+	ctx := context.Background()
+
+	cases := []struct {
+		name string
+		qry  query.Query
+		err  error
+	}{
+		{
+			name: "function query",
+			qry:  query.New("foo", query.WithArgs("bar")),
+			err:  ErrFunctionQuery,
+		},
+		{
+			name: "invalid sort order",
+			qry:  query.New("tx", query.WithSortBy(query.SortBy{Field: "height", Order: "foo"})),
+			err:  ErrInvalidSortOrder,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Act
+			_, err := adapter.Query(ctx, tc.qry)
+
+			// Assert
+			require.ErrorIs(t, err, tc.err)
+		})
+	}
+}
+
+func TestEventQueryWithFilters(t *testing.T) {
+	// Arrange
+	adapter := createAdapter(t)
+	ctx := context.Background()
+
+	err := adapter.Save(ctx, []cosmosclient.TX{
+		createTX(t, hashA, 1,
+			abci.Event{
+				Type:       "transfer",
+				Attributes: []abci.EventAttribute{{Key: "amount", Value: "42"}},
+			},
+			abci.Event{
+				Type:       "message",
+				Attributes: []abci.EventAttribute{{Key: "sender", Value: "cosmos1foo"}},
+			},
+		),
+		createTX(t, hashB, 2,
+			abci.Event{
+				Type:       "transfer",
+				Attributes: []abci.EventAttribute{{Key: "amount", Value: "7"}},
+			},
+		),
+	})
+	require.NoError(t, err)
+
+	cases := []struct {
+		name    string
+		filters []query.Filter
+		want    []string
+	}{
+		{
+			name:    "no filters",
+			filters: nil,
+			want:    []string{hashA + "/transfer", hashA + "/message", hashB + "/transfer"},
+		},
+		{
+			name:    "event type",
+			filters: []query.Filter{FilterByEventType("transfer")},
+			want:    []string{hashA + "/transfer", hashB + "/transfer"},
+		},
+		{
+			name:    "event TX hashes",
+			filters: []query.Filter{FilterByEventTXs(hashB)},
+			want:    []string{hashB + "/transfer"},
+		},
+		{
+			name: "event attribute string value",
+			filters: []query.Filter{
+				FilterByEventAttrName("sender"),
+				FilterByEventAttrValue("cosmos1foo"),
+			},
+			want: []string{hashA + "/message"},
+		},
+		{
+			name: "event attribute int value",
+			filters: []query.Filter{
+				FilterByEventAttrName("amount"),
+				FilterByEventAttrValueInt(7),
+			},
+			want: []string{hashB + "/transfer"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			qry := query.NewEventQuery(query.WithFilters(tc.filters...))
+
+			// Act
+			events, err := adapter.QueryEvents(ctx, qry)
+
+			// Assert
+			require.NoError(t, err)
+
+			var got []string
+			for _, e := range events {
+				got = append(got, e.TXHash+"/"+e.Type)
+				require.NotEmpty(t, e.Attributes)
+				require.False(t, e.CreatedAt.IsZero())
+			}
+
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestEventQueryAttributes(t *testing.T) {
+	// Arrange
+	adapter := createAdapter(t)
+	ctx := context.Background()
+
+	err := adapter.Save(ctx, []cosmosclient.TX{
+		createTX(t, hashA, 1, abci.Event{
+			Type:       "transfer",
+			Attributes: []abci.EventAttribute{{Key: "amount", Value: "42"}},
+		}),
+	})
+	require.NoError(t, err)
+
+	// Act
+	events, err := adapter.QueryEvents(ctx, query.NewEventQuery())
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	require.Len(t, events[0].Attributes, 1)
+	require.Equal(t, "amount", events[0].Attributes[0].Name)
+
+	v, err := events[0].Attributes[0].Value()
+	require.NoError(t, err)
+	require.EqualValues(t, 42, v)
+}
+
+func TestClosed(t *testing.T) {
+	// Arrange
+	adapter := Adapter{}
+
+	// Act
+	_, err := adapter.GetLatestHeight(context.Background())
+
+	// Assert
+	require.ErrorIs(t, err, ErrClosed)
+}
+
+func createAdapter(t *testing.T) Adapter {
+	t.Helper()
+
+	adapter, err := NewAdapter(filepath.Join(t.TempDir(), "collector.db"))
+	require.NoError(t, err)
+
+	t.Cleanup(func() { adapter.Close() })
+
+	require.NoError(t, adapter.Init(context.Background()))
+
+	return adapter
+}
+
+func createTX(t *testing.T, hash string, height int64, events ...abci.Event) cosmosclient.TX {
+	t.Helper()
+
+	h, err := hex.DecodeString(hash)
+	require.NoError(t, err)
+
+	return cosmosclient.TX{
+		BlockTime: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		Raw: &ctypes.ResultTx{
+			Hash:   h,
+			Height: height,
+			TxResult: abci.ExecTxResult{
+				Events: events,
+			},
+		},
+	}
+}
@@ -0,0 +1,276 @@
+package cosmostxcollector
+
+import (
+	"context"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+
+	"github.com/ignite/cli/v29/ignite/pkg/cosmosclient"
+	"github.com/ignite/cli/v29/ignite/pkg/cosmostxcollector/adapter"
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+)
+
+const (
+	// DefaultFollowWorkers is the default number of blocks fetched in parallel while backfilling.
+	DefaultFollowWorkers = 4
+
+	// DefaultPollInterval is the default interval used to check for new blocks.
+	DefaultPollInterval = 5 * time.Second
+)
+
+var (
+	// ErrFollowNotSupported is returned when the collector client doesn't support following new blocks.
+	ErrFollowNotSupported = errors.New("the client doesn't support following new blocks")
+
+	// ErrChainBehind is returned when the data backend contains blocks that the chain doesn't have,
+	// which happens when the chain is reset after the blocks were collected.
+	ErrChainBehind = errors.New("the data backend contains blocks newer than the latest chain block")
+)
+
+// BlockClient defines the interface for Cosmos clients that support following new blocks.
+type BlockClient interface {
+	TXsCollector
+
+	// LatestBlockHeight returns the latest block height.
+	LatestBlockHeight(ctx context.Context) (int64, error)
+
+	// GetBlockTXs returns the transactions of a block.
+	GetBlockTXs(ctx context.Context, height int64) ([]cosmosclient.TX, error)
+
+	// SubscribeNewBlocks subscribes to new blocks and sends their height to the returned channel.
+	SubscribeNewBlocks(ctx context.Context) (<-chan int64, error)
+}
+
+// FollowOption configures the follow mode of the collector.
+type FollowOption func(*followOptions)
+
+type followOptions struct {
+	fromHeight   int64
+	workers      int
+	pollInterval time.Duration
+	onBlock      func(height int64, txs []cosmosclient.TX)
+	onError      func(error)
+}
+
+// FollowFromHeight sets the block height to start collecting from when the data
+// backend doesn't contain collected blocks yet.
+func FollowFromHeight(height int64) FollowOption {
+	return func(o *followOptions) {
+		o.fromHeight = height
+	}
+}
+
+// FollowWorkers sets the maximum number of blocks fetched in parallel while
+// backfilling the blocks missing in the data backend.
+func FollowWorkers(n int) FollowOption {
+	return func(o *followOptions) {
+		if n > 0 {
+			o.workers = n
+		}
+	}
+}
+
+// FollowPollInterval sets the interval used to check for new blocks.
+// Polling is used when the new block subscription is not available and also to
+// recover blocks whose subscription events were lost.
+func FollowPollInterval(d time.Duration) FollowOption {
+	return func(o *followOptions) {
+		if d > 0 {
+			o.pollInterval = d
+		}
+	}
+}
+
+// FollowOnBlock sets a function that is called after the transactions of each block are saved.
+func FollowOnBlock(fn func(height int64, txs []cosmosclient.TX)) FollowOption {
+	return func(o *followOptions) {
+		o.onBlock = fn
+	}
+}
+
+// FollowOnError sets a function that is called when the client fails to fetch blocks.
+// Fetch errors don't stop the collection, fetching is retried after the poll interval.
+func FollowOnError(fn func(error)) FollowOption {
+	return func(o *followOptions) {
+		o.onError = fn
+	}
+}
+
+// Follow collects the transactions of new blocks until the context is done.
+//
+// Collection resumes at the block next to the latest one saved in the data backend,
+// which is read from the checkpoint when the data backend implements adapter.Checkpointer
+// or otherwise from the height of the latest saved transaction. Blocks missing between
+// that height and the latest block are backfilled fetching them with parallel workers,
+// but they are always saved in order so there are no block height gaps in the data
+// backend when the collection is interrupted.
+//
+// New blocks are received using the client subscription and checked periodically
+// when the subscription is not available. Errors fetching blocks are retried, which
+// allows following a chain that is restarted, while errors saving the blocks stop
+// the collection.
+func (c Collector) Follow(ctx context.Context, options ...FollowOption) error {
+	client, ok := c.client.(BlockClient)
+	if !ok {
+		return ErrFollowNotSupported
+	}
+
+	o := followOptions{
+		workers:      DefaultFollowWorkers,
+		pollInterval: DefaultPollInterval,
+	}
+	for _, apply := range options {
+		apply(&o)
+	}
+
+	height, err := c.resumeHeight(ctx)
+	if err != nil {
+		return err
+	}
+
+	next := max(height+1, o.fromHeight, 1)
+
+	// The subscription is optional, when it fails new blocks are polled
+	blocks, err := client.SubscribeNewBlocks(ctx)
+	if err != nil {
+		blocks = nil
+	}
+
+	ticker := time.NewTicker(o.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		next, err = c.collectUntilLatest(ctx, client, next, o)
+		if err != nil {
+			var fetchErr fetchError
+			if !errors.As(err, &fetchErr) || ctx.Err() != nil {
+				return err
+			}
+
+			if o.onError != nil {
+				o.onError(fetchErr.err)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		case _, ok := <-blocks:
+			if !ok {
+				// Keep polling when the subscription is closed
+				blocks = nil
+			}
+		}
+	}
+}
+
+// resumeHeight returns the height of the latest block saved in the data backend.
+func (c Collector) resumeHeight(ctx context.Context) (height int64, err error) {
+	if db, ok := c.db.(adapter.Checkpointer); ok {
+		if height, err = db.GetCheckpoint(ctx); err != nil {
+			return 0, errors.Errorf("failed to read checkpoint: %w", err)
+		}
+	}
+
+	// The latest transaction height is also checked for data backends that
+	// contain transactions collected before the checkpoint support was added.
+	if db, ok := c.db.(interface {
+		GetLatestHeight(context.Context) (int64, error)
+	}); ok {
+		latest, err := db.GetLatestHeight(ctx)
+		if err != nil {
+			return 0, errors.Errorf("failed to read latest saved block height: %w", err)
+		}
+
+		height = max(height, latest)
+	}
+
+	return height, nil
+}
+
+// collectUntilLatest collects the blocks from a height until the latest block
+// and returns the height of the next block to collect.
+func (c Collector) collectUntilLatest(ctx context.Context, client BlockClient, next int64, o followOptions) (int64, error) {
+	latest, err := client.LatestBlockHeight(ctx)
+	if err != nil {
+		return next, fetchError{errors.Errorf("failed to fetch latest block height: %w", err)}
+	}
+
+	if latest < next-1 {
+		return next, errors.Wrapf(ErrChainBehind, "latest chain block is %d and the latest collected block is %d", latest, next-1)
+	}
+
+	return c.backfill(ctx, client, next, latest, o)
+}
+
+// backfill collects the transactions of the blocks between two heights.
+// Blocks are fetched in parallel in groups of at most the number of workers and
+// each group is saved sequentially to avoid block height gaps.
+// The height of the next block to collect is returned, even on error.
+func (c Collector) backfill(ctx context.Context, client BlockClient, from, to int64, o followOptions) (int64, error) {
+	for start := from; start <= to; start += int64(o.workers) {
+		end := min(start+int64(o.workers)-1, to)
+		blocks := make([][]cosmosclient.TX, end-start+1)
+
+		wg, wctx := errgroup.WithContext(ctx)
+		for i := range blocks {
+			height := start + int64(i)
+
+			wg.Go(func() error {
+				txs, err := client.GetBlockTXs(wctx, height)
+				if err != nil {
+					return fetchError{err}
+				}
+
+				blocks[i] = txs
+
+				return nil
+			})
+		}
+
+		if err := wg.Wait(); err != nil {
+			return start, err
+		}
+
+		for i, txs := range blocks {
+			height := start + int64(i)
+			if err := c.saveBlock(ctx, height, txs); err != nil {
+				return height, err
+			}
+
+			if o.onBlock != nil {
+				o.onBlock(height, txs)
+			}
+		}
+	}
+
+	return max(from, to+1), nil
+}
+
+func (c Collector) saveBlock(ctx context.Context, height int64, txs []cosmosclient.TX) error {
+	if db, ok := c.db.(adapter.Checkpointer); ok {
+		return db.SaveBlock(ctx, height, txs)
+	}
+
+	// Blocks without transactions can't be saved without checkpoint support
+	if len(txs) == 0 {
+		return nil
+	}
+
+	return c.db.Save(ctx, txs)
+}
+
+// fetchError is an error fetching blocks from the client.
+type fetchError struct {
+	err error
+}
+
+func (e fetchError) Error() string {
+	return e.err.Error()
+}
+
+func (e fetchError) Unwrap() error {
+	return e.err
+}
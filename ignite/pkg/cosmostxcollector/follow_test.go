@@ -0,0 +1,321 @@
+package cosmostxcollector_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	ctypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/v29/ignite/pkg/cosmosclient"
+	"github.com/ignite/cli/v29/ignite/pkg/cosmostxcollector"
+	"github.com/ignite/cli/v29/ignite/pkg/cosmostxcollector/mocks"
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+)
+
+func TestFollowBackfill(t *testing.T) {
+	// Arrange
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	// Arrange: A chain with 10 blocks where every even block has a transaction
+	client := newFakeBlockClient(10)
+	db := &fakeCheckpointDB{checkpoint: 3}
+
+	c := cosmostxcollector.New(db, client)
+
+	// Act
+	err := c.Follow(
+		ctx,
+		cosmostxcollector.FollowWorkers(3),
+		cosmostxcollector.FollowPollInterval(time.Hour),
+		cosmostxcollector.FollowOnBlock(func(height int64, _ []cosmosclient.TX) {
+			if height == 10 {
+				cancel()
+			}
+		}),
+	)
+
+	// Assert: Blocks are saved in order starting after the checkpoint
+	require.ErrorIs(t, err, context.Canceled)
+	require.Equal(t, []int64{4, 5, 6, 7, 8, 9, 10}, db.heights)
+	require.Equal(t, int64(10), db.checkpoint)
+	require.Len(t, db.txs, 4)
+}
+
+func TestFollowResumeFromLatestHeight(t *testing.T) {
+	// Arrange
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	client := newFakeBlockClient(6)
+	db := &fakeDB{latestHeight: 2}
+
+	c := cosmostxcollector.New(db, client)
+
+	// Act
+	err := c.Follow(
+		ctx,
+		cosmostxcollector.FollowFromHeight(1),
+		cosmostxcollector.FollowPollInterval(time.Hour),
+		cosmostxcollector.FollowOnBlock(func(height int64, _ []cosmosclient.TX) {
+			if height == 6 {
+				cancel()
+			}
+		}),
+	)
+
+	// Assert: Only the transactions of blocks 4 and 6 are saved
+	require.ErrorIs(t, err, context.Canceled)
+	require.Len(t, db.txs, 2)
+	require.Equal(t, int64(4), db.txs[0].Raw.Height)
+	require.Equal(t, int64(6), db.txs[1].Raw.Height)
+}
+
+func TestFollowNewBlocks(t *testing.T) {
+	// Arrange
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	client := newFakeBlockClient(2)
+	db := &fakeCheckpointDB{}
+
+	c := cosmostxcollector.New(db, client)
+
+	// Act: Produce a new block after the existing blocks are collected
+	err := c.Follow(
+		ctx,
+		cosmostxcollector.FollowPollInterval(time.Hour),
+		cosmostxcollector.FollowOnBlock(func(height int64, _ []cosmosclient.TX) {
+			switch height {
+			case 2:
+				client.produceBlock()
+			case 3:
+				cancel()
+			}
+		}),
+	)
+
+	// Assert
+	require.ErrorIs(t, err, context.Canceled)
+	require.Equal(t, []int64{1, 2, 3}, db.heights)
+}
+
+func TestFollowPolling(t *testing.T) {
+	// Arrange
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	// Arrange: A client without subscription support
+	client := newFakeBlockClient(1)
+	client.subscribeErr = errors.New("websocket not available")
+
+	db := &fakeCheckpointDB{}
+
+	c := cosmostxcollector.New(db, client)
+
+	// Act
+	err := c.Follow(
+		ctx,
+		cosmostxcollector.FollowPollInterval(10*time.Millisecond),
+		cosmostxcollector.FollowOnBlock(func(height int64, _ []cosmosclient.TX) {
+			switch height {
+			case 1:
+				client.produceBlock()
+			case 2:
+				cancel()
+			}
+		}),
+	)
+
+	// Assert
+	require.ErrorIs(t, err, context.Canceled)
+	require.Equal(t, []int64{1, 2}, db.heights)
+}
+
+func TestFollowRetryFetchError(t *testing.T) {
+	// Arrange
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	wantErr := errors.New("expected error")
+
+	client := newFakeBlockClient(2)
+	client.blockErrs = map[int64]error{2: wantErr}
+
+	db := &fakeCheckpointDB{}
+
+	var fetchErrs []error
+
+	c := cosmostxcollector.New(db, client)
+
+	// Act
+	err := c.Follow(
+		ctx,
+		cosmostxcollector.FollowPollInterval(10*time.Millisecond),
+		cosmostxcollector.FollowOnError(func(err error) {
+			fetchErrs = append(fetchErrs, err)
+		}),
+		cosmostxcollector.FollowOnBlock(func(height int64, _ []cosmosclient.TX) {
+			if height == 2 {
+				cancel()
+			}
+		}),
+	)
+
+	// Assert
+	require.ErrorIs(t, err, context.Canceled)
+	require.Equal(t, []int64{1, 2}, db.heights)
+	require.Len(t, fetchErrs, 1)
+	require.ErrorIs(t, fetchErrs[0], wantErr)
+}
+
+func TestFollowWithSaveError(t *testing.T) {
+	// Arrange
+	wantErr := errors.New("expected error")
+
+	client := newFakeBlockClient(2)
+	db := &fakeCheckpointDB{saveErr: wantErr}
+
+	c := cosmostxcollector.New(db, client)
+
+	// Act
+	err := c.Follow(context.Background(), cosmostxcollector.FollowPollInterval(time.Hour))
+
+	// Assert
+	require.ErrorIs(t, err, wantErr)
+}
+
+func TestFollowChainBehind(t *testing.T) {
+	// Arrange: A chain that was reset after collecting 5 blocks
+	client := newFakeBlockClient(2)
+	db := &fakeCheckpointDB{checkpoint: 5}
+
+	c := cosmostxcollector.New(db, client)
+
+	// Act
+	err := c.Follow(context.Background(), cosmostxcollector.FollowPollInterval(time.Hour))
+
+	// Assert
+	require.ErrorIs(t, err, cosmostxcollector.ErrChainBehind)
+	require.Empty(t, db.heights)
+}
+
+func TestFollowNotSupported(t *testing.T) {
+	// Arrange
+	client := mocks.NewTXsCollector(t)
+	db := mocks.NewSaver(t)
+
+	c := cosmostxcollector.New(db, client)
+
+	// Act
+	err := c.Follow(context.Background())
+
+	// Assert
+	require.ErrorIs(t, err, cosmostxcollector.ErrFollowNotSupported)
+}
+
+// fakeBlockClient is a block client for a chain where only even blocks contain transactions.
+type fakeBlockClient struct {
+	mu           sync.Mutex
+	latest       int64
+	blocks       chan int64
+	blockErrs    map[int64]error
+	subscribeErr error
+}
+
+func newFakeBlockClient(latest int64) *fakeBlockClient {
+	return &fakeBlockClient{
+		latest: latest,
+		blocks: make(chan int64, 1),
+	}
+}
+
+func (c *fakeBlockClient) produceBlock() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.latest++
+	c.blocks <- c.latest
+}
+
+func (c *fakeBlockClient) CollectTXs(context.Context, int64, chan<- []cosmosclient.TX) error {
+	return nil
+}
+
+func (c *fakeBlockClient) LatestBlockHeight(context.Context) (int64, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.latest, nil
+}
+
+func (c *fakeBlockClient) GetBlockTXs(_ context.Context, height int64) ([]cosmosclient.TX, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// Fail only once to fetch the block
+	if err, ok := c.blockErrs[height]; ok {
+		delete(c.blockErrs, height)
+		return nil, err
+	}
+
+	if height%2 != 0 {
+		return nil, nil
+	}
+
+	return []cosmosclient.TX{{Raw: &ctypes.ResultTx{Height: height}}}, nil
+}
+
+func (c *fakeBlockClient) SubscribeNewBlocks(context.Context) (<-chan int64, error) {
+	if c.subscribeErr != nil {
+		return nil, c.subscribeErr
+	}
+
+	return c.blocks, nil
+}
+
+// fakeCheckpointDB is a data backend that supports checkpoints.
+type fakeCheckpointDB struct {
+	checkpoint int64
+	heights    []int64
+	txs        []cosmosclient.TX
+	saveErr    error
+}
+
+func (db *fakeCheckpointDB) Save(_ context.Context, txs []cosmosclient.TX) error {
+	db.txs = append(db.txs, txs...)
+	return db.saveErr
+}
+
+func (db *fakeCheckpointDB) SaveBlock(ctx context.Context, height int64, txs []cosmosclient.TX) error {
+	if err := db.Save(ctx, txs); err != nil {
+		return err
+	}
+
+	db.heights = append(db.heights, height)
+	db.checkpoint = height
+
+	return nil
+}
+
+func (db *fakeCheckpointDB) GetCheckpoint(context.Context) (int64, error) {
+	return db.checkpoint, nil
+}
+
+// fakeDB is a data backend without checkpoint support.
+type fakeDB struct {
+	latestHeight int64
+	txs          []cosmosclient.TX
+}
+
+func (db *fakeDB) Save(_ context.Context, txs []cosmosclient.TX) error {
+	db.txs = append(db.txs, txs...)
+	return nil
+}
+
+func (db *fakeDB) GetLatestHeight(context.Context) (int64, error) {
+	return db.latestHeight, nil
+}
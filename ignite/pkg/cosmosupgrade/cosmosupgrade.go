@@ -0,0 +1,194 @@
+// Package cosmosupgrade creates the upgrade info of chain releases and the
+// governance proposals that schedule software upgrades with the x/upgrade module.
+package cosmosupgrade
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	sdkaddress "github.com/cosmos/cosmos-sdk/types/address"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+)
+
+const (
+	// InfoFile is the name of the upgrade info file of a release.
+	InfoFile = "upgrade-info.json"
+
+	// MsgSoftwareUpgradeType is the type URL of the software upgrade message.
+	MsgSoftwareUpgradeType = "/cosmos.upgrade.v1beta1.MsgSoftwareUpgrade"
+
+	checksumAlgo = "sha256"
+
+	govModuleName = "gov"
+)
+
+// Info is the upgrade info of a release in the format used by cosmovisor to
+// download the binaries of an upgrade. Its JSON is used as the info of upgrade plans.
+type Info struct {
+	// Binaries maps platforms (GOOS/GOARCH) to binary URLs.
+	Binaries map[string]string `json:"binaries"`
+}
+
+// URLData holds the values available in the binary URL templates.
+type URLData struct {
+	// Version is the version of the release.
+	Version string
+
+	// OS is the target operating system.
+	OS string
+
+	// Arch is the target architecture.
+	Arch string
+
+	// Tarball is the file name of the target's tarball.
+	Tarball string
+}
+
+// Platform returns the cosmovisor platform of a target.
+func Platform(goos, goarch string) string {
+	return fmt.Sprintf("%s/%s", goos, goarch)
+}
+
+// BinaryURL renders the URL template of a target's binary and appends the
+// checksum of the binary tarball that cosmovisor verifies after download.
+func BinaryURL(urlTemplate string, data URLData, checksum string) (string, error) {
+	t, err := template.New("url").Option("missingkey=error").Parse(urlTemplate)
+	if err != nil {
+		return "", errors.Errorf("invalid URL template: %w", err)
+	}
+
+	var url bytes.Buffer
+	if err := t.Execute(&url, data); err != nil {
+		return "", errors.Errorf("invalid URL template: %w", err)
+	}
+
+	separator := "?"
+	if strings.Contains(url.String(), "?") {
+		separator = "&"
+	}
+
+	return fmt.Sprintf("%s%schecksum=%s:%s", url.String(), separator, checksumAlgo, checksum), nil
+}
+
+// Add adds the binary URL of a target.
+func (i *Info) Add(goos, goarch, url string) {
+	if i.Binaries == nil {
+		i.Binaries = make(map[string]string)
+	}
+	i.Binaries[Platform(goos, goarch)] = url
+}
+
+// EstimateHeight estimates the height of the block committed at the target time,
+// from the latest block and the average block time.
+func EstimateHeight(latestHeight int64, latestTime time.Time, blockTime time.Duration, target time.Time) (int64, error) {
+	if blockTime <= 0 {
+		return 0, errors.Errorf("invalid block time %s", blockTime)
+	}
+	if !target.After(latestTime) {
+		return 0, errors.Errorf("upgrade time %s is before the latest block time %s", target, latestTime)
+	}
+
+	return latestHeight + int64(target.Sub(latestTime)/blockTime), nil
+}
+
+type (
+	// Proposal is a governance proposal in the format of the gov submit-proposal command.
+	Proposal struct {
+		Messages  []MsgSoftwareUpgrade `json:"messages"`
+		Metadata  string               `json:"metadata"`
+		Deposit   string               `json:"deposit"`
+		Title     string               `json:"title"`
+		Summary   string               `json:"summary"`
+		Expedited bool                 `json:"expedited"`
+	}
+
+	// MsgSoftwareUpgrade is the message scheduling a software upgrade.
+	MsgSoftwareUpgrade struct {
+		Type      string `json:"@type"`
+		Authority string `json:"authority"`
+		Plan      Plan   `json:"plan"`
+	}
+
+	// Plan is the plan of a software upgrade.
+	Plan struct {
+		Name   string `json:"name"`
+		Height string `json:"height"`
+		Info   string `json:"info"`
+	}
+)
+
+// GovAuthority returns the address of the gov module account, which is the
+// authority of software upgrade messages.
+func GovAuthority(bech32Prefix string) (string, error) {
+	return address.NewBech32Codec(bech32Prefix).BytesToString(sdkaddress.Module(govModuleName))
+}
+
+// ProposalOptions configures a software upgrade proposal.
+type ProposalOptions struct {
+	// Name is the name of the upgrade, it must match the upgrade handler of the new binary.
+	Name string
+
+	// Height is the height of the upgrade.
+	Height int64
+
+	// Info is the upgrade info of the release.
+	Info Info
+
+	// Authority is the address of the gov module account.
+	Authority string
+
+	// Title, Summary, Metadata, Deposit and Expedited are the proposal fields.
+	Title     string
+	Summary   string
+	Metadata  string
+	Deposit   string
+	Expedited bool
+}
+
+// NewProposal creates a software upgrade proposal.
+func NewProposal(o ProposalOptions) (Proposal, error) {
+	if o.Name == "" {
+		return Proposal{}, errors.New("upgrade name is required")
+	}
+	if o.Height <= 0 {
+		return Proposal{}, errors.Errorf("invalid upgrade height %d", o.Height)
+	}
+
+	info, err := json.Marshal(o.Info)
+	if err != nil {
+		return Proposal{}, err
+	}
+
+	title := o.Title
+	if title == "" {
+		title = fmt.Sprintf("Software upgrade %s", o.Name)
+	}
+	summary := o.Summary
+	if summary == "" {
+		summary = fmt.Sprintf("Upgrade the chain to %s at height %d.", o.Name, o.Height)
+	}
+
+	return Proposal{
+		Messages: []MsgSoftwareUpgrade{{
+			Type:      MsgSoftwareUpgradeType,
+			Authority: o.Authority,
+			Plan: Plan{
+				Name:   o.Name,
+				Height: strconv.FormatInt(o.Height, 10),
+				Info:   string(info),
+			},
+		}},
+		Metadata:  o.Metadata,
+		Deposit:   o.Deposit,
+		Title:     title,
+		Summary:   summary,
+		Expedited: o.Expedited,
+	}, nil
+}
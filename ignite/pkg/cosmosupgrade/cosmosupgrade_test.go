@@ -0,0 +1,128 @@
+package cosmosupgrade_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/v29/ignite/pkg/cosmosupgrade"
+)
+
+func TestBinaryURL(t *testing.T) {
+	data := cosmosupgrade.URLData{
+		Version: "1.2.0",
+		OS:      "linux",
+		Arch:    "amd64",
+		Tarball: "mars_linux_amd64.tar.gz",
+	}
+
+	tests := []struct {
+		name     string
+		template string
+		want     string
+		err      string
+	}{
+		{
+			name:     "release download URL",
+			template: "https://github.com/ignite/mars/releases/download/v{{.Version}}/{{.Tarball}}",
+			want:     "https://github.com/ignite/mars/releases/download/v1.2.0/mars_linux_amd64.tar.gz?checksum=sha256:abc",
+		},
+		{
+			name:     "URL with query",
+			template: "https://example.com/mars?os={{.OS}}&arch={{.Arch}}",
+			want:     "https://example.com/mars?os=linux&arch=amd64&checksum=sha256:abc",
+		},
+		{
+			name:     "unknown field",
+			template: "https://example.com/{{.Name}}",
+			err:      "invalid URL template",
+		},
+		{
+			name:     "invalid template",
+			template: "https://example.com/{{.Tarball",
+			err:      "invalid URL template",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := cosmosupgrade.BinaryURL(tt.template, data, "abc")
+			if tt.err != "" {
+				require.ErrorContains(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestInfo(t *testing.T) {
+	var info cosmosupgrade.Info
+	info.Add("linux", "amd64", "https://example.com/linux")
+	info.Add("darwin", "arm64", "https://example.com/darwin")
+
+	bz, err := json.Marshal(info)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"binaries":{"darwin/arm64":"https://example.com/darwin","linux/amd64":"https://example.com/linux"}}`, string(bz))
+}
+
+func TestEstimateHeight(t *testing.T) {
+	latest := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	height, err := cosmosupgrade.EstimateHeight(1000, latest, 5*time.Second, latest.Add(time.Hour))
+	require.NoError(t, err)
+	require.EqualValues(t, 1720, height)
+
+	_, err = cosmosupgrade.EstimateHeight(1000, latest, 5*time.Second, latest.Add(-time.Hour))
+	require.ErrorContains(t, err, "before the latest block time")
+
+	_, err = cosmosupgrade.EstimateHeight(1000, latest, 0, latest.Add(time.Hour))
+	require.ErrorContains(t, err, "invalid block time")
+}
+
+func TestGovAuthority(t *testing.T) {
+	authority, err := cosmosupgrade.GovAuthority("cosmos")
+	require.NoError(t, err)
+	require.Equal(t, "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn", authority)
+}
+
+func TestNewProposal(t *testing.T) {
+	var info cosmosupgrade.Info
+	info.Add("linux", "amd64", "https://example.com/linux?checksum=sha256:abc")
+
+	proposal, err := cosmosupgrade.NewProposal(cosmosupgrade.ProposalOptions{
+		Name:      "v2",
+		Height:    1720,
+		Info:      info,
+		Authority: "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn",
+		Deposit:   "10000000stake",
+	})
+	require.NoError(t, err)
+
+	bz, err := json.Marshal(proposal)
+	require.NoError(t, err)
+	require.JSONEq(t, `{
+		"messages": [{
+			"@type": "/cosmos.upgrade.v1beta1.MsgSoftwareUpgrade",
+			"authority": "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn",
+			"plan": {
+				"name": "v2",
+				"height": "1720",
+				"info": "{\"binaries\":{\"linux/amd64\":\"https://example.com/linux?checksum=sha256:abc\"}}"
+			}
+		}],
+		"metadata": "",
+		"deposit": "10000000stake",
+		"title": "Software upgrade v2",
+		"summary": "Upgrade the chain to v2 at height 1720.",
+		"expedited": false
+	}`, string(bz))
+
+	_, err = cosmosupgrade.NewProposal(cosmosupgrade.ProposalOptions{Name: "v2"})
+	require.ErrorContains(t, err, "invalid upgrade height")
+
+	_, err = cosmosupgrade.NewProposal(cosmosupgrade.ProposalOptions{Height: 10})
+	require.ErrorContains(t, err, "upgrade name is required")
+}
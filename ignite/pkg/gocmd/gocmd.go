@@ -54,6 +54,8 @@ const (
 	EnvGOMOD = "GOMOD"
 	// EnvGOOS represents GOOS variable.
 	EnvGOOS = "GOOS"
+	// EnvGOVERSION represents GOVERSION variable.
+	EnvGOVERSION = "GOVERSION"
 
 	// FlagGcflags represents gcflags go flag.
 	FlagGcflags = "-gcflags"
@@ -69,6 +71,8 @@ const (
 	FlagModValueReadOnly = "readonly"
 	// FlagOut represents out go flag.
 	FlagOut = "-o"
+	// FlagTrimpath represents trimpath go flag.
+	FlagTrimpath = "-trimpath"
 )
 
 // Env returns the value of `go env name`.
@@ -0,0 +1,191 @@
+// Package provenance creates in-toto provenance statements for build artifacts
+// and signs them in DSSE envelopes.
+package provenance
+
+import (
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+
+	"github.com/ignite/cli/v29/ignite/pkg/checksum"
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+)
+
+const (
+	// StatementType is the type of in-toto v1 statements.
+	StatementType = "https://in-toto.io/Statement/v1"
+
+	// PredicateTypeSLSA is the type of SLSA v1 provenance predicates.
+	PredicateTypeSLSA = "https://slsa.dev/provenance/v1"
+
+	// PayloadType is the DSSE payload type of in-toto statements.
+	PayloadType = "application/vnd.in-toto+json"
+
+	// DigestSHA256 is the digest algorithm used for subjects.
+	DigestSHA256 = "sha256"
+)
+
+// ErrInvalidSignature is returned when an envelope is not signed by the expected key.
+var ErrInvalidSignature = errors.New("invalid provenance signature")
+
+type (
+	// Statement is an in-toto v1 statement.
+	Statement struct {
+		Type          string    `json:"_type"`
+		Subject       []Subject `json:"subject"`
+		PredicateType string    `json:"predicateType"`
+		Predicate     Predicate `json:"predicate"`
+	}
+
+	// Subject is an artifact described by a statement.
+	Subject struct {
+		Name   string            `json:"name"`
+		Digest map[string]string `json:"digest"`
+	}
+
+	// Predicate is a SLSA v1 provenance predicate.
+	Predicate struct {
+		BuildDefinition BuildDefinition `json:"buildDefinition"`
+		RunDetails      RunDetails      `json:"runDetails"`
+	}
+
+	// BuildDefinition describes the inputs of a build.
+	BuildDefinition struct {
+		BuildType            string               `json:"buildType"`
+		ExternalParameters   map[string]any       `json:"externalParameters"`
+		InternalParameters   map[string]any       `json:"internalParameters,omitempty"`
+		ResolvedDependencies []ResourceDescriptor `json:"resolvedDependencies,omitempty"`
+	}
+
+	// ResourceDescriptor describes a resource used by a build.
+	ResourceDescriptor struct {
+		URI    string            `json:"uri,omitempty"`
+		Name   string            `json:"name,omitempty"`
+		Digest map[string]string `json:"digest,omitempty"`
+	}
+
+	// RunDetails describes the builder that run a build.
+	RunDetails struct {
+		Builder Builder `json:"builder"`
+	}
+
+	// Builder identifies the builder.
+	Builder struct {
+		ID      string            `json:"id"`
+		Version map[string]string `json:"version,omitempty"`
+	}
+)
+
+// NewStatement creates a SLSA provenance statement for subjects.
+func NewStatement(subjects []Subject, predicate Predicate) Statement {
+	return Statement{
+		Type:          StatementType,
+		Subject:       subjects,
+		PredicateType: PredicateTypeSLSA,
+		Predicate:     predicate,
+	}
+}
+
+// FileSubjects creates subjects from files, named by their base name.
+func FileSubjects(paths ...string) ([]Subject, error) {
+	subjects := make([]Subject, 0, len(paths))
+	for _, path := range paths {
+		digest, err := checksum.File(path)
+		if err != nil {
+			return nil, err
+		}
+		subjects = append(subjects, Subject{
+			Name:   filepath.Base(path),
+			Digest: map[string]string{DigestSHA256: digest},
+		})
+	}
+	return subjects, nil
+}
+
+// Signer signs provenance statements.
+type Signer interface {
+	// KeyID returns an identifier of the signing key.
+	KeyID() string
+
+	// Sign signs a message.
+	Sign(msg []byte) ([]byte, error)
+}
+
+type (
+	// Envelope is a DSSE envelope holding a signed statement.
+	Envelope struct {
+		PayloadType string      `json:"payloadType"`
+		Payload     string      `json:"payload"`
+		Signatures  []Signature `json:"signatures"`
+	}
+
+	// Signature is a signature of a DSSE envelope.
+	Signature struct {
+		KeyID string `json:"keyid,omitempty"`
+		Sig   string `json:"sig"`
+	}
+)
+
+// Sign signs a statement and wraps it into a DSSE envelope.
+func Sign(s Statement, signer Signer) (Envelope, error) {
+	payload, err := json.Marshal(s)
+	if err != nil {
+		return Envelope{}, err
+	}
+
+	sig, err := signer.Sign(pae(PayloadType, payload))
+	if err != nil {
+		return Envelope{}, errors.Errorf("error signing provenance: %w", err)
+	}
+
+	return Envelope{
+		PayloadType: PayloadType,
+		Payload:     base64.StdEncoding.EncodeToString(payload),
+		Signatures: []Signature{{
+			KeyID: signer.KeyID(),
+			Sig:   base64.StdEncoding.EncodeToString(sig),
+		}},
+	}, nil
+}
+
+// Verify checks that the envelope is signed by the ed25519 public key
+// and returns the statement it holds.
+func Verify(e Envelope, pubKey ed25519.PublicKey) (Statement, error) {
+	if e.PayloadType != PayloadType {
+		return Statement{}, errors.Errorf("unexpected payload type %q", e.PayloadType)
+	}
+
+	payload, err := base64.StdEncoding.DecodeString(e.Payload)
+	if err != nil {
+		return Statement{}, err
+	}
+
+	msg := pae(e.PayloadType, payload)
+	verified := false
+	for _, s := range e.Signatures {
+		sig, err := base64.StdEncoding.DecodeString(s.Sig)
+		if err != nil {
+			return Statement{}, err
+		}
+		if ed25519.Verify(pubKey, msg, sig) {
+			verified = true
+			break
+		}
+	}
+	if !verified {
+		return Statement{}, ErrInvalidSignature
+	}
+
+	var s Statement
+	if err := json.Unmarshal(payload, &s); err != nil {
+		return Statement{}, err
+	}
+	return s, nil
+}
+
+// pae returns the DSSE pre-authentication encoding of a payload.
+func pae(payloadType string, payload []byte) []byte {
+	return fmt.Appendf(nil, "DSSEv1 %d %s %d %s", len(payloadType), payloadType, len(payload), payload)
+}
@@ -0,0 +1,79 @@
+package provenance_test
+
+import (
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/v29/ignite/pkg/provenance"
+)
+
+type keySigner struct {
+	key ed25519.PrivateKey
+}
+
+func (s keySigner) KeyID() string { return "test" }
+
+func (s keySigner) Sign(msg []byte) ([]byte, error) {
+	return ed25519.Sign(s.key, msg), nil
+}
+
+func TestFileSubjects(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "mars_linux_amd64.tar.gz")
+	require.NoError(t, os.WriteFile(path, []byte("mars"), 0o600))
+
+	subjects, err := provenance.FileSubjects(path)
+	require.NoError(t, err)
+
+	sum := sha256.Sum256([]byte("mars"))
+	require.Equal(t, []provenance.Subject{{
+		Name:   "mars_linux_amd64.tar.gz",
+		Digest: map[string]string{provenance.DigestSHA256: hex.EncodeToString(sum[:])},
+	}}, subjects)
+
+	_, err = provenance.FileSubjects(filepath.Join(t.TempDir(), "missing"))
+	require.Error(t, err)
+}
+
+func TestSignAndVerify(t *testing.T) {
+	pubKey, privKey, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+	otherPubKey, _, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+
+	statement := provenance.NewStatement(
+		[]provenance.Subject{{Name: "mars", Digest: map[string]string{provenance.DigestSHA256: "abc"}}},
+		provenance.Predicate{
+			BuildDefinition: provenance.BuildDefinition{
+				BuildType:          "https://example.com/build/v1",
+				ExternalParameters: map[string]any{"targets": []any{"linux:amd64"}},
+			},
+			RunDetails: provenance.RunDetails{
+				Builder: provenance.Builder{ID: "https://example.com/builder"},
+			},
+		},
+	)
+
+	envelope, err := provenance.Sign(statement, keySigner{privKey})
+	require.NoError(t, err)
+	require.Equal(t, provenance.PayloadType, envelope.PayloadType)
+	require.Len(t, envelope.Signatures, 1)
+	require.Equal(t, "test", envelope.Signatures[0].KeyID)
+
+	got, err := provenance.Verify(envelope, pubKey)
+	require.NoError(t, err)
+	require.Equal(t, statement, got)
+
+	_, err = provenance.Verify(envelope, otherPubKey)
+	require.ErrorIs(t, err, provenance.ErrInvalidSignature)
+
+	envelope.Payload = base64.StdEncoding.EncodeToString([]byte(`{"_type":"tampered"}`))
+	_, err = provenance.Verify(envelope, pubKey)
+	require.ErrorIs(t, err, provenance.ErrInvalidSignature)
+}
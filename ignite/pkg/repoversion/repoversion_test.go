@@ -42,6 +42,7 @@ func TestDetermineWithoutTag(t *testing.T) {
 	require.NoError(t, err)
 	require.Empty(t, v.Tag)
 	require.Equal(t, headHash, v.Hash)
+	require.Equal(t, int64(100), v.Time.Unix())
 }
 
 func TestDetermineWithTagOnHead(t *testing.T) {
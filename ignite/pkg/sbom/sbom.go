@@ -0,0 +1,246 @@
+// Package sbom generates software bills of materials for Go modules in the
+// CycloneDX and SPDX JSON formats.
+package sbom
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"golang.org/x/mod/modfile"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/pkg/gomodule"
+)
+
+const (
+	cycloneDXSpecVersion = "1.5"
+	spdxVersion          = "SPDX-2.3"
+	spdxDataLicense      = "CC0-1.0"
+	spdxNoAssertion      = "NOASSERTION"
+	spdxDocumentID       = "SPDXRef-DOCUMENT"
+	spdxNamespace        = "https://spdx.org/spdxdocs/"
+)
+
+// SBOM is the bill of materials of a Go module.
+type SBOM struct {
+	// Name is the Go module path.
+	Name string
+
+	// Version is the version of the module.
+	Version string
+
+	// Tool is the name and version of the tool that generated the SBOM.
+	Tool string
+
+	// Created is the creation time of the SBOM.
+	Created time.Time
+
+	// Dependencies are the dependencies of the module, including indirect ones,
+	// with replacements applied.
+	Dependencies []gomodule.Version
+}
+
+// FromGoMod creates an SBOM from a parsed go.mod file.
+func FromGoMod(f *modfile.File, version, tool string, created time.Time) (SBOM, error) {
+	if f.Module == nil {
+		return SBOM{}, errors.New("go.mod has no module directive")
+	}
+
+	deps, err := gomodule.ResolveDependencies(f, true)
+	if err != nil {
+		return SBOM{}, err
+	}
+
+	return SBOM{
+		Name:         f.Module.Mod.Path,
+		Version:      version,
+		Tool:         tool,
+		Created:      created.UTC(),
+		Dependencies: deps,
+	}, nil
+}
+
+// PURL returns the package URL of a Go module.
+func PURL(path, version string) string {
+	if version == "" {
+		return fmt.Sprintf("pkg:golang/%s", path)
+	}
+	return fmt.Sprintf("pkg:golang/%s@%s", path, version)
+}
+
+type (
+	cycloneDXDocument struct {
+		BOMFormat    string                `json:"bomFormat"`
+		SpecVersion  string                `json:"specVersion"`
+		Version      int                   `json:"version"`
+		Metadata     cycloneDXMetadata     `json:"metadata"`
+		Components   []cycloneDXComponent  `json:"components"`
+		Dependencies []cycloneDXDependency `json:"dependencies"`
+	}
+
+	cycloneDXMetadata struct {
+		Timestamp string             `json:"timestamp"`
+		Tools     []cycloneDXTool    `json:"tools,omitempty"`
+		Component cycloneDXComponent `json:"component"`
+	}
+
+	cycloneDXTool struct {
+		Name string `json:"name"`
+	}
+
+	cycloneDXComponent struct {
+		Type    string `json:"type"`
+		BOMRef  string `json:"bom-ref"`
+		Name    string `json:"name"`
+		Version string `json:"version,omitempty"`
+		PURL    string `json:"purl"`
+	}
+
+	cycloneDXDependency struct {
+		Ref       string   `json:"ref"`
+		DependsOn []string `json:"dependsOn"`
+	}
+)
+
+// CycloneDX returns the SBOM as a CycloneDX JSON document.
+func (s SBOM) CycloneDX() ([]byte, error) {
+	main := cycloneDXComponent{
+		Type:    "application",
+		BOMRef:  PURL(s.Name, s.Version),
+		Name:    s.Name,
+		Version: s.Version,
+		PURL:    PURL(s.Name, s.Version),
+	}
+
+	doc := cycloneDXDocument{
+		BOMFormat:   "CycloneDX",
+		SpecVersion: cycloneDXSpecVersion,
+		Version:     1,
+		Metadata: cycloneDXMetadata{
+			Timestamp: s.Created.Format(time.RFC3339),
+			Component: main,
+		},
+		Components: []cycloneDXComponent{},
+	}
+	if s.Tool != "" {
+		doc.Metadata.Tools = []cycloneDXTool{{Name: s.Tool}}
+	}
+
+	dependsOn := []string{}
+	for _, dep := range s.Dependencies {
+		purl := PURL(dep.Path, dep.Version)
+		doc.Components = append(doc.Components, cycloneDXComponent{
+			Type:    "library",
+			BOMRef:  purl,
+			Name:    dep.Path,
+			Version: dep.Version,
+			PURL:    purl,
+		})
+		dependsOn = append(dependsOn, purl)
+	}
+	doc.Dependencies = []cycloneDXDependency{{Ref: main.BOMRef, DependsOn: dependsOn}}
+
+	return json.MarshalIndent(doc, "", "  ")
+}
+
+type (
+	spdxDocument struct {
+		SPDXVersion       string             `json:"spdxVersion"`
+		DataLicense       string             `json:"dataLicense"`
+		SPDXID            string             `json:"SPDXID"`
+		Name              string             `json:"name"`
+		DocumentNamespace string             `json:"documentNamespace"`
+		CreationInfo      spdxCreationInfo   `json:"creationInfo"`
+		Packages          []spdxPackage      `json:"packages"`
+		Relationships     []spdxRelationship `json:"relationships"`
+	}
+
+	spdxCreationInfo struct {
+		Created  string   `json:"created"`
+		Creators []string `json:"creators"`
+	}
+
+	spdxPackage struct {
+		Name             string            `json:"name"`
+		SPDXID           string            `json:"SPDXID"`
+		VersionInfo      string            `json:"versionInfo,omitempty"`
+		DownloadLocation string            `json:"downloadLocation"`
+		FilesAnalyzed    bool              `json:"filesAnalyzed"`
+		ExternalRefs     []spdxExternalRef `json:"externalRefs"`
+	}
+
+	spdxExternalRef struct {
+		ReferenceCategory string `json:"referenceCategory"`
+		ReferenceType     string `json:"referenceType"`
+		ReferenceLocator  string `json:"referenceLocator"`
+	}
+
+	spdxRelationship struct {
+		SPDXElementID      string `json:"spdxElementId"`
+		RelationshipType   string `json:"relationshipType"`
+		RelatedSPDXElement string `json:"relatedSpdxElement"`
+	}
+)
+
+// SPDX returns the SBOM as an SPDX JSON document.
+func (s SBOM) SPDX() ([]byte, error) {
+	creators := []string{"Organization: " + spdxNoAssertion}
+	if s.Tool != "" {
+		creators = []string{"Tool: " + s.Tool}
+	}
+
+	doc := spdxDocument{
+		SPDXVersion: spdxVersion,
+		DataLicense: spdxDataLicense,
+		SPDXID:      spdxDocumentID,
+		Name:        s.Name,
+		CreationInfo: spdxCreationInfo{
+			Created:  s.Created.Format(time.RFC3339),
+			Creators: creators,
+		},
+	}
+
+	newPackage := func(id, path, version string) spdxPackage {
+		return spdxPackage{
+			Name:             path,
+			SPDXID:           id,
+			VersionInfo:      version,
+			DownloadLocation: spdxNoAssertion,
+			ExternalRefs: []spdxExternalRef{{
+				ReferenceCategory: "PACKAGE-MANAGER",
+				ReferenceType:     "purl",
+				ReferenceLocator:  PURL(path, version),
+			}},
+		}
+	}
+
+	const mainID = "SPDXRef-Package-0"
+	doc.Packages = append(doc.Packages, newPackage(mainID, s.Name, s.Version))
+	doc.Relationships = append(doc.Relationships, spdxRelationship{
+		SPDXElementID:      spdxDocumentID,
+		RelationshipType:   "DESCRIBES",
+		RelatedSPDXElement: mainID,
+	})
+
+	// the namespace must be unique for each document, derive it from the content
+	// instead of a random value to keep the document reproducible.
+	h := sha256.New()
+	fmt.Fprintf(h, "%s@%s\n", s.Name, s.Version)
+
+	for i, dep := range s.Dependencies {
+		id := fmt.Sprintf("SPDXRef-Package-%d", i+1)
+		doc.Packages = append(doc.Packages, newPackage(id, dep.Path, dep.Version))
+		doc.Relationships = append(doc.Relationships, spdxRelationship{
+			SPDXElementID:      mainID,
+			RelationshipType:   "DEPENDS_ON",
+			RelatedSPDXElement: id,
+		})
+		fmt.Fprintf(h, "%s@%s\n", dep.Path, dep.Version)
+	}
+
+	doc.DocumentNamespace = fmt.Sprintf("%s%s-%x", spdxNamespace, s.Name, h.Sum(nil))
+
+	return json.MarshalIndent(doc, "", "  ")
+}
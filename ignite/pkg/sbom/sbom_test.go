@@ -0,0 +1,124 @@
+package sbom_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/mod/modfile"
+
+	"github.com/ignite/cli/v29/ignite/pkg/sbom"
+)
+
+const goMod = `module github.com/ignite/mars
+
+go 1.24
+
+require (
+	cosmossdk.io/math v1.5.3
+	github.com/cosmos/cosmos-sdk v0.53.6
+	github.com/spf13/cobra v1.9.1 // indirect
+)
+
+replace github.com/cosmos/cosmos-sdk => ../cosmos-sdk
+`
+
+func newSBOM(t *testing.T) sbom.SBOM {
+	t.Helper()
+
+	f, err := modfile.Parse("go.mod", []byte(goMod), nil)
+	require.NoError(t, err)
+
+	s, err := sbom.FromGoMod(f, "1.0.0", "ignite", time.Unix(1700000000, 0))
+	require.NoError(t, err)
+	return s
+}
+
+func TestFromGoMod(t *testing.T) {
+	s := newSBOM(t)
+
+	require.Equal(t, "github.com/ignite/mars", s.Name)
+	require.Equal(t, "1.0.0", s.Version)
+	require.Len(t, s.Dependencies, 3)
+	require.Equal(t, "../cosmos-sdk", s.Dependencies[1].Path)
+	require.Empty(t, s.Dependencies[1].Version)
+}
+
+func TestPURL(t *testing.T) {
+	require.Equal(t, "pkg:golang/cosmossdk.io/math@v1.5.3", sbom.PURL("cosmossdk.io/math", "v1.5.3"))
+	require.Equal(t, "pkg:golang/../cosmos-sdk", sbom.PURL("../cosmos-sdk", ""))
+}
+
+func TestCycloneDX(t *testing.T) {
+	bz, err := newSBOM(t).CycloneDX()
+	require.NoError(t, err)
+
+	var doc struct {
+		BOMFormat string `json:"bomFormat"`
+		Metadata  struct {
+			Timestamp string `json:"timestamp"`
+			Component struct {
+				PURL string `json:"purl"`
+			} `json:"component"`
+		} `json:"metadata"`
+		Components []struct {
+			Name string `json:"name"`
+			PURL string `json:"purl"`
+		} `json:"components"`
+		Dependencies []struct {
+			Ref       string   `json:"ref"`
+			DependsOn []string `json:"dependsOn"`
+		} `json:"dependencies"`
+	}
+	require.NoError(t, json.Unmarshal(bz, &doc))
+
+	require.Equal(t, "CycloneDX", doc.BOMFormat)
+	require.Equal(t, "2023-11-14T22:13:20Z", doc.Metadata.Timestamp)
+	require.Equal(t, "pkg:golang/github.com/ignite/mars@1.0.0", doc.Metadata.Component.PURL)
+	require.Len(t, doc.Components, 3)
+	require.Equal(t, "pkg:golang/cosmossdk.io/math@v1.5.3", doc.Components[0].PURL)
+	require.Len(t, doc.Dependencies, 1)
+	require.Equal(t, doc.Metadata.Component.PURL, doc.Dependencies[0].Ref)
+	require.Len(t, doc.Dependencies[0].DependsOn, 3)
+}
+
+func TestSPDX(t *testing.T) {
+	s := newSBOM(t)
+	bz, err := s.SPDX()
+	require.NoError(t, err)
+
+	var doc struct {
+		SPDXVersion       string `json:"spdxVersion"`
+		DocumentNamespace string `json:"documentNamespace"`
+		CreationInfo      struct {
+			Creators []string `json:"creators"`
+		} `json:"creationInfo"`
+		Packages []struct {
+			Name   string `json:"name"`
+			SPDXID string `json:"SPDXID"`
+		} `json:"packages"`
+		Relationships []struct {
+			RelationshipType string `json:"relationshipType"`
+		} `json:"relationships"`
+	}
+	require.NoError(t, json.Unmarshal(bz, &doc))
+
+	require.Equal(t, "SPDX-2.3", doc.SPDXVersion)
+	require.Equal(t, []string{"Tool: ignite"}, doc.CreationInfo.Creators)
+	require.Len(t, doc.Packages, 4)
+	require.Equal(t, "github.com/ignite/mars", doc.Packages[0].Name)
+	require.Len(t, doc.Relationships, 4)
+	require.Equal(t, "DESCRIBES", doc.Relationships[0].RelationshipType)
+
+	// the document is reproducible.
+	again, err := s.SPDX()
+	require.NoError(t, err)
+	require.Equal(t, bz, again)
+
+	// the namespace changes with the dependencies.
+	s.Dependencies = s.Dependencies[:1]
+	bz, err = s.SPDX()
+	require.NoError(t, err)
+	require.NotContains(t, string(bz), doc.DocumentNamespace)
+}
@@ -10,22 +10,25 @@ import (
 	"sort"
 	"strings"
 
+	"golang.org/x/tools/go/ast/astutil"
+
 	"github.com/ignite/cli/v29/ignite/pkg/errors"
 )
 
 type (
 	// functionOpts represent the options for functions.
 	functionOpts struct {
-		newParams      []functionParam  // Parameters to add to the function.
-		body           string           // New function body content.
-		newLines       []functionLine   // Lines to insert at specific positions.
-		insideCall     functionCalls    // Function calls to modify.
-		insideStruct   functionStructs  // Struct literals to modify.
-		appendTestCase []string         // Test cases to append.
-		appendCode     []string         // Code to append at the end.
-		returnVars     []string         // Return variables to modify.
-		appendSwitch   functionSwitches // Switch cases to append.
-		removeCalls    []string         // Function calls to remove.
+		newParams      []functionParam   // Parameters to add to the function.
+		body           string            // New function body content.
+		newLines       []functionLine    // Lines to insert at specific positions.
+		insideCall     functionCalls     // Function calls to modify.
+		insideStruct   functionStructs   // Struct literals to modify.
+		appendTestCase []string          // Test cases to append.
+		appendCode     []string          // Code to append at the end.
+		returnVars     []string          // Return variables to modify.
+		appendSwitch   functionSwitches  // Switch cases to append.
+		removeCalls    []string          // Function calls to remove.
+		selectors      map[string]string // Selector expressions to replace.
 	}
 
 	// FunctionOptions configures code generation.
@@ -253,6 +256,15 @@ func RemoveFuncCall(callName string) FunctionOptions {
 	}
 }
 
+// ReplaceFuncSelector replaces the selector expressions with the specified name from within a function.
+// For instance, replacing 'sdkerrors.ErrInvalidRequest' with 'types.ErrNotFound' changes the
+// 'errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "not found")' call into 'errorsmod.Wrap(types.ErrNotFound, "not found")'.
+func ReplaceFuncSelector(selector, newSelector string) FunctionOptions {
+	return func(c *functionOpts) {
+		c.selectors[selector] = newSelector
+	}
+}
+
 // newFunctionOptions creates a new functionOpts with defaults.
 func newFunctionOptions() functionOpts {
 	return functionOpts{
@@ -265,6 +277,7 @@ func newFunctionOptions() functionOpts {
 		appendCode:     make([]string, 0),
 		returnVars:     make([]string, 0),
 		removeCalls:    make([]string, 0),
+		selectors:      make(map[string]string),
 	}
 }
 
@@ -782,6 +795,8 @@ func applyFunctionOptions(fileSet *token.FileSet, f *ast.FuncDecl, opts *functio
 		return err
 	}
 
+	selectorsCheck := replaceSelectors(f, opts.selectors)
+
 	if len(callMapCheck) > 0 {
 		return errors.Errorf("function calls not found: %v", callMapCheck)
 	}
@@ -791,9 +806,40 @@ func applyFunctionOptions(fileSet *token.FileSet, f *ast.FuncDecl, opts *functio
 	if len(switchesCasesMapCheck) > 0 {
 		return errors.Errorf("function switch not found: %v", switchesCasesMapCheck)
 	}
+	if len(selectorsCheck) > 0 {
+		return errors.Errorf("function selectors not found: %v", selectorsCheck)
+	}
 	return nil
 }
 
+// replaceSelectors replaces the selector expressions of a function and returns the ones not found.
+func replaceSelectors(f *ast.FuncDecl, selectors map[string]string) map[string]string {
+	notFound := make(map[string]string, len(selectors))
+	for k, v := range selectors {
+		notFound[k] = v
+	}
+
+	astutil.Apply(f.Body, func(c *astutil.Cursor) bool {
+		sel, ok := c.Node().(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+		name, _ := exprName(sel)
+		newSelector, ok := selectors[name]
+		if !ok {
+			return true
+		}
+
+		newExpr := ast.NewIdent(newSelector)
+		newExpr.NamePos = sel.Pos()
+		c.Replace(newExpr)
+		delete(notFound, name)
+		return false
+	}, nil)
+
+	return notFound
+}
+
 // ModifyCaller replaces all arguments of a specific function call in the given content.
 // The callerExpr should be in the format "pkgname.FuncName" or just "FuncName".
 // The modifiers function is called with the existing arguments and should return the new arguments.
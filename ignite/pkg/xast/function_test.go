@@ -1485,3 +1485,41 @@ func process(values []int, anyValue interface{}) int {
 	require.Contains(t, got, "doKeep()")
 	require.Contains(t, got, "return 1")
 }
+
+func TestReplaceFuncSelector(t *testing.T) {
+	content := `package keeper
+
+func (k msgServer) CreatePost(ctx context.Context, msg *types.MsgCreatePost) (*types.MsgCreatePostResponse, error) {
+	if msg.Title == "" {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty title")
+	}
+	return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "invalid address")
+}
+
+func (k msgServer) DeletePost(ctx context.Context, msg *types.MsgDeletePost) (*types.MsgDeletePostResponse, error) {
+	return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "not implemented")
+}
+`
+
+	t.Run("replace selector", func(t *testing.T) {
+		got, err := ModifyFunction(content, "CreatePost", ReplaceFuncSelector("sdkerrors.ErrInvalidRequest", "types.ErrEmptyTitle"))
+		require.NoError(t, err)
+		require.Equal(t, `package keeper
+
+func (k msgServer) CreatePost(ctx context.Context, msg *types.MsgCreatePost) (*types.MsgCreatePostResponse, error) {
+	if msg.Title == "" {
+		return nil, errorsmod.Wrap(types.ErrEmptyTitle, "empty title")
+	}
+	return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "invalid address")
+}
+
+func (k msgServer) DeletePost(ctx context.Context, msg *types.MsgDeletePost) (*types.MsgDeletePostResponse, error) {
+	return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "not implemented")
+}`, got)
+	})
+
+	t.Run("selector not found", func(t *testing.T) {
+		_, err := ModifyFunction(content, "CreatePost", ReplaceFuncSelector("sdkerrors.ErrLogic", "types.ErrEmptyTitle"))
+		require.EqualError(t, err, "function selectors not found: map[sdkerrors.ErrLogic:types.ErrEmptyTitle]")
+	})
+}
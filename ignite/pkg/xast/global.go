@@ -124,6 +124,107 @@ func InsertGlobal(fileContent string, globalType GlobalType, globals ...GlobalOp
 	return buf.String(), nil
 }
 
+// AppendGlobal appends global variables or constants to the last declaration block of their type
+// in the provided Go source code content, for instance a 'var (...)' block for variables.
+// The globals are declared at the end of the file when the content has no declaration block of their type.
+// An error is returned when a global with the same name is already declared.
+func AppendGlobal(fileContent string, globalType GlobalType, globals ...GlobalOptions) (modifiedContent string, err error) {
+	// apply global options.
+	opts := newGlobalOptions()
+	for _, o := range globals {
+		o(&opts)
+	}
+	if len(opts.globals) == 0 {
+		return fileContent, nil
+	}
+
+	tok, err := globalTypeToken(globalType)
+	if err != nil {
+		return "", err
+	}
+
+	// Append the globals one by one to keep the positions of the parsed content valid.
+	modifiedContent = fileContent
+	for _, global := range opts.globals {
+		modifiedContent, err = appendGlobal(modifiedContent, tok, global)
+		if err != nil {
+			return "", err
+		}
+	}
+	return modifiedContent, nil
+}
+
+func appendGlobal(fileContent string, tok token.Token, global global) (string, error) {
+	fileSet := token.NewFileSet()
+
+	// Parse the Go source code content.
+	f, err := parser.ParseFile(fileSet, "", fileContent, parser.ParseComments)
+	if err != nil {
+		return "", err
+	}
+	cmap := ast.NewCommentMap(fileSet, f, f.Comments)
+
+	// Find the last declaration block of the global type.
+	var block *ast.GenDecl
+	for _, decl := range f.Decls {
+		genDecl, ok := decl.(*ast.GenDecl)
+		if !ok || genDecl.Tok != tok {
+			continue
+		}
+		if isGlobalDeclared(genDecl, global.name) {
+			return "", errors.Errorf("global %s is already declared", global.name)
+		}
+		if genDecl.Lparen.IsValid() {
+			block = genDecl
+		}
+	}
+
+	spec, err := newGlobalValueSpec(fileSet, global)
+	if err != nil {
+		return "", err
+	}
+
+	if block != nil {
+		// Place the new spec on a new line before the closing parenthesis of the block.
+		file := fileSet.File(block.Rparen)
+		file.AddLine(file.Offset(block.Rparen))
+		spec.Names[0].NamePos = block.Rparen
+		block.Specs = append(block.Specs, spec)
+	} else {
+		f.Decls = append(f.Decls, &ast.GenDecl{
+			TokPos: 1,
+			Tok:    tok,
+			Specs:  []ast.Spec{spec},
+		})
+	}
+
+	f.Comments = cmap.Filter(f).Comments()
+
+	// Format the modified AST.
+	var buf bytes.Buffer
+	if err := format.Node(&buf, fileSet, f); err != nil {
+		return "", err
+	}
+
+	return buf.String(), nil
+}
+
+// isGlobalDeclared checks if a global with the name is declared in the declaration.
+func isGlobalDeclared(decl *ast.GenDecl, name string) bool {
+	for _, spec := range decl.Specs {
+		valueSpec, ok := spec.(*ast.ValueSpec)
+		if !ok {
+			continue
+		}
+		for _, ident := range valueSpec.Names {
+			if ident.Name == name {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // AppendFunction appends a new function to the end of the Go source code content.
 func AppendFunction(fileContent string, function string) (modifiedContent string, err error) {
 	fileSet := token.NewFileSet()
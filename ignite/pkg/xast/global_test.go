@@ -750,3 +750,75 @@ func TestNewGlobalValueSpec(t *testing.T) {
 	})
 	require.Error(t, err)
 }
+
+func TestAppendGlobal(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		globals []GlobalOptions
+		want    string
+		err     string
+	}{
+		{
+			name: "append to the declaration block",
+			content: `package types
+
+// module sentinel errors
+var (
+	ErrInvalidSigner = errors.Register(ModuleName, 1100, "invalid signer")
+)
+
+var ErrOther = errors.New("other")
+`,
+			globals: []GlobalOptions{
+				WithGlobal("ErrPostNotFound", "", `errors.Register(ModuleName, 1101, "post not found")`),
+				WithGlobal("ErrPostExists", "", `errors.Register(ModuleName, 1102, "post exists")`),
+			},
+			want: `package types
+
+// module sentinel errors
+var (
+	ErrInvalidSigner = errors.Register(ModuleName, 1100, "invalid signer")
+	ErrPostNotFound  = errors.Register(ModuleName, 1101, "post not found")
+	ErrPostExists    = errors.Register(ModuleName, 1102, "post exists")
+)
+
+var ErrOther = errors.New("other")
+`,
+		},
+		{
+			name: "append without declaration block",
+			content: `package types
+
+const Foo = "foo"
+`,
+			globals: []GlobalOptions{WithGlobal("Bar", "string", `"bar"`)},
+			want: `package types
+
+const Foo = "foo"
+
+var Bar string = "bar"
+`,
+		},
+		{
+			name: "global already declared",
+			content: `package types
+
+var Foo = "foo"
+`,
+			globals: []GlobalOptions{WithGlobal("Foo", "", `"bar"`)},
+			err:     "global Foo is already declared",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := AppendGlobal(tt.content, GlobalTypeVar, tt.globals...)
+			if tt.err != "" {
+				require.EqualError(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
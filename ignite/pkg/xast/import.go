@@ -134,6 +134,39 @@ func RemoveImports(fileContent string, imports ...ImportOptions) (string, error)
 	return buf.String(), nil
 }
 
+// RemoveUnusedImports removes the import statements of the paths that are no longer used in Go source code content.
+func RemoveUnusedImports(fileContent string, paths ...string) (string, error) {
+	if len(paths) == 0 {
+		return fileContent, nil
+	}
+
+	fileSet := token.NewFileSet()
+
+	// Parse the Go source code content.
+	f, err := parser.ParseFile(fileSet, "", fileContent, parser.ParseComments)
+	if err != nil {
+		return "", err
+	}
+	cmap := ast.NewCommentMap(fileSet, f, f.Comments)
+
+	// Remove the unused import statements.
+	for _, path := range paths {
+		if !astutil.UsesImport(f, path) {
+			deleteImportsByPath(fileSet, f, path)
+		}
+	}
+
+	f.Comments = cmap.Filter(f).Comments()
+
+	// Format the modified AST.
+	var buf bytes.Buffer
+	if err := format.Node(&buf, fileSet, f); err != nil {
+		return "", err
+	}
+
+	return buf.String(), nil
+}
+
 func deleteImportsByPath(fileSet *token.FileSet, file *ast.File, path string) {
 	names := make([]string, 0, len(file.Imports))
 	for _, spec := range file.Imports {
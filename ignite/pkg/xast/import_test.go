@@ -549,3 +549,34 @@ func main() {}
 	require.Equal(t, "broken", importName(invalidSpec))
 	require.Equal(t, "", importPath(invalidSpec))
 }
+
+func TestRemoveUnusedImports(t *testing.T) {
+	content := `package keeper
+
+import (
+	"fmt"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+func main() {
+	fmt.Println(sdkerrors.ErrInvalidRequest)
+}
+`
+
+	got, err := RemoveUnusedImports(content, "fmt", "github.com/cosmos/cosmos-sdk/types/errors", "github.com/cosmos/cosmos-sdk/types/query")
+	require.NoError(t, err)
+	require.Equal(t, `package keeper
+
+import (
+	"fmt"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func main() {
+	fmt.Println(sdkerrors.ErrInvalidRequest)
+}
+`, got)
+}
@@ -0,0 +1,720 @@
+package xast
+
+import (
+	"bytes"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"golang.org/x/tools/go/ast/astutil"
+)
+
+type (
+	// removeOpts represent the options to remove code.
+	removeOpts struct {
+		globals []string           // Names of the global constants and variables to remove.
+		fields  []structFields     // Fields of the struct declarations to remove.
+		funcs   map[string]*funcRm // Code to remove from the functions, by function name.
+	}
+
+	// RemoveOptions configures the code to remove.
+	RemoveOptions func(*removeOpts)
+
+	// structFields represents the fields of a struct to remove.
+	structFields struct {
+		name   string   // Name of the struct type.
+		fields []string // Names of the struct fields.
+	}
+
+	// funcElement represents the composite literal elements to remove.
+	funcElement struct {
+		key   string // Name of the element key.
+		value string // String value of the key.
+	}
+
+	// funcRm represents the code to remove from a function.
+	funcRm struct {
+		idents   []string       // Identifiers used by the statements and elements to remove.
+		structs  []structFields // Fields of the struct literals to remove.
+		elements []funcElement  // Composite literal elements to remove.
+	}
+)
+
+// RemoveGlobal removes the global constants and variables with the given names.
+func RemoveGlobal(names ...string) RemoveOptions {
+	return func(o *removeOpts) {
+		o.globals = append(o.globals, names...)
+	}
+}
+
+// RemoveStructField removes fields from the declaration of a struct type.
+func RemoveStructField(structName string, fields ...string) RemoveOptions {
+	return func(o *removeOpts) {
+		o.fields = append(o.fields, structFields{name: structName, fields: fields})
+	}
+}
+
+// RemoveFuncStruct removes fields from the struct literals of a function.
+// The structName can be either a simple type name like "GenesisState" or a
+// qualified one like "types.GenesisState".
+func RemoveFuncStruct(funcName, structName string, fields ...string) RemoveOptions {
+	return func(o *removeOpts) {
+		f := o.function(funcName)
+		f.structs = append(f.structs, structFields{name: structName, fields: fields})
+	}
+}
+
+// RemoveFuncElement removes from a function the composite literal elements with
+// a key set to the string value, like the test cases with a given description.
+func RemoveFuncElement(funcName, key, value string) RemoveOptions {
+	return func(o *removeOpts) {
+		f := o.function(funcName)
+		f.elements = append(f.elements, funcElement{key: key, value: value})
+	}
+}
+
+// RemoveFuncCode removes from a function the statements and the composite literal elements
+// using one of the identifiers. The local variables defined by a removed statement and the
+// error check following it are removed with it, as well as the local variables only used by
+// removed statements. Return statements are never removed.
+func RemoveFuncCode(funcName string, idents ...string) RemoveOptions {
+	return func(o *removeOpts) {
+		f := o.function(funcName)
+		f.idents = append(f.idents, idents...)
+	}
+}
+
+// function returns the code to remove from a function.
+func (o *removeOpts) function(name string) *funcRm {
+	f, ok := o.funcs[name]
+	if !ok {
+		f = &funcRm{}
+		o.funcs[name] = f
+	}
+	return f
+}
+
+// RemoveCode removes code from the Go source code content using functional options.
+// The comments of the removed code and the imports it was the only one to use are removed too.
+// The code that is not found is ignored, the content is returned unchanged when nothing is removed.
+func RemoveCode(content string, options ...RemoveOptions) (string, error) {
+	opts := removeOpts{funcs: make(map[string]*funcRm)}
+	for _, o := range options {
+		o(&opts)
+	}
+
+	fileSet := token.NewFileSet()
+	file, err := parser.ParseFile(fileSet, "", content, parser.ParseComments)
+	if err != nil {
+		return "", err
+	}
+
+	r := &remover{}
+	lists := nodeLists(file)
+	usedImports := make(map[*ast.ImportSpec]bool)
+	for _, spec := range file.Imports {
+		usedImports[spec] = astutil.UsesImport(file, importPath(spec))
+	}
+
+	r.removeGlobals(file, opts.globals)
+	r.removeStructFields(file, opts.fields)
+	for _, decl := range file.Decls {
+		funcDecl, ok := decl.(*ast.FuncDecl)
+		if !ok || funcDecl.Body == nil {
+			continue
+		}
+		if f, ok := opts.funcs[funcDecl.Name.Name]; ok {
+			r.removeFuncCode(funcDecl.Body, f)
+		}
+	}
+	if len(r.removed) == 0 {
+		return content, nil
+	}
+
+	file.Comments = r.keptComments(fileSet, file, content)
+	r.recordGaps(file, lists)
+	r.closeGaps(fileSet.File(file.Pos()), file.Comments)
+
+	for spec, used := range usedImports {
+		path := importPath(spec)
+		if !used || astutil.UsesImport(file, path) {
+			continue
+		}
+		astutil.DeleteNamedImport(fileSet, file, importName(spec), path)
+	}
+
+	var buf bytes.Buffer
+	if err := format.Node(&buf, fileSet, file); err != nil {
+		return "", err
+	}
+	formatted, err := format.Source(buf.Bytes())
+	if err != nil {
+		return "", err
+	}
+	return string(formatted), nil
+}
+
+// remover removes nodes from a file, keeping track of their positions
+// to remove their comments and the empty lines they leave.
+type remover struct {
+	// removed are the positions of the code removed from the file.
+	removed []span
+
+	// gaps are the positions of the nodes removed from the lists of the file.
+	gaps []gap
+}
+
+// gap is the position of consecutive nodes removed from a list.
+type gap struct {
+	removed  span
+	prev     token.Pos // end of the previous node or the list opening brace.
+	next     token.Pos // start of the next node or the list closing brace.
+	inBraces bool      // whether the nodes were the first or the last ones of the list.
+}
+
+// span is the position of a removed node.
+type span struct {
+	pos, end token.Pos
+}
+
+// remove records the position of a removed node.
+func (r *remover) remove(node ast.Node) {
+	r.removed = append(r.removed, nodeSpan(node))
+}
+
+// nodeSpan returns the position of a node. The end of an emptied declaration is unknown,
+// its start is used instead.
+func nodeSpan(node ast.Node) span {
+	genDecl, ok := node.(*ast.GenDecl)
+	if declStmt, isStmt := node.(*ast.DeclStmt); isStmt {
+		genDecl, ok = declStmt.Decl.(*ast.GenDecl)
+	}
+	if ok && len(genDecl.Specs) == 0 && !genDecl.Rparen.IsValid() {
+		return span{pos: node.Pos(), end: node.Pos()}
+	}
+	return span{pos: node.Pos(), end: node.End()}
+}
+
+// removeGlobals removes the global constants and variables with the given names.
+// The declarations left empty are removed.
+func (r *remover) removeGlobals(file *ast.File, names []string) {
+	if len(names) == 0 {
+		return
+	}
+
+	decls := file.Decls[:0]
+	for _, decl := range file.Decls {
+		genDecl, ok := decl.(*ast.GenDecl)
+		if !ok || (genDecl.Tok != token.CONST && genDecl.Tok != token.VAR) {
+			decls = append(decls, decl)
+			continue
+		}
+		if r.removeValueSpecs(genDecl, names) && len(genDecl.Specs) == 0 {
+			r.remove(genDecl)
+			continue
+		}
+		decls = append(decls, decl)
+	}
+	file.Decls = decls
+}
+
+// removeValueSpecs removes from a declaration the specs only declaring the given names.
+// It returns true if a spec was removed.
+func (r *remover) removeValueSpecs(genDecl *ast.GenDecl, names []string) bool {
+	removed := false
+	specs := genDecl.Specs[:0]
+	for _, spec := range genDecl.Specs {
+		if valueSpec, ok := spec.(*ast.ValueSpec); ok && containsAll(names, valueSpec.Names) {
+			r.remove(valueSpec)
+			removed = true
+			continue
+		}
+		specs = append(specs, spec)
+	}
+	genDecl.Specs = specs
+	return removed
+}
+
+// removeStructFields removes fields from the struct type declarations.
+func (r *remover) removeStructFields(file *ast.File, fields []structFields) {
+	for _, sf := range fields {
+		ast.Inspect(file, func(n ast.Node) bool {
+			typeSpec, ok := n.(*ast.TypeSpec)
+			if !ok || typeSpec.Name.Name != sf.name {
+				return true
+			}
+			structType, ok := typeSpec.Type.(*ast.StructType)
+			if !ok {
+				return false
+			}
+
+			list := structType.Fields.List[:0]
+			for _, field := range structType.Fields.List {
+				if len(field.Names) > 0 && containsAll(sf.fields, field.Names) {
+					r.remove(field)
+					continue
+				}
+				list = append(list, field)
+			}
+			structType.Fields.List = list
+			return false
+		})
+	}
+}
+
+// removeFuncCode removes code from the body of a function. The struct literal fields
+// are removed first, so the statements defining the struct literals are kept.
+func (r *remover) removeFuncCode(body *ast.BlockStmt, f *funcRm) {
+	r.removeElements(body, func(node ast.Expr) bool {
+		lit, ok := node.(*ast.CompositeLit)
+		if !ok {
+			return false
+		}
+		r.removeStructKeys(lit, f.structs)
+		return f.isElement(lit)
+	})
+
+	if len(f.idents) == 0 {
+		return
+	}
+	r.removeStatements(body, f.idents)
+	r.removeElements(body, func(node ast.Expr) bool {
+		_, isKeyValue := node.(*ast.KeyValueExpr)
+		return !isKeyValue && usesIdents(node, f.idents, nil)
+	})
+}
+
+// removeElements removes the composite literal elements of a node matching the filter.
+// The filter is called for every expression of the node.
+func (r *remover) removeElements(node ast.Node, filter func(ast.Expr) bool) {
+	astutil.Apply(node, func(c *astutil.Cursor) bool {
+		expr, ok := c.Node().(ast.Expr)
+		if !ok || !filter(expr) {
+			return true
+		}
+		// only the elements of the composite literals are removed.
+		if _, ok := c.Parent().(*ast.CompositeLit); !ok || c.Index() < 0 {
+			return true
+		}
+		r.remove(expr)
+		c.Delete()
+		return false
+	}, nil)
+}
+
+// isElement checks if a composite literal is an element to remove,
+// with one of its keys set to the string value.
+func (f *funcRm) isElement(lit *ast.CompositeLit) bool {
+	for _, elt := range lit.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			continue
+		}
+		key, ok := kv.Key.(*ast.Ident)
+		if !ok {
+			continue
+		}
+		value, ok := kv.Value.(*ast.BasicLit)
+		if !ok || value.Kind != token.STRING {
+			continue
+		}
+		text, err := strconv.Unquote(value.Value)
+		if err != nil {
+			continue
+		}
+		if slices.Contains(f.elements, funcElement{key: key.Name, value: text}) {
+			return true
+		}
+	}
+	return false
+}
+
+// removeStructKeys removes the fields of a struct literal matching its type.
+func (r *remover) removeStructKeys(lit *ast.CompositeLit, structs []structFields) {
+	name, ok := exprName(lit.Type)
+	if !ok {
+		return
+	}
+	var simpleName string
+	if sel, isSel := lit.Type.(*ast.SelectorExpr); isSel {
+		simpleName = sel.Sel.Name
+	}
+
+	for _, sf := range structs {
+		if sf.name != name && sf.name != simpleName {
+			continue
+		}
+		lit.Elts = slices.DeleteFunc(lit.Elts, func(elt ast.Expr) bool {
+			kv, ok := elt.(*ast.KeyValueExpr)
+			if !ok {
+				return false
+			}
+			key, ok := kv.Key.(*ast.Ident)
+			if !ok || !slices.Contains(sf.fields, key.Name) {
+				return false
+			}
+			r.remove(kv)
+			return true
+		})
+	}
+}
+
+// removeStatements removes from a function body the statements using the identifiers,
+// until no more statements can be removed. The local variables defined by a removed
+// statement are removed with it, and so is the error check following it.
+// Local variables only used by removed statements are removed too.
+func (r *remover) removeStatements(body *ast.BlockStmt, idents []string) {
+	var (
+		locals = make(map[string]struct{})
+		refs   = make(map[string]struct{})
+	)
+
+	isRemoved := func(stmt ast.Stmt, counts map[string]int) bool {
+		if decl, ok := stmt.(*ast.DeclStmt); ok {
+			if genDecl, ok := decl.Decl.(*ast.GenDecl); ok && len(genDecl.Specs) == 0 {
+				return true
+			}
+		}
+		if references(stmt, idents, locals) {
+			return true
+		}
+
+		// a local variable only used by removed statements, e.g. a map checking duplicated values.
+		names := definedNames(stmt)
+		if len(names) == 0 {
+			return false
+		}
+		for _, name := range names {
+			if _, ok := refs[name]; !ok || counts[name] > 1 {
+				return false
+			}
+		}
+		return true
+	}
+
+	for {
+		var (
+			counts  = identCounts(body)
+			changed = false
+		)
+		filter := func(list []ast.Stmt) []ast.Stmt {
+			kept := list[:0]
+			for i := 0; i < len(list); i++ {
+				stmt := list[i]
+				if !isRemoved(stmt, counts) {
+					kept = append(kept, stmt)
+					continue
+				}
+
+				changed = true
+				r.remove(stmt)
+				for _, name := range definedNames(stmt) {
+					if name != "err" && name != "ok" {
+						locals[name] = struct{}{}
+					}
+				}
+				ast.Inspect(stmt, func(n ast.Node) bool {
+					if ident, ok := n.(*ast.Ident); ok {
+						refs[ident.Name] = struct{}{}
+					}
+					return true
+				})
+
+				if i+1 < len(list) && assignsError(stmt) && checksError(list[i+1]) {
+					i++
+					r.remove(list[i])
+				}
+			}
+			return kept
+		}
+
+		ast.Inspect(body, func(n ast.Node) bool {
+			switch node := n.(type) {
+			case *ast.BlockStmt:
+				node.List = filter(node.List)
+			case *ast.CaseClause:
+				node.Body = filter(node.Body)
+			case *ast.CommClause:
+				node.Body = filter(node.Body)
+			case *ast.DeclStmt:
+				// the local constants of a removed statement, e.g. the weights of a simulation operation.
+				if genDecl, ok := node.Decl.(*ast.GenDecl); ok && r.removeValueSpecs(genDecl, idents) {
+					changed = true
+				}
+			}
+			return true
+		})
+
+		if !changed {
+			return
+		}
+	}
+}
+
+// references checks if a statement uses the identifiers or the given local variables.
+// The body of a compound statement isn't checked, its statements are removed one by one instead.
+// Return statements are never removed.
+func references(stmt ast.Stmt, idents []string, locals map[string]struct{}) bool {
+	var nodes []ast.Node
+	switch stmt := stmt.(type) {
+	case *ast.ReturnStmt, *ast.BlockStmt, *ast.LabeledStmt, *ast.SelectStmt:
+		return false
+	case *ast.IfStmt:
+		nodes = []ast.Node{stmt.Init, stmt.Cond}
+	case *ast.ForStmt:
+		nodes = []ast.Node{stmt.Init, stmt.Cond, stmt.Post}
+	case *ast.RangeStmt:
+		nodes = []ast.Node{stmt.Key, stmt.Value, stmt.X}
+	case *ast.SwitchStmt:
+		nodes = []ast.Node{stmt.Init, stmt.Tag}
+	case *ast.TypeSwitchStmt:
+		nodes = []ast.Node{stmt.Init, stmt.Assign}
+	default:
+		nodes = []ast.Node{stmt}
+	}
+
+	for _, node := range nodes {
+		if node != nil && usesIdents(node, idents, locals) {
+			return true
+		}
+	}
+	return false
+}
+
+// usesIdents checks if a node uses one of the identifiers or the given local variables.
+func usesIdents(node ast.Node, idents []string, locals map[string]struct{}) bool {
+	found := false
+	ast.Inspect(node, func(n ast.Node) bool {
+		if ident, ok := n.(*ast.Ident); ok {
+			if _, ok := locals[ident.Name]; ok || slices.Contains(idents, ident.Name) {
+				found = true
+			}
+		}
+		return !found
+	})
+	return found
+}
+
+// containsAll checks if all the identifiers are part of the names.
+func containsAll(names []string, idents []*ast.Ident) bool {
+	for _, ident := range idents {
+		if !slices.Contains(names, ident.Name) {
+			return false
+		}
+	}
+	return true
+}
+
+// nodeList is a list of nodes enclosed by braces or parentheses, like the statements of a block,
+// the elements of a composite literal, the fields of a struct or the specs of a grouped declaration.
+type nodeList struct {
+	open, close token.Pos
+	nodes       []ast.Node
+}
+
+// nodeLists returns the lists of nodes of a file, by their parent node.
+func nodeLists(file *ast.File) map[ast.Node]nodeList {
+	lists := make(map[ast.Node]nodeList)
+	ast.Inspect(file, func(n ast.Node) bool {
+		switch node := n.(type) {
+		case *ast.BlockStmt:
+			lists[node] = nodeList{node.Lbrace, node.Rbrace, toNodes(node.List)}
+		case *ast.CompositeLit:
+			lists[node] = nodeList{node.Lbrace, node.Rbrace, toNodes(node.Elts)}
+		case *ast.StructType:
+			lists[node] = nodeList{node.Fields.Opening, node.Fields.Closing, toNodes(node.Fields.List)}
+		case *ast.GenDecl:
+			if node.Lparen.IsValid() {
+				lists[node] = nodeList{node.Lparen, node.Rparen, toNodes(node.Specs)}
+			}
+		}
+		return true
+	})
+	return lists
+}
+
+func toNodes[T ast.Node](list []T) []ast.Node {
+	nodes := make([]ast.Node, len(list))
+	for i, node := range list {
+		nodes[i] = node
+	}
+	return nodes
+}
+
+// recordGaps records the positions of the nodes removed from the lists of the file.
+// The lists removed with their parent node are ignored.
+func (r *remover) recordGaps(file *ast.File, original map[ast.Node]nodeList) {
+	for parent, list := range nodeLists(file) {
+		for i, nodes := 0, original[parent].nodes; i < len(nodes); i++ {
+			if slices.Contains(list.nodes, nodes[i]) {
+				continue
+			}
+			j := i
+			for j+1 < len(nodes) && !slices.Contains(list.nodes, nodes[j+1]) {
+				j++
+			}
+
+			g := gap{removed: span{pos: nodes[i].Pos(), end: nodeSpan(nodes[j]).end}}
+			if i == 0 {
+				g.prev, g.inBraces = list.open, true
+			} else {
+				g.prev = nodes[i-1].End()
+			}
+			if j == len(nodes)-1 {
+				g.next, g.inBraces = list.close, true
+			} else {
+				g.next = nodes[j+1].Pos()
+			}
+			r.gaps = append(r.gaps, g)
+			i = j
+		}
+	}
+}
+
+// closeGaps removes the empty lines left by the removed nodes, keeping one empty line
+// between the surrounding nodes if the removed ones were separated from them by one.
+// The lines of the removed nodes are merged, so the printer doesn't see them anymore.
+func (r *remover) closeGaps(file *token.File, comments []*ast.CommentGroup) {
+	type merge struct{ line, count int }
+	var merges []merge
+	for _, g := range r.gaps {
+		var (
+			prevLine = file.Line(g.prev)
+			nextLine = file.Line(g.next)
+		)
+		// the comments kept around the removed nodes belong to the surrounding ones.
+		for _, comment := range comments {
+			if comment.Pos() > g.prev && comment.End() <= g.removed.pos {
+				prevLine = max(prevLine, file.Line(comment.End()))
+			}
+			if comment.Pos() >= g.removed.end && comment.End() <= g.next {
+				nextLine = min(nextLine, file.Line(comment.Pos()))
+			}
+		}
+
+		blank := 0
+		if !g.inBraces && (file.Line(g.removed.pos)-prevLine > 1 || nextLine-file.Line(g.removed.end) > 1) {
+			blank = 1
+		}
+		if count := nextLine - prevLine - 1 - blank; count > 0 {
+			merges = append(merges, merge{line: prevLine + 1, count: count})
+		}
+	}
+
+	// the lines are merged from the end of the file, so the lines of the next merges don't change.
+	sort.Slice(merges, func(i, j int) bool { return merges[i].line > merges[j].line })
+	for _, m := range merges {
+		for range m.count {
+			file.MergeLine(m.line)
+		}
+	}
+}
+
+// keptComments returns the comments of the file, except the ones inside the removed nodes
+// and the ones on the lines right before them.
+func (r *remover) keptComments(fileSet *token.FileSet, file *ast.File, content string) []*ast.CommentGroup {
+	kept := make([]*ast.CommentGroup, 0, len(file.Comments))
+	for _, comment := range file.Comments {
+		if !r.isRemovedComment(fileSet, comment, content) {
+			kept = append(kept, comment)
+		}
+	}
+	return kept
+}
+
+func (r *remover) isRemovedComment(fileSet *token.FileSet, comment *ast.CommentGroup, content string) bool {
+	var (
+		start = fileSet.Position(comment.Pos())
+		end   = fileSet.Position(comment.End())
+	)
+	// a comment following some code on the same line belongs to that code.
+	lineStart := strings.LastIndex(content[:start.Offset], "\n") + 1
+	ownLine := strings.TrimSpace(content[lineStart:start.Offset]) == ""
+
+	for _, removed := range r.removed {
+		if comment.Pos() >= removed.pos && comment.End() <= removed.end {
+			return true
+		}
+		if ownLine && end.Line+1 == fileSet.Position(removed.pos).Line {
+			return true
+		}
+	}
+	return false
+}
+
+// definedNames returns the names of the local variables defined by a statement.
+func definedNames(stmt ast.Stmt) (names []string) {
+	switch stmt := stmt.(type) {
+	case *ast.AssignStmt:
+		if stmt.Tok != token.DEFINE {
+			return nil
+		}
+		for _, expr := range stmt.Lhs {
+			if ident, ok := expr.(*ast.Ident); ok && ident.Name != "_" {
+				names = append(names, ident.Name)
+			}
+		}
+	case *ast.DeclStmt:
+		genDecl, ok := stmt.Decl.(*ast.GenDecl)
+		if !ok {
+			return nil
+		}
+		for _, spec := range genDecl.Specs {
+			if valueSpec, ok := spec.(*ast.ValueSpec); ok {
+				for _, ident := range valueSpec.Names {
+					if ident.Name != "_" {
+						names = append(names, ident.Name)
+					}
+				}
+			}
+		}
+	}
+	return names
+}
+
+// assignsError checks if a statement assigns the err variable.
+func assignsError(stmt ast.Stmt) bool {
+	assign, ok := stmt.(*ast.AssignStmt)
+	if !ok {
+		return false
+	}
+	for _, expr := range assign.Lhs {
+		if ident, ok := expr.(*ast.Ident); ok && ident.Name == "err" {
+			return true
+		}
+	}
+	return false
+}
+
+// checksError checks if a statement is an if statement checking the err variable.
+func checksError(stmt ast.Stmt) bool {
+	ifStmt, ok := stmt.(*ast.IfStmt)
+	if !ok || ifStmt.Init != nil {
+		return false
+	}
+	found := false
+	ast.Inspect(ifStmt.Cond, func(n ast.Node) bool {
+		if ident, ok := n.(*ast.Ident); ok && ident.Name == "err" {
+			found = true
+		}
+		return !found
+	})
+	return found
+}
+
+// identCounts counts the occurrences of the identifiers in a node.
+func identCounts(node ast.Node) map[string]int {
+	counts := make(map[string]int)
+	ast.Inspect(node, func(n ast.Node) bool {
+		if ident, ok := n.(*ast.Ident); ok {
+			counts[ident.Name]++
+		}
+		return true
+	})
+	return counts
+}
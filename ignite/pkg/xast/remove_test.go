@@ -0,0 +1,179 @@
+package xast
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRemoveCode(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		options []RemoveOptions
+		want    string
+	}{
+		{
+			name: "remove globals",
+			content: `package main
+
+const Foo = "foo"
+
+var (
+	BarKey    = "bar"
+	FooBarKey = "foobar"
+)
+`,
+			options: []RemoveOptions{RemoveGlobal("Foo", "BarKey")},
+			want: `package main
+
+var (
+	FooBarKey = "foobar"
+)
+`,
+		},
+		{
+			name: "remove struct fields",
+			content: `package main
+
+type Keeper struct {
+	Params collections.Item[Params]
+	Bar    collections.Map[uint64, Bar]
+	FooBar collections.Map[uint64, FooBar]
+}
+
+func NewKeeper() Keeper {
+	return Keeper{
+		Params: collections.NewItem(),
+		Bar:    collections.NewMap(),
+		FooBar: collections.NewMap(),
+	}
+}
+`,
+			options: []RemoveOptions{
+				RemoveStructField("Keeper", "Bar"),
+				RemoveFuncStruct("NewKeeper", "Keeper", "Bar"),
+			},
+			want: `package main
+
+type Keeper struct {
+	Params collections.Item[Params]
+	FooBar collections.Map[uint64, FooBar]
+}
+
+func NewKeeper() Keeper {
+	return Keeper{
+		Params: collections.NewItem(),
+		FooBar: collections.NewMap(),
+	}
+}
+`,
+		},
+		{
+			name: "remove function code",
+			content: `package main
+
+import (
+	"errors"
+	"fmt"
+)
+
+func Validate(gs GenesisState) error {
+	// Check for duplicated index in bar
+	barIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.BarMap {
+		if _, ok := barIndexMap[elem.Index]; ok {
+			return errors.New("duplicated index for bar")
+		}
+		barIndexMap[elem.Index] = struct{}{}
+	}
+
+	// Check the foo bars
+	for _, elem := range gs.FooBarMap {
+		fmt.Println(elem)
+	}
+
+	return nil
+}
+`,
+			options: []RemoveOptions{RemoveFuncCode("Validate", "BarMap")},
+			want: `package main
+
+import (
+	"fmt"
+)
+
+func Validate(gs GenesisState) error {
+	// Check the foo bars
+	for _, elem := range gs.FooBarMap {
+		fmt.Println(elem)
+	}
+
+	return nil
+}
+`,
+		},
+		{
+			name: "remove function elements",
+			content: `package main
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		desc  string
+		valid bool
+	}{
+		{
+			desc:  "valid",
+			valid: true,
+		},
+		{
+			desc:  "duplicated bar",
+			valid: false,
+		},
+	}
+	_ = tests
+}
+`,
+			options: []RemoveOptions{RemoveFuncElement("TestValidate", "desc", "duplicated bar")},
+			want: `package main
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		desc  string
+		valid bool
+	}{
+		{
+			desc:  "valid",
+			valid: true,
+		},
+	}
+	_ = tests
+}
+`,
+		},
+		{
+			name: "code not found",
+			content: `package main
+
+func main() {}
+`,
+			options: []RemoveOptions{
+				RemoveGlobal("Foo"),
+				RemoveFuncCode("main", "Foo"),
+				RemoveFuncCode("unknown", "Foo"),
+			},
+			want: `package main
+
+func main() {}
+`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := RemoveCode(tt.content, tt.options...)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
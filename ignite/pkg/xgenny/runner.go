@@ -16,6 +16,7 @@ type Runner struct {
 	*genny.Runner
 	ctx     context.Context
 	results []genny.File
+	deleted []string
 	tmpPath string
 	root    string
 }
@@ -53,6 +54,7 @@ type (
 	// ApplyOption holds the ApplyModifications options.
 	applyOptions struct {
 		preRun  OverwriteCallback
+		preCopy func(SourceModification) error
 		postRun OverwriteCallback
 	}
 
@@ -67,6 +69,14 @@ func ApplyPreRun(preRun OverwriteCallback) ApplyOption {
 	}
 }
 
+// ApplyPreCopy sets a callback for the ApplyModifications function, called after the pre-runner,
+// right before the modifications are copied to the target path and the deleted files are removed.
+func ApplyPreCopy(preCopy func(SourceModification) error) ApplyOption {
+	return func(o *applyOptions) {
+		o.preCopy = preCopy
+	}
+}
+
 // ApplyPostRun sets pos-runner for the ApplyModifications function.
 func ApplyPostRun(postRun OverwriteCallback) ApplyOption {
 	return func(o *applyOptions) {
@@ -97,13 +107,21 @@ func (r *Runner) ApplyModifications(options ...ApplyOption) (SourceModification,
 	}
 	r.results = make([]genny.File, 0)
 
-	if _, err := os.Stat(r.tmpPath); os.IsNotExist(err) {
+	sm.AppendDeletedFiles(r.deleted...)
+	r.deleted = nil
+
+	_, err := os.Stat(r.tmpPath)
+	hasFiles := !os.IsNotExist(err)
+	if !hasFiles && len(sm.DeletedFiles()) == 0 {
 		return sm, nil
 	}
 
-	duplicatedFiles, err := xos.ValidateFolderCopy(r.tmpPath, r.Root, sm.ModifiedFiles()...)
-	if err != nil {
-		return sm, err
+	var duplicatedFiles []string
+	if hasFiles {
+		duplicatedFiles, err = xos.ValidateFolderCopy(r.tmpPath, r.Root, sm.ModifiedFiles()...)
+		if err != nil {
+			return sm, err
+		}
 	}
 
 	if opts.preRun != nil {
@@ -112,17 +130,34 @@ func (r *Runner) ApplyModifications(options ...ApplyOption) (SourceModification,
 		}
 	}
 
-	// Create the target path and copy the content from the temporary folder.
-	if err := os.MkdirAll(r.Root, os.ModePerm); err != nil {
-		return sm, err
+	if opts.preCopy != nil {
+		if err := opts.preCopy(sm); err != nil {
+			return sm, err
+		}
 	}
 
-	if err := xos.CopyFolder(r.tmpPath, r.Root); err != nil {
-		return sm, err
+	if hasFiles {
+		// Create the target path and copy the content from the temporary folder.
+		if err := os.MkdirAll(r.Root, os.ModePerm); err != nil {
+			return sm, err
+		}
+
+		if err := xos.CopyFolder(r.tmpPath, r.Root); err != nil {
+			return sm, err
+		}
+
+		if err := os.RemoveAll(r.tmpPath); err != nil {
+			return sm, err
+		}
 	}
 
-	if err := os.RemoveAll(r.tmpPath); err != nil {
-		return sm, err
+	for _, file := range sm.DeletedFiles() {
+		if !filepath.IsAbs(file) {
+			file = filepath.Join(r.Root, file)
+		}
+		if err := os.Remove(file); err != nil && !os.IsNotExist(err) {
+			return sm, err
+		}
 	}
 
 	if opts.postRun != nil {
@@ -133,6 +168,11 @@ func (r *Runner) ApplyModifications(options ...ApplyOption) (SourceModification,
 	return sm, nil
 }
 
+// Delete schedules the deletion of files, applied with the next modifications.
+func (r *Runner) Delete(files ...string) {
+	r.deleted = append(r.deleted, files...)
+}
+
 // RunAndApply run the generators and apply the modifications to the target path.
 func (r *Runner) RunAndApply(gens *genny.Generator, options ...ApplyOption) (SourceModification, error) {
 	if err := r.Run(gens); err != nil {
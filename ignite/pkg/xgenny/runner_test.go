@@ -2,6 +2,8 @@ package xgenny_test
 
 import (
 	"context"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/gobuffalo/genny/v2"
@@ -33,3 +35,44 @@ func TestMultipleGen(t *testing.T) {
 	require.Equal(t, 1, firstRunCount, "first generator should run only once")
 	require.Equal(t, 1, secondRunCount, "second generator should run only once")
 }
+
+func TestApplyPreCopy(t *testing.T) {
+	var (
+		root   = t.TempDir()
+		runner = xgenny.NewRunner(context.Background(), root)
+		gen    = genny.New()
+		file   = filepath.Join(root, "foo.txt")
+		called bool
+	)
+
+	gen.RunFn(func(r *genny.Runner) error {
+		return r.File(genny.NewFileS(file, "foo"))
+	})
+	require.NoError(t, runner.Run(gen))
+
+	_, err := runner.ApplyModifications(xgenny.ApplyPreCopy(func(sm xgenny.SourceModification) error {
+		called = true
+		require.Equal(t, []string{file}, sm.CreatedFiles())
+		require.Empty(t, sm.ModifiedFiles())
+		require.NoFileExists(t, file, "files must not be copied before the callback")
+		return nil
+	}))
+	require.NoError(t, err)
+	require.True(t, called)
+	require.FileExists(t, file)
+}
+
+func TestApplyDelete(t *testing.T) {
+	var (
+		root    = t.TempDir()
+		runner  = xgenny.NewRunner(context.Background(), root)
+		deleted = filepath.Join(root, "foo.txt")
+	)
+	require.NoError(t, os.WriteFile(deleted, []byte("foo"), 0o644))
+
+	runner.Delete(deleted)
+	sm, err := runner.ApplyModifications()
+	require.NoError(t, err)
+	require.Equal(t, []string{deleted}, sm.DeletedFiles())
+	require.NoFileExists(t, deleted)
+}
@@ -12,21 +12,24 @@ import (
 var (
 	modifyPrefix = colors.Modified("modify ")
 	createPrefix = colors.Success("create ")
+	deletePrefix = colors.Error("delete ")
 	removePrefix = func(s string) string {
-		return strings.TrimPrefix(strings.TrimPrefix(s, modifyPrefix), createPrefix)
+		return strings.TrimPrefix(strings.TrimPrefix(strings.TrimPrefix(s, modifyPrefix), createPrefix), deletePrefix)
 	}
 )
 
-// SourceModification describes modified and created files in the source code after a run.
+// SourceModification describes modified, created and deleted files in the source code after a run.
 type SourceModification struct {
 	modified map[string]struct{}
 	created  map[string]struct{}
+	deleted  map[string]struct{}
 }
 
 func NewSourceModification() SourceModification {
 	return SourceModification{
 		make(map[string]struct{}),
 		make(map[string]struct{}),
+		make(map[string]struct{}),
 	}
 }
 
@@ -46,6 +49,14 @@ func (sm SourceModification) CreatedFiles() (createdFiles []string) {
 	return
 }
 
+// DeletedFiles returns the deleted files of the source modification.
+func (sm SourceModification) DeletedFiles() (deletedFiles []string) {
+	for deleted := range sm.deleted {
+		deletedFiles = append(deletedFiles, deleted)
+	}
+	return
+}
+
 // AppendModifiedFiles appends modified files in the source modification that are not already documented.
 func (sm *SourceModification) AppendModifiedFiles(modifiedFiles ...string) {
 	for _, modifiedFile := range modifiedFiles {
@@ -68,10 +79,24 @@ func (sm *SourceModification) AppendCreatedFiles(createdFiles ...string) {
 	}
 }
 
+// AppendDeletedFiles appends deleted files in the source modification that are not already documented.
+// Files created or modified by the run and then deleted are only documented as deleted.
+func (sm *SourceModification) AppendDeletedFiles(deletedFiles ...string) {
+	for _, deletedFile := range deletedFiles {
+		delete(sm.modified, deletedFile)
+		if _, created := sm.created[deletedFile]; created {
+			delete(sm.created, deletedFile)
+			continue
+		}
+		sm.deleted[deletedFile] = struct{}{}
+	}
+}
+
 // Merge merges a new source modification to an existing one.
 func (sm *SourceModification) Merge(newSm SourceModification) {
 	sm.AppendModifiedFiles(newSm.ModifiedFiles()...)
 	sm.AppendCreatedFiles(newSm.CreatedFiles()...)
+	sm.AppendDeletedFiles(newSm.DeletedFiles()...)
 }
 
 // String convert to string value.
@@ -102,7 +127,13 @@ func (sm *SourceModification) String() (string, error) {
 		return "", err
 	}
 
+	deleted, err := appendPrefix(sm.DeletedFiles(), deletePrefix)
+	if err != nil {
+		return "", err
+	}
+
 	files = append(files, modified...)
+	files = append(files, deleted...)
 
 	// sort filenames without a prefix
 	sort.Slice(files, func(i, j int) bool {
@@ -81,3 +81,11 @@ func TestMerge(t *testing.T) {
 	require.Subset(t, sm1.ModifiedFiles(), []string{"foo1", "foo2", "foo3", "foo4", "foo5"})
 	require.Subset(t, sm1.CreatedFiles(), []string{"bar1", "bar2", "bar3"})
 }
+
+func TestAppendDeletedFiles(t *testing.T) {
+	sm := sourceModificationExample()
+	sm.AppendDeletedFiles("dfoo", "mfoo", "cfoo")
+	require.ElementsMatch(t, []string{"dfoo", "mfoo"}, sm.DeletedFiles())
+	require.NotContains(t, sm.ModifiedFiles(), "mfoo")
+	require.NotContains(t, sm.CreatedFiles(), "cfoo")
+}
@@ -179,3 +179,18 @@ func RepositoryURL(path string) (string, error) {
 
 	return origin.URLs[0], nil
 }
+
+// HeadCommit returns the hash of the commit checked out in a Git repository.
+func HeadCommit(path string) (string, error) {
+	repo, err := git.PlainOpenWithOptions(path, &defaultOpenOpts)
+	if err != nil {
+		return "", err
+	}
+
+	ref, err := repo.Head()
+	if err != nil {
+		return "", err
+	}
+
+	return ref.Hash().String(), nil
+}
@@ -443,3 +443,21 @@ func TestIsRepository(t *testing.T) {
 		})
 	}
 }
+
+func TestHeadCommit(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(path.Join(dir, "foo"), []byte("hello"), 0o644))
+	require.NoError(t, xgit.InitAndCommit(dir))
+
+	repo, err := git.PlainOpen(dir)
+	require.NoError(t, err)
+	head, err := repo.Head()
+	require.NoError(t, err)
+
+	hash, err := xgit.HeadCommit(dir)
+	require.NoError(t, err)
+	require.Equal(t, head.Hash().String(), hash)
+
+	_, err = xgit.HeadCommit(t.TempDir())
+	require.Error(t, err)
+}
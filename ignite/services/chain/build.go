@@ -6,7 +6,9 @@ import (
 	"os"
 	"path/filepath"
 	"runtime"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/ignite/cli/v29/ignite/pkg/archive"
 	"github.com/ignite/cli/v29/ignite/pkg/cache"
@@ -82,7 +84,7 @@ func (c *Chain) build(
 		buildTags = append(buildTags, consumerDevel)
 	}
 
-	buildFlags, err := c.preBuild(ctx, cacheStorage, buildTags...)
+	buildFlags, err := c.preBuild(ctx, cacheStorage, false, buildTags...)
 	if err != nil {
 		return err
 	}
@@ -108,6 +110,10 @@ func (c *Chain) build(
 // BuildRelease builds binaries for a release. targets is a list
 // of GOOS:GOARCH when provided. It defaults to your system when no targets provided.
 // prefix is used as prefix to tarballs containing each target.
+// When the chain is configured with ReproducibleRelease the binaries and tarballs
+// are reproducible, and the release includes an SBOM and, with a ReleaseSigner,
+// a signed provenance.
+// When it is configured with ReleaseUpgradeInfo the release includes the upgrade info.
 func (c *Chain) BuildRelease(
 	ctx context.Context,
 	cacheStorage cache.Storage,
@@ -127,11 +133,29 @@ func (c *Chain) BuildRelease(
 		return "", err
 	}
 
-	buildFlags, err := c.preBuild(ctx, cacheStorage, buildParams...)
+	reproducible := c.options.reproducibleRelease
+
+	buildFlags, err := c.preBuild(ctx, cacheStorage, reproducible, buildParams...)
 	if err != nil {
 		return "", err
 	}
 
+	var (
+		sourceDate     time.Time
+		archiveOptions []archive.Option
+		buildEnv       []string
+		tarballs       []releaseTarball
+	)
+	if reproducible {
+		if sourceDate, err = c.sourceDateEpoch(); err != nil {
+			return "", err
+		}
+
+		buildFlags = append(buildFlags, gocmd.FlagTrimpath)
+		buildEnv = append(buildEnv, cmdrunner.Env(envSourceDateEpoch, strconv.FormatInt(sourceDate.Unix(), 10)))
+		archiveOptions = append(archiveOptions, archive.Reproducible(sourceDate))
+	}
+
 	binary, err := c.Binary()
 	if err != nil {
 		return "", err
@@ -169,10 +193,10 @@ func (c *Chain) BuildRelease(
 		defer os.RemoveAll(out)
 
 		buildOptions := []exec.Option{
-			exec.StepOption(step.Env(
+			exec.StepOption(step.Env(append([]string{
 				cmdrunner.Env(gocmd.EnvGOOS, goos),
 				cmdrunner.Env(gocmd.EnvGOARCH, goarch),
-			)),
+			}, buildEnv...)...)),
 		}
 
 		if err := gocmd.BuildPath(ctx, out, binary, mainPath, buildFlags, buildOptions...); err != nil {
@@ -188,9 +212,31 @@ func (c *Chain) BuildRelease(
 		}
 		defer tarf.Close()
 
-		if err := archive.CreateArchive(out, tarf); err != nil {
+		if err := archive.CreateArchive(out, tarf, archiveOptions...); err != nil {
 			return "", errors.Errorf("error creating release archive: %w", err)
 		}
+		tarballs = append(tarballs, releaseTarball{goos: goos, goarch: goarch, path: tarPath})
+	}
+
+	if c.options.releaseURLTemplate != "" {
+		if err := c.writeUpgradeInfo(releasePath, tarballs); err != nil {
+			return "", err
+		}
+	}
+
+	if reproducible {
+		attestation := releaseAttestation{
+			releasePath: releasePath,
+			prefix:      prefix,
+			tarballs:    tarballs,
+			targets:     targets,
+			buildTags:   buildParams,
+			buildFlags:  buildFlags,
+			sourceDate:  sourceDate,
+		}
+		if err := c.attestRelease(attestation); err != nil {
+			return "", err
+		}
 	}
 
 	checksumPath := filepath.Join(releasePath, releaseChecksumKey)
@@ -202,6 +248,7 @@ func (c *Chain) BuildRelease(
 func (c *Chain) preBuild(
 	ctx context.Context,
 	cacheStorage cache.Storage,
+	reproducible bool,
 	buildTags ...string,
 ) (buildFlags []string, err error) {
 	config, err := c.Config()
@@ -223,6 +270,10 @@ func (c *Chain) preBuild(
 		fmt.Sprintf("-X github.com/cosmos/cosmos-sdk/version.BuildTags=%s", strings.Join(buildTags, ",")),
 		fmt.Sprintf("-X %s/cmd/%s/cmd.ChainID=%s", c.app.ImportPath, c.app.D(), chainID),
 	)
+	if reproducible {
+		// the build id contains hashes of the build inputs that depend on the environment.
+		ldFlags = append(ldFlags, "-buildid=")
+	}
 	buildFlags = []string{
 		gocmd.FlagMod, gocmd.FlagModValueReadOnly,
 		gocmd.FlagTags, gocmd.Tags(buildTags...),
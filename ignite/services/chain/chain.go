@@ -6,6 +6,7 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/go-git/go-git/v5"
 	"github.com/spf13/cobra"
@@ -22,6 +23,7 @@ import (
 	"github.com/ignite/cli/v29/ignite/pkg/cosmosver"
 	"github.com/ignite/cli/v29/ignite/pkg/errors"
 	"github.com/ignite/cli/v29/ignite/pkg/events"
+	"github.com/ignite/cli/v29/ignite/pkg/provenance"
 	"github.com/ignite/cli/v29/ignite/pkg/repoversion"
 	"github.com/ignite/cli/v29/ignite/pkg/xexec"
 	"github.com/ignite/cli/v29/ignite/pkg/xurl"
@@ -48,6 +50,16 @@ type (
 		serveRefresher chan struct{}
 		served         bool
 
+		// hotReloaded is true when the app is restarted on its existing data directory.
+		hotReloaded bool
+
+		// hotReloadUpgrade is the no-op upgrade adding the stores missing from the data directory.
+		hotReloadUpgrade hotReloadUpgrade
+
+		// keepPreviousBinary is true when the binary used before a hot reload must be kept
+		// because the new binary hasn't restarted on the existing data directory yet.
+		keepPreviousBinary bool
+
 		ev          events.Bus
 		logOutputer uilog.Outputer
 	}
@@ -76,11 +88,24 @@ type (
 
 		// path of a custom config file
 		ConfigFile string
+
+		// profile is the name of the config profile to apply.
+		profile string
+
+		// reproducibleRelease makes the release builds reproducible.
+		reproducibleRelease bool
+
+		// releaseSigner signs the provenance of reproducible releases.
+		releaseSigner provenance.Signer
+
+		// releaseURLTemplate is the template of the binary URLs of the release upgrade info.
+		releaseURLTemplate string
 	}
 
 	version struct {
 		tag  string
 		hash string
+		time time.Time
 	}
 
 	// Option configures Chain.
@@ -115,6 +140,13 @@ func ConfigFile(configFile string) Option {
 	}
 }
 
+// Profile selects the config profile whose overlays are applied over the config file.
+func Profile(profile string) Option {
+	return func(c *Chain) {
+		c.options.profile = profile
+	}
+}
+
 // WithOutputer sets the CLI outputer for the chain.
 func WithOutputer(s uilog.Outputer) Option {
 	return func(c *Chain) {
@@ -146,6 +178,30 @@ func CheckCosmosSDKVersion() Option {
 	}
 }
 
+// ReproducibleRelease makes release builds reproducible and ships each release
+// with an SBOM, and with a signed provenance statement when a ReleaseSigner is set.
+func ReproducibleRelease() Option {
+	return func(c *Chain) {
+		c.options.reproducibleRelease = true
+	}
+}
+
+// ReleaseSigner sets the signer of the provenance statement of reproducible releases.
+// It has no effect when the releases are not reproducible.
+func ReleaseSigner(signer provenance.Signer) Option {
+	return func(c *Chain) {
+		c.options.releaseSigner = signer
+	}
+}
+
+// ReleaseUpgradeInfo makes releases include the upgrade info used in software
+// upgrade plans, with the binary URL of each target rendered from urlTemplate.
+func ReleaseUpgradeInfo(urlTemplate string) Option {
+	return func(c *Chain) {
+		c.options.releaseURLTemplate = urlTemplate
+	}
+}
+
 // PrintGeneratedPaths prints the output paths of the generated code.
 func PrintGeneratedPaths() Option {
 	return func(c *Chain) {
@@ -215,6 +271,7 @@ func (c *Chain) appVersion() (v version, err error) {
 
 	v.hash = ver.Hash
 	v.tag = ver.Tag
+	v.time = ver.Time
 
 	return v, nil
 }
@@ -259,7 +316,26 @@ func (c *Chain) Config() (*chainconfig.Config, error) {
 	if configPath == "" {
 		return chainconfig.DefaultChainConfig(), nil
 	}
-	return chainconfig.ParseFile(configPath)
+	return chainconfig.ParseFileWithProfile(configPath, c.options.profile)
+}
+
+// configPaths returns the paths of the config file and of the overlay file
+// of the selected profile when it exists.
+func (c *Chain) configPaths() []string {
+	configPath := c.ConfigPath()
+	if configPath == "" {
+		return nil
+	}
+
+	paths := []string{configPath}
+	if c.options.profile != "" {
+		overlayPath := chainconfig.ProfilePath(configPath, c.options.profile)
+		if _, err := os.Stat(overlayPath); err == nil {
+			paths = append(paths, overlayPath)
+		}
+	}
+
+	return paths
 }
 
 // ID returns the chain's id.
@@ -462,26 +538,32 @@ func (c *Chain) KeyringBackend() (chaincmd.KeyringBackend, error) {
 
 // Commands returns the runner execute commands on the chain's binary.
 func (c *Chain) Commands(ctx context.Context) (chaincmdrunner.Runner, error) {
-	id, err := c.ID()
+	binary, err := c.Binary()
 	if err != nil {
 		return chaincmdrunner.Runner{}, err
 	}
 
-	home, err := c.Home()
+	// Try to make the binary path absolute. This will also
+	// find the binary path when the Go bin path is not part
+	// of the PATH environment variable.
+	binary = xexec.TryResolveAbsPath(binary)
+
+	return c.commandsWithBinary(ctx, binary)
+}
+
+// commandsWithBinary returns the runner execute commands on the given binary
+// using the chain's configuration.
+func (c *Chain) commandsWithBinary(ctx context.Context, binary string) (chaincmdrunner.Runner, error) {
+	id, err := c.ID()
 	if err != nil {
 		return chaincmdrunner.Runner{}, err
 	}
 
-	binary, err := c.Binary()
+	home, err := c.Home()
 	if err != nil {
 		return chaincmdrunner.Runner{}, err
 	}
 
-	// Try to make the binary path absolute. This will also
-	// find the binary path when the Go bin path is not part
-	// of the PATH environment variable.
-	binary = xexec.TryResolveAbsPath(binary)
-
 	backend, err := c.KeyringBackend()
 	if err != nil {
 		return chaincmdrunner.Runner{}, err
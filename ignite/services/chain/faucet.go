@@ -2,17 +2,26 @@ package chain
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
+	"io"
 	"os"
+	"path/filepath"
 	"strings"
+	"sync"
 	"time"
 
 	sdkmath "cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	_ "github.com/lib/pq" // register the postgres driver used by the faucet ledger
 
 	chainconfig "github.com/ignite/cli/v29/ignite/config/chain"
+	"github.com/ignite/cli/v29/ignite/config/chain/base"
 	chaincmdrunner "github.com/ignite/cli/v29/ignite/pkg/chaincmd/runner"
+	"github.com/ignite/cli/v29/ignite/pkg/cosmosaccount"
+	"github.com/ignite/cli/v29/ignite/pkg/cosmosclient"
 	"github.com/ignite/cli/v29/ignite/pkg/cosmosfaucet"
 	"github.com/ignite/cli/v29/ignite/pkg/errors"
 	"github.com/ignite/cli/v29/ignite/pkg/xurl"
@@ -27,6 +36,14 @@ var (
 	ErrFaucetAccountDoesNotExist = errors.New("specified account (faucet.name) does not exist")
 )
 
+const (
+	// faucetBoltLedger is the default name of the faucet ledger file for the bolt ledger.
+	faucetBoltLedger = "faucet_ledger.db"
+
+	// faucetJSONLedger is the default name of the faucet ledger file for the json ledger.
+	faucetJSONLedger = "faucet_ledger.jsonl"
+)
+
 var envAPIAddress = os.Getenv("API_ADDRESS")
 
 // Faucet returns the faucet for the chain or an error if the faucet
@@ -90,7 +107,7 @@ func (c *Chain) Faucet(ctx context.Context) (cosmosfaucet.Faucet, error) {
 	// check if indexer is enabled or not.
 	if indexerDisabled(validator.Config) {
 		faucetOptions = append(faucetOptions, cosmosfaucet.IndexerDisabled())
-		c.ev.Send("⚠️ CometBFT indexer disabled. Faucet can't check account limits or verify transaction status.")
+		c.ev.Send("⚠️ CometBFT indexer disabled. Faucet can't verify transaction status.")
 	}
 
 	// parse coins to pass to the faucet as coins.
@@ -136,8 +153,247 @@ func (c *Chain) Faucet(ctx context.Context) (cosmosfaucet.Faucet, error) {
 		faucetOptions = append(faucetOptions, cosmosfaucet.RefreshWindow(rateLimitWindow))
 	}
 
+	challengeOption, err := faucetChallenge(conf.Faucet.Challenge)
+	if err != nil {
+		return cosmosfaucet.Faucet{}, err
+	}
+	if challengeOption != nil {
+		faucetOptions = append(faucetOptions, challengeOption)
+	}
+
+	if quota := conf.Faucet.IPQuota; quota.Requests > 0 || quota.SubnetRequests > 0 {
+		limits := cosmosfaucet.IPQuotaLimits{
+			Requests:       int(quota.Requests),
+			SubnetRequests: int(quota.SubnetRequests),
+			IPv4SubnetBits: int(quota.IPv4SubnetBits),
+			IPv6SubnetBits: int(quota.IPv6SubnetBits),
+		}
+
+		if quota.Window != "" {
+			limits.Window, err = time.ParseDuration(quota.Window)
+			if err != nil {
+				return cosmosfaucet.Faucet{}, errors.Errorf("%w: %s", err, quota.Window)
+			}
+		}
+
+		faucetOptions = append(faucetOptions, cosmosfaucet.IPQuota(limits))
+	}
+
+	if conf.Faucet.BatchWindow != "" {
+		batchWindow, err := time.ParseDuration(conf.Faucet.BatchWindow)
+		if err != nil {
+			return cosmosfaucet.Faucet{}, errors.Errorf("%w: %s", err, conf.Faucet.BatchWindow)
+		}
+
+		sender := &faucetMultiSender{
+			chain:           c,
+			accountName:     *conf.Faucet.Name,
+			fees:            conf.Faucet.TxFee,
+			indexerDisabled: indexerDisabled(validator.Config),
+		}
+		faucetOptions = append(faucetOptions, cosmosfaucet.Batch(sender, batchWindow, int(conf.Faucet.BatchSize)))
+	}
+
+	// the ledger is created last so its resources are released when the faucet can't be created.
+	ledger, err := c.faucetLedger(ctx, conf.Faucet.Ledger)
+	if err != nil {
+		return cosmosfaucet.Faucet{}, err
+	}
+
+	// without indexer the transfer history can't be queried, so the
+	// transfers are recorded in memory to still enforce the limits
+	if ledger == nil && indexerDisabled(validator.Config) {
+		ledger = cosmosfaucet.NewMemoryLedger()
+	}
+
+	if ledger != nil {
+		faucetOptions = append(faucetOptions, cosmosfaucet.WithLedger(ledger))
+	}
+
 	// init the faucet with options and return.
-	return cosmosfaucet.New(ctx, commands, faucetOptions...)
+	faucet, err := cosmosfaucet.New(ctx, commands, faucetOptions...)
+	if err != nil {
+		if closer, ok := ledger.(io.Closer); ok {
+			_ = closer.Close()
+		}
+		return cosmosfaucet.Faucet{}, err
+	}
+
+	return faucet, nil
+}
+
+// faucetLedger returns the ledger used by the faucet to record its transfers.
+// Nil is returned when no ledger is configured.
+func (c *Chain) faucetLedger(ctx context.Context, cfg base.FaucetLedger) (cosmosfaucet.Ledger, error) {
+	path := cfg.Path
+	if path != "" && !filepath.IsAbs(path) {
+		path = filepath.Join(c.app.Path, path)
+	}
+
+	switch cfg.Type {
+	case "":
+		return nil, nil
+	case base.FaucetLedgerMemory:
+		return cosmosfaucet.NewMemoryLedger(), nil
+	case base.FaucetLedgerBolt:
+		if path == "" {
+			savePath, err := c.chainSavePath()
+			if err != nil {
+				return nil, err
+			}
+			path = filepath.Join(savePath, faucetBoltLedger)
+		}
+		return cosmosfaucet.NewBoltLedger(path)
+	case base.FaucetLedgerJSON:
+		if path == "" {
+			savePath, err := c.chainSavePath()
+			if err != nil {
+				return nil, err
+			}
+			path = filepath.Join(savePath, faucetJSONLedger)
+		}
+		return cosmosfaucet.NewJSONLedger(path)
+	case base.FaucetLedgerPostgres:
+		db, err := sql.Open("postgres", cfg.DSN)
+		if err != nil {
+			return nil, err
+		}
+		ledger, err := cosmosfaucet.NewSQLLedger(ctx, db)
+		if err != nil {
+			_ = db.Close()
+			return nil, err
+		}
+		return ledger, nil
+	default:
+		return nil, errors.Errorf("invalid faucet ledger type %q", cfg.Type)
+	}
+}
+
+// faucetChallenge returns the faucet option to require a challenge to request tokens.
+// Nil is returned when no challenge is configured.
+func faucetChallenge(cfg base.FaucetChallenge) (cosmosfaucet.Option, error) {
+	switch cfg.Type {
+	case "":
+		return nil, nil
+	case base.FaucetChallengePoW:
+		var ttl time.Duration
+		if cfg.TTL != "" {
+			var err error
+			if ttl, err = time.ParseDuration(cfg.TTL); err != nil {
+				return nil, errors.Errorf("%w: %s", err, cfg.TTL)
+			}
+		}
+
+		pow, err := cosmosfaucet.NewProofOfWork(cfg.Difficulty, ttl)
+		if err != nil {
+			return nil, err
+		}
+		return cosmosfaucet.Challenge(pow), nil
+	case base.FaucetChallengeCaptcha:
+		verifier := cosmosfaucet.NewCaptchaVerifier(cfg.VerifyURL, os.ExpandEnv(cfg.Secret))
+		return cosmosfaucet.Challenge(verifier), nil
+	default:
+		return nil, errors.Errorf("invalid faucet challenge type %q", cfg.Type)
+	}
+}
+
+// faucetMultiSender sends the faucet batch transactions with a Cosmos client
+// that signs them with the faucet account from the chain keyring.
+type faucetMultiSender struct {
+	chain           *Chain
+	accountName     string
+	fees            string
+	indexerDisabled bool
+
+	mu     sync.Mutex
+	client *cosmosclient.Client
+}
+
+// MultiSend implements cosmosfaucet.MultiSender.
+func (s *faucetMultiSender) MultiSend(ctx context.Context, outputs []banktypes.Output) (string, error) {
+	client, err := s.cosmosClient(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	account, err := client.Account(s.accountName)
+	if err != nil {
+		return "", err
+	}
+
+	txService, err := client.BankMultiSendTx(ctx, account, outputs)
+	if err != nil {
+		return "", err
+	}
+
+	// the tx can't be confirmed by querying it when the indexer is disabled
+	broadcast := txService.Broadcast
+	if s.indexerDisabled {
+		broadcast = txService.BroadcastAsync
+	}
+
+	resp, err := broadcast(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return resp.TxHash, nil
+}
+
+// cosmosClient returns the client used to send the transactions. The client is
+// created on first use because it requires the chain node to be running.
+func (s *faucetMultiSender) cosmosClient(ctx context.Context) (cosmosclient.Client, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.client != nil {
+		return *s.client, nil
+	}
+
+	rpcAddress, err := s.chain.RPCPublicAddress()
+	if err != nil {
+		return cosmosclient.Client{}, err
+	}
+
+	nodeAddress, err := xurl.HTTP(rpcAddress)
+	if err != nil {
+		return cosmosclient.Client{}, err
+	}
+
+	home, err := s.chain.Home()
+	if err != nil {
+		return cosmosclient.Client{}, err
+	}
+
+	keyringBackend, err := s.chain.KeyringBackend()
+	if err != nil {
+		return cosmosclient.Client{}, err
+	}
+
+	prefix, err := s.chain.Bech32Prefix()
+	if err != nil {
+		return cosmosclient.Client{}, err
+	}
+
+	options := []cosmosclient.Option{
+		cosmosclient.WithNodeAddress(nodeAddress),
+		cosmosclient.WithHome(home),
+		cosmosclient.WithKeyringBackend(cosmosaccount.KeyringBackend(keyringBackend)),
+		cosmosclient.WithBech32Prefix(prefix),
+		cosmosclient.WithGas(cosmosclient.GasAuto),
+	}
+	if s.fees != "" {
+		options = append(options, cosmosclient.WithFees(s.fees))
+	}
+
+	client, err := cosmosclient.New(ctx, options...)
+	if err != nil {
+		return cosmosclient.Client{}, err
+	}
+
+	s.client = &client
+
+	return client, nil
 }
 
 // indexerDisabled checks if the indexer is disabled in the config.yml.
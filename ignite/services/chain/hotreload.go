@@ -0,0 +1,137 @@
+package chain
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/pkg/events"
+	"github.com/ignite/cli/v29/ignite/pkg/xgenny"
+	"github.com/ignite/cli/v29/ignite/templates/upgrade"
+)
+
+// upgradeInfoFile is the name of the file of the app data directory read by the
+// upgrade module to set the store loader of the upgrade being applied.
+const upgradeInfoFile = "upgrade-info.json"
+
+// storeMismatchRe matches the error returned when the app loads a store missing from its data directory.
+var storeMismatchRe = regexp.MustCompile(`version of store (\S+) mismatch root store's version; expected (\d+)`)
+
+// hotReloadUpgrade holds the no-op upgrade scaffolded to add the stores of new modules
+// when the app is restarted on its existing data directory.
+type hotReloadUpgrade struct {
+	height int64
+	stores []string
+}
+
+// name returns the name of the upgrade.
+func (u hotReloadUpgrade) name() string {
+	return fmt.Sprintf("hot-reload-%d", u.height)
+}
+
+// parseStoreMismatch returns the name of a store missing from the app data directory
+// and the last height of the data directory from the start error logs of the app.
+func parseStoreMismatch(logs string) (store string, height int64, ok bool) {
+	m := storeMismatchRe.FindStringSubmatch(logs)
+	if m == nil {
+		return "", 0, false
+	}
+
+	height, err := strconv.ParseInt(m[2], 10, 64)
+	if err != nil {
+		return "", 0, false
+	}
+
+	return m[1], height, true
+}
+
+// hotReloadAddStores scaffolds a no-op upgrade handler when the app failed to restart
+// because the stores of new modules are missing from its data directory.
+// The upgrade info is written in the data directory so the missing stores are added
+// by the upgrade store loader when the app restarts at the next height.
+// It returns false when the start error is not caused by a missing store, or when the
+// store was already added by the upgrade, in which case the state must be exported.
+func (c *Chain) hotReloadAddStores(ctx context.Context, startErr *CannotStartAppError) (bool, error) {
+	store, height, ok := parseStoreMismatch(errors.Unwrap(startErr.Err).Error())
+	if !ok {
+		return false, nil
+	}
+
+	// a single upgrade adds all the missing stores at the next height
+	if c.hotReloadUpgrade.height != height+1 {
+		c.hotReloadUpgrade = hotReloadUpgrade{height: height + 1}
+	}
+	if slices.Contains(c.hotReloadUpgrade.stores, store) {
+		return false, nil
+	}
+	c.hotReloadUpgrade.stores = append(c.hotReloadUpgrade.stores, store)
+
+	c.ev.Send(
+		fmt.Sprintf("Store %q is missing from the app state, scaffolding the no-op upgrade %q...", store, c.hotReloadUpgrade.name()),
+		events.ProgressStart(),
+	)
+
+	if err := c.scaffoldHotReloadUpgrade(ctx); err != nil {
+		return false, err
+	}
+
+	if err := c.writeHotReloadUpgradeInfo(); err != nil {
+		return false, err
+	}
+
+	// the previous binary must be kept to export the state if the upgrade doesn't fix the restart
+	c.keepPreviousBinary = true
+
+	return true, nil
+}
+
+// scaffoldHotReloadUpgrade scaffolds the no-op upgrade adding the missing stores to the app.
+// The upgrade is scaffolded again when more stores are missing.
+func (c *Chain) scaffoldHotReloadUpgrade(ctx context.Context) error {
+	opts := &upgrade.Options{
+		ModulePath:  c.app.ImportPath,
+		UpgradeName: c.hotReloadUpgrade.name(),
+		AddStores:   c.hotReloadUpgrade.stores,
+	}
+
+	if _, err := os.Stat(filepath.Join(c.app.Path, "app", "upgrades.go")); os.IsNotExist(err) {
+		opts.FirstUpgrade = true
+	} else if err != nil {
+		return err
+	}
+
+	g, err := upgrade.NewGenerator(opts)
+	if err != nil {
+		return err
+	}
+
+	_, err = xgenny.NewRunner(ctx, c.app.Path).RunAndApply(g)
+	return err
+}
+
+// writeHotReloadUpgradeInfo writes the info of the hot reload upgrade in the app data directory.
+func (c *Chain) writeHotReloadUpgradeInfo() error {
+	home, err := c.Home()
+	if err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(struct {
+		Name   string `json:"name"`
+		Height int64  `json:"height"`
+	}{
+		Name:   c.hotReloadUpgrade.name(),
+		Height: c.hotReloadUpgrade.height,
+	})
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filepath.Join(home, "data", upgradeInfoFile), data, 0o600)
+}
@@ -0,0 +1,37 @@
+package chain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseStoreMismatch(t *testing.T) {
+	tests := []struct {
+		name       string
+		logs       string
+		wantStore  string
+		wantHeight int64
+		wantOK     bool
+	}{
+		{
+			name:       "missing store",
+			logs:       "Error: failed to load latest version: version of store blog mismatch root store's version; expected 42 got 0; new stores should be added using StoreUpgrades",
+			wantStore:  "blog",
+			wantHeight: 42,
+			wantOK:     true,
+		},
+		{
+			name: "other error",
+			logs: "Error: failed to load latest version: no such file or directory",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store, height, ok := parseStoreMismatch(tt.logs)
+			require.Equal(t, tt.wantOK, ok)
+			require.Equal(t, tt.wantStore, store)
+			require.Equal(t, tt.wantHeight, height)
+		})
+	}
+}
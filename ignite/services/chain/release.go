@@ -0,0 +1,226 @@
+package chain
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/ignite/cli/v29/ignite/pkg/checksum"
+	"github.com/ignite/cli/v29/ignite/pkg/cosmosupgrade"
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/pkg/gocmd"
+	"github.com/ignite/cli/v29/ignite/pkg/gomodule"
+	"github.com/ignite/cli/v29/ignite/pkg/provenance"
+	"github.com/ignite/cli/v29/ignite/pkg/sbom"
+	igniteversion "github.com/ignite/cli/v29/ignite/version"
+)
+
+const (
+	envSourceDateEpoch = "SOURCE_DATE_EPOCH"
+	releaseBuildType   = "https://github.com/ignite/cli/chain-build-release@v1"
+	releaseBuilderID   = "https://github.com/ignite/cli"
+)
+
+// releaseTarball is the tarball of a release target.
+type releaseTarball struct {
+	goos   string
+	goarch string
+	path   string
+}
+
+// releaseAttestation holds the inputs and outputs of a reproducible release.
+type releaseAttestation struct {
+	releasePath string
+	prefix      string
+	tarballs    []releaseTarball
+	targets     []string
+	buildTags   []string
+	buildFlags  []string
+	sourceDate  time.Time
+}
+
+// sourceDateEpoch returns the time used to make releases reproducible. It is read
+// from SOURCE_DATE_EPOCH and defaults to the time of the source's HEAD commit.
+func (c *Chain) sourceDateEpoch() (time.Time, error) {
+	if epoch := os.Getenv(envSourceDateEpoch); epoch != "" {
+		sec, err := strconv.ParseInt(epoch, 10, 64)
+		if err != nil {
+			return time.Time{}, errors.Errorf("invalid %s %q: %w", envSourceDateEpoch, epoch, err)
+		}
+		return time.Unix(sec, 0).UTC(), nil
+	}
+
+	if c.sourceVersion.time.IsZero() {
+		return time.Time{}, errors.Errorf(
+			"cannot determine the source date of the release without git history, set %s",
+			envSourceDateEpoch,
+		)
+	}
+
+	return c.sourceVersion.time.UTC(), nil
+}
+
+// UpgradeInfoPath returns the path of the upgrade info of releases built in the
+// default release dir.
+func (c *Chain) UpgradeInfoPath() string {
+	return filepath.Join(c.app.Path, releaseDir, cosmosupgrade.InfoFile)
+}
+
+// writeUpgradeInfo writes the upgrade info of the release, with the URL and the
+// checksum of the tarball of each target, into the release dir.
+func (c *Chain) writeUpgradeInfo(releasePath string, tarballs []releaseTarball) error {
+	var info cosmosupgrade.Info
+	for _, t := range tarballs {
+		sum, err := checksum.File(t.path)
+		if err != nil {
+			return err
+		}
+
+		url, err := cosmosupgrade.BinaryURL(c.options.releaseURLTemplate, cosmosupgrade.URLData{
+			Version: c.sourceVersion.tag,
+			OS:      t.goos,
+			Arch:    t.goarch,
+			Tarball: filepath.Base(t.path),
+		}, sum)
+		if err != nil {
+			return err
+		}
+
+		info.Add(t.goos, t.goarch, url)
+	}
+
+	bz, err := json.MarshalIndent(info, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filepath.Join(releasePath, cosmosupgrade.InfoFile), append(bz, '\n'), 0o644)
+}
+
+// attestRelease writes the SBOM of the chain and, when the chain has a release signer,
+// a signed provenance statement of the release artifacts into the release dir.
+func (c *Chain) attestRelease(a releaseAttestation) error {
+	version := c.sourceVersion.tag
+	if version == "" {
+		version = c.sourceVersion.hash
+	}
+
+	modFile, err := gomodule.ParseAt(c.app.Path)
+	if err != nil {
+		return err
+	}
+
+	bom, err := sbom.FromGoMod(modFile, version, "ignite-"+igniteversion.Version, a.sourceDate)
+	if err != nil {
+		return err
+	}
+
+	cycloneDX, err := bom.CycloneDX()
+	if err != nil {
+		return err
+	}
+
+	spdx, err := bom.SPDX()
+	if err != nil {
+		return err
+	}
+
+	sbomPaths := []string{
+		filepath.Join(a.releasePath, fmt.Sprintf("%s_sbom.cdx.json", a.prefix)),
+		filepath.Join(a.releasePath, fmt.Sprintf("%s_sbom.spdx.json", a.prefix)),
+	}
+	for i, content := range [][]byte{cycloneDX, spdx} {
+		if err := os.WriteFile(sbomPaths[i], content, 0o644); err != nil {
+			return errors.Errorf("error writing release SBOM: %w", err)
+		}
+	}
+
+	if c.options.releaseSigner == nil {
+		return nil
+	}
+
+	var subjectPaths []string
+	for _, t := range a.tarballs {
+		subjectPaths = append(subjectPaths, t.path)
+	}
+
+	subjects, err := provenance.FileSubjects(append(subjectPaths, sbomPaths...)...)
+	if err != nil {
+		return err
+	}
+
+	predicate, err := c.releasePredicate(a)
+	if err != nil {
+		return err
+	}
+
+	envelope, err := provenance.Sign(provenance.NewStatement(subjects, predicate), c.options.releaseSigner)
+	if err != nil {
+		return err
+	}
+
+	bz, err := json.Marshal(envelope)
+	if err != nil {
+		return err
+	}
+
+	provenancePath := filepath.Join(a.releasePath, fmt.Sprintf("%s_provenance.intoto.jsonl", a.prefix))
+	return os.WriteFile(provenancePath, append(bz, '\n'), 0o644)
+}
+
+func (c *Chain) releasePredicate(a releaseAttestation) (provenance.Predicate, error) {
+	goVersion, err := gocmd.Env(gocmd.EnvGOVERSION)
+	if err != nil {
+		return provenance.Predicate{}, err
+	}
+
+	var dependencies []provenance.ResourceDescriptor
+	if c.sourceVersion.hash != "" {
+		dependencies = append(dependencies, provenance.ResourceDescriptor{
+			URI:    c.app.ImportPath,
+			Digest: map[string]string{"gitCommit": c.sourceVersion.hash},
+		})
+	}
+
+	for _, name := range []string{"go.mod", "go.sum"} {
+		path := filepath.Join(c.app.Path, name)
+		if _, err := os.Stat(path); os.IsNotExist(err) {
+			continue
+		}
+
+		files, err := provenance.FileSubjects(path)
+		if err != nil {
+			return provenance.Predicate{}, err
+		}
+		dependencies = append(dependencies, provenance.ResourceDescriptor{
+			Name:   name,
+			Digest: files[0].Digest,
+		})
+	}
+
+	return provenance.Predicate{
+		BuildDefinition: provenance.BuildDefinition{
+			BuildType: releaseBuildType,
+			ExternalParameters: map[string]any{
+				"source":    c.app.ImportPath,
+				"targets":   a.targets,
+				"buildTags": a.buildTags,
+			},
+			InternalParameters: map[string]any{
+				"goVersion":       goVersion,
+				"buildFlags":      a.buildFlags,
+				"sourceDateEpoch": a.sourceDate.Unix(),
+			},
+			ResolvedDependencies: dependencies,
+		},
+		RunDetails: provenance.RunDetails{
+			Builder: provenance.Builder{
+				ID:      releaseBuilderID,
+				Version: map[string]string{"ignite": igniteversion.Version},
+			},
+		},
+	}, nil
+}
@@ -0,0 +1,164 @@
+package chain
+
+import (
+	"crypto/ed25519"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/v29/ignite/pkg/checksum"
+	"github.com/ignite/cli/v29/ignite/pkg/cosmosupgrade"
+	"github.com/ignite/cli/v29/ignite/pkg/provenance"
+)
+
+type testSigner struct {
+	key ed25519.PrivateKey
+}
+
+func (s testSigner) KeyID() string { return "test" }
+
+func (s testSigner) Sign(msg []byte) ([]byte, error) {
+	return ed25519.Sign(s.key, msg), nil
+}
+
+func TestSourceDateEpoch(t *testing.T) {
+	t.Run("head commit time", func(t *testing.T) {
+		c, err := New(tempSource(t, "testdata/version/mars.v0.2.tar.gz"))
+		require.NoError(t, err)
+
+		got, err := c.sourceDateEpoch()
+		require.NoError(t, err)
+		require.False(t, got.IsZero())
+		require.Equal(t, c.sourceVersion.time.Unix(), got.Unix())
+	})
+
+	t.Run("SOURCE_DATE_EPOCH overrides the commit time", func(t *testing.T) {
+		t.Setenv(envSourceDateEpoch, "1700000000")
+
+		c, err := New(tempSource(t, "testdata/version/mars.v0.2.tar.gz"))
+		require.NoError(t, err)
+
+		got, err := c.sourceDateEpoch()
+		require.NoError(t, err)
+		require.Equal(t, time.Unix(1700000000, 0).UTC(), got)
+	})
+
+	t.Run("invalid SOURCE_DATE_EPOCH", func(t *testing.T) {
+		t.Setenv(envSourceDateEpoch, "yesterday")
+
+		c, err := New(tempSource(t, "testdata/version/mars.v0.2.tar.gz"))
+		require.NoError(t, err)
+
+		_, err = c.sourceDateEpoch()
+		require.ErrorContains(t, err, "invalid SOURCE_DATE_EPOCH")
+	})
+
+	t.Run("no git history", func(t *testing.T) {
+		dir, err := tempSourceWithApp(t)
+		require.NoError(t, err)
+		c, err := New(dir)
+		require.NoError(t, err)
+
+		_, err = c.sourceDateEpoch()
+		require.ErrorContains(t, err, "without git history")
+	})
+}
+
+func TestAttestRelease(t *testing.T) {
+	pubKey, privKey, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+
+	dir, err := tempSourceWithApp(t)
+	require.NoError(t, err)
+	c, err := New(dir, ReproducibleRelease(), ReleaseSigner(testSigner{privKey}))
+	require.NoError(t, err)
+
+	releasePath := t.TempDir()
+	tarPath := filepath.Join(releasePath, "mars_linux_amd64.tar.gz")
+	require.NoError(t, os.WriteFile(tarPath, []byte("binary"), 0o644))
+
+	err = c.attestRelease(releaseAttestation{
+		releasePath: releasePath,
+		prefix:      "mars",
+		tarballs:    []releaseTarball{{goos: "linux", goarch: "amd64", path: tarPath}},
+		targets:     []string{"linux:amd64"},
+		sourceDate:  time.Unix(1700000000, 0).UTC(),
+	})
+	require.NoError(t, err)
+
+	require.FileExists(t, filepath.Join(releasePath, "mars_sbom.cdx.json"))
+	require.FileExists(t, filepath.Join(releasePath, "mars_sbom.spdx.json"))
+
+	bz, err := os.ReadFile(filepath.Join(releasePath, "mars_provenance.intoto.jsonl"))
+	require.NoError(t, err)
+
+	var envelope provenance.Envelope
+	require.NoError(t, json.Unmarshal(bz, &envelope))
+
+	statement, err := provenance.Verify(envelope, pubKey)
+	require.NoError(t, err)
+
+	var names []string
+	for _, s := range statement.Subject {
+		names = append(names, s.Name)
+	}
+	require.Equal(t, []string{"mars_linux_amd64.tar.gz", "mars_sbom.cdx.json", "mars_sbom.spdx.json"}, names)
+	require.Equal(t, releaseBuildType, statement.Predicate.BuildDefinition.BuildType)
+	require.Len(t, statement.Predicate.BuildDefinition.ResolvedDependencies, 1)
+	require.Equal(t, "go.mod", statement.Predicate.BuildDefinition.ResolvedDependencies[0].Name)
+}
+
+func TestAttestReleaseWithoutSigner(t *testing.T) {
+	dir, err := tempSourceWithApp(t)
+	require.NoError(t, err)
+	c, err := New(dir, ReproducibleRelease())
+	require.NoError(t, err)
+
+	releasePath := t.TempDir()
+	tarPath := filepath.Join(releasePath, "mars_linux_amd64.tar.gz")
+	require.NoError(t, os.WriteFile(tarPath, []byte("binary"), 0o644))
+
+	err = c.attestRelease(releaseAttestation{
+		releasePath: releasePath,
+		prefix:      "mars",
+		tarballs:    []releaseTarball{{goos: "linux", goarch: "amd64", path: tarPath}},
+		targets:     []string{"linux:amd64"},
+		sourceDate:  time.Unix(1700000000, 0).UTC(),
+	})
+	require.NoError(t, err)
+
+	require.FileExists(t, filepath.Join(releasePath, "mars_sbom.cdx.json"))
+	require.FileExists(t, filepath.Join(releasePath, "mars_sbom.spdx.json"))
+	require.NoFileExists(t, filepath.Join(releasePath, "mars_provenance.intoto.jsonl"))
+}
+
+func TestWriteUpgradeInfo(t *testing.T) {
+	dir, err := tempSourceWithApp(t)
+	require.NoError(t, err)
+	c, err := New(dir, ReleaseUpgradeInfo("https://example.com/v{{.Version}}/{{.Tarball}}"))
+	require.NoError(t, err)
+	c.sourceVersion.tag = "1.2.0"
+
+	releasePath := t.TempDir()
+	tarPath := filepath.Join(releasePath, "mars_linux_amd64.tar.gz")
+	require.NoError(t, os.WriteFile(tarPath, []byte("binary"), 0o644))
+
+	err = c.writeUpgradeInfo(releasePath, []releaseTarball{{goos: "linux", goarch: "amd64", path: tarPath}})
+	require.NoError(t, err)
+
+	bz, err := os.ReadFile(filepath.Join(releasePath, cosmosupgrade.InfoFile))
+	require.NoError(t, err)
+
+	var info cosmosupgrade.Info
+	require.NoError(t, json.Unmarshal(bz, &info))
+
+	sum, err := checksum.File(tarPath)
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{
+		"linux/amd64": "https://example.com/v1.2.0/mars_linux_amd64.tar.gz?checksum=sha256:" + sum,
+	}, info.Binaries)
+}
@@ -41,6 +41,10 @@ const (
 	// exportedGenesis is the name of the exported genesis file for a chain.
 	exportedGenesis = "exported_genesis.json"
 
+	// previousBinary is the name of the copy of the app binary that was running
+	// before a hot reload, used to export the state when the new binary fails.
+	previousBinary = "previous_binary"
+
 	// sourceChecksumKey is the cache key for the checksum to detect source modification.
 	sourceChecksumKey = "source_checksum"
 
@@ -72,6 +76,7 @@ type serveOptions struct {
 	skipBuild       bool
 	quitOnFail      bool
 	generateClients bool
+	hotReload       bool
 	buildTags       []string
 }
 
@@ -128,6 +133,17 @@ func ServeSkipBuild() ServeOption {
 	}
 }
 
+// ServeHotReload allows to restart the app on its existing data directory when
+// the app source or binary changes, instead of exporting and importing the genesis state.
+// When the stores of new modules are missing from the data directory, a no-op upgrade
+// adding them is scaffolded. When the app fails to restart for any other reason, the
+// state is exported using the previous binary.
+func ServeHotReload() ServeOption {
+	return func(c *serveOptions) {
+		c.hotReload = true
+	}
+}
+
 // BuildTags set the build tags for the go build.
 func BuildTags(buildTags ...string) ServeOption {
 	return func(c *serveOptions) {
@@ -201,13 +217,16 @@ func (c *Chain) Serve(ctx context.Context, cacheStorage cache.Storage, options .
 					serveOptions.skipProto,
 					serveOptions.skipBuild,
 					serveOptions.generateClients,
+					serveOptions.hotReload,
 				)
 				serveOptions.resetOnce = false
 
 				switch {
 				case err == nil:
 				case errors.Is(err, context.Canceled):
-					// If the app has been served, we save the genesis state
+					// If the app has been served, we save the genesis state.
+					// The state is also saved when hot reload is enabled, so the
+					// export is up to date when the app is served without it.
 					if c.served {
 						c.served = false
 
@@ -246,6 +265,34 @@ func (c *Chain) Serve(ctx context.Context, cacheStorage cache.Storage, options .
 					}
 
 					c.ev.SendView(errorview.NewError(err), events.ProgressFinish(), events.Group(events.GroupError))
+				case errors.As(err, &startErr) && c.hotReloaded:
+					// The app failed to restart on its existing data directory, the new code
+					// may not be compatible with the stored state. When the stores of new
+					// modules are missing, a no-op upgrade is scaffolded to add them,
+					// otherwise the export is used instead.
+					c.hotReloaded = false
+
+					upgraded, err := c.hotReloadAddStores(ctx, startErr)
+					if err != nil {
+						c.ev.SendError(err, events.ProgressFinish())
+						return err
+					}
+
+					if !upgraded {
+						c.keepPreviousBinary = false
+
+						if err := c.hotReloadFallback(ctx); err != nil {
+							c.ev.SendError(err, events.ProgressFinish())
+							return err
+						}
+					}
+
+					// Serve again using the upgrade or the imported state. The refresh is
+					// skipped when one is already pending because of a new source change.
+					select {
+					case c.serveRefresher <- struct{}{}:
+					default:
+					}
 				case errors.As(err, &startErr):
 					// Parse returned error logs
 					parsedErr := startErr.ParseStartError()
@@ -313,7 +360,7 @@ func (c *Chain) watchAppBackend(ctx context.Context) error {
 		if err != nil {
 			return err
 		}
-		watchPaths = append(appBackendSourceWatchPaths(conf.Build.Proto.Path), c.ConfigPath())
+		watchPaths = append(appBackendSourceWatchPaths(conf.Build.Proto.Path), c.configPaths()...)
 	}
 
 	return localfs.Watch(
@@ -334,8 +381,10 @@ func (c *Chain) serve(
 	ctx context.Context,
 	cacheStorage cache.Storage,
 	buildTags []string,
-	forceReset, skipProto, skipBuild, generateClients bool,
+	forceReset, skipProto, skipBuild, generateClients, hotReload bool,
 ) error {
+	c.hotReloaded = false
+
 	conf, err := c.Config()
 	if err != nil {
 		return &CannotBuildAppError{err}
@@ -362,7 +411,7 @@ func (c *Chain) serve(
 	if isInit {
 		configModified := false
 		if c.ConfigPath() != "" {
-			configModified, err = dirchange.HasDirChecksumChanged(dirCache, configChecksumKey, c.app.Path, c.ConfigPath())
+			configModified, err = dirchange.HasDirChecksumChanged(dirCache, configChecksumKey, c.app.Path, c.configPaths()...)
 			if err != nil {
 				return err
 			}
@@ -403,6 +452,10 @@ func (c *Chain) serve(
 
 	appModified := sourceModified || binaryModified
 
+	// the app is restarted on its existing data directory when hot reload is enabled,
+	// the chain is initialized and only the app source or binary has been modified
+	hotReload = hotReload && isInit && appModified
+
 	// check if exported genesis exists
 	exportGenesisExists := true
 	exportedGenesisPath, err := c.exportedGenesisPath()
@@ -423,6 +476,15 @@ func (c *Chain) serve(
 	}
 
 	if (!isInit || appModified) && !skipBuild {
+		// keep a copy of the running binary to be able to export the
+		// state in case the new binary can't restart on the existing data
+		if hotReload && binaryPath != "" && !c.keepPreviousBinary {
+			if err := c.savePreviousBinary(binaryPath); err != nil {
+				return err
+			}
+		}
+		c.keepPreviousBinary = false
+
 		// build the blockchain app
 		if err := c.build(ctx, cacheStorage, buildTags, "", skipProto, generateClients, true); err != nil {
 			return err
@@ -430,7 +492,7 @@ func (c *Chain) serve(
 	}
 
 	// init phase
-	initApp := !isInit || (appModified && !exportGenesisExists)
+	initApp := !isInit || (appModified && !exportGenesisExists && !hotReload)
 
 	//nolint:gocritic
 	if initApp {
@@ -439,6 +501,10 @@ func (c *Chain) serve(
 		if err := c.Init(ctx, InitArgsAll); err != nil {
 			return err
 		}
+	} else if hotReload {
+		// if hot reload is enabled the new binary is started on the existing data directory
+		c.ev.Send("Source changes detected, restarting the app on its existing state...", events.ProgressUpdate())
+		c.hotReloaded = true
 	} else if appModified {
 		// if the chain is already initialized but the source has been modified
 		// we reset the chain database and import the genesis state
@@ -457,7 +523,7 @@ func (c *Chain) serve(
 
 	// save checksums
 	if c.ConfigPath() != "" {
-		if err := dirchange.SaveDirChecksum(dirCache, configChecksumKey, c.app.Path, c.ConfigPath()); err != nil {
+		if err := dirchange.SaveDirChecksum(dirCache, configChecksumKey, c.app.Path, c.configPaths()...); err != nil {
 			return err
 		}
 	}
@@ -577,6 +643,8 @@ func (c *Chain) start(ctx context.Context, cfg *chainconfig.Config) error {
 }
 
 func (c *Chain) runFaucetServer(ctx context.Context, faucet cosmosfaucet.Faucet) error {
+	defer faucet.Close()
+
 	cfg, err := c.Config()
 	if err != nil {
 		return err
@@ -613,6 +681,63 @@ func (c *Chain) importChainState() error {
 	return copy.Copy(exportGenesisPath, genesisPath)
 }
 
+// hotReloadFallback restores the app state when the app fails to restart on its existing
+// data directory after a hot reload. The state is exported using the previous app binary,
+// then the database is reset and the exported genesis is imported.
+func (c *Chain) hotReloadFallback(ctx context.Context) error {
+	c.ev.Send("App failed to restart on its existing state, exporting the genesis state...", events.ProgressStart())
+
+	previousBinaryPath, err := c.previousBinaryPath()
+	if err != nil {
+		return err
+	}
+	if _, err := os.Stat(previousBinaryPath); err != nil {
+		return errors.Errorf("previous app binary not found, cannot export the state: %w", err)
+	}
+
+	previousCommands, err := c.commandsWithBinary(ctx, previousBinaryPath)
+	if err != nil {
+		return err
+	}
+
+	if err := c.saveChainState(ctx, previousCommands); err != nil {
+		return errors.Errorf("cannot export the state using the previous app binary: %w", err)
+	}
+
+	commands, err := c.Commands(ctx)
+	if err != nil {
+		return err
+	}
+
+	c.ev.Send("Existent genesis detected, restoring the database...", events.ProgressUpdate())
+
+	if err := commands.UnsafeReset(ctx); err != nil {
+		return err
+	}
+
+	return c.importChainState()
+}
+
+// savePreviousBinary keeps a copy of the app binary in the chain saved config.
+func (c *Chain) savePreviousBinary(binaryPath string) error {
+	previousBinaryPath, err := c.previousBinaryPath()
+	if err != nil {
+		return err
+	}
+
+	return copy.Copy(binaryPath, previousBinaryPath)
+}
+
+// previousBinaryPath returns the path of the copy of the app binary used before a hot reload.
+func (c *Chain) previousBinaryPath() (string, error) {
+	savePath, err := c.chainSavePath()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(savePath, previousBinary), nil
+}
+
 // chainSavePath returns the path where the chain state is saved.
 // Creates the path if it doesn't exist.
 func (c *Chain) chainSavePath() (string, error) {
@@ -0,0 +1,352 @@
+package chain
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/otiai10/copy"
+
+	"github.com/ignite/cli/v29/ignite/pkg/dirchange"
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/pkg/events"
+)
+
+const (
+	// snapshotsDir is the name of the directory where the chain state snapshots are saved.
+	snapshotsDir = "snapshots"
+
+	// snapshotGenesis is the name of the exported genesis file of a snapshot.
+	snapshotGenesis = "genesis.json"
+
+	// snapshotMetadata is the name of the metadata file of a snapshot.
+	snapshotMetadata = "snapshot.json"
+)
+
+var (
+	// ErrSnapshotNotFound is returned when a snapshot doesn't exist.
+	ErrSnapshotNotFound = errors.New("snapshot not found")
+
+	// ErrSnapshotExists is returned when saving a snapshot with a name that is already used.
+	ErrSnapshotExists = errors.New("snapshot already exists")
+
+	snapshotNameRe = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9._-]*$`)
+)
+
+// Snapshot holds the metadata of a saved chain state.
+type Snapshot struct {
+	// Name is the unique name of the snapshot.
+	Name string `json:"name"`
+
+	// ChainID is the ID of the chain the state was exported from.
+	ChainID string `json:"chain_id"`
+
+	// Height is the last block height of the exported state.
+	Height int64 `json:"height"`
+
+	// Commit is the git commit of the app source when the snapshot was saved.
+	Commit string `json:"commit,omitempty"`
+
+	// SourceChecksum is the checksum of the app source when the snapshot was saved.
+	SourceChecksum string `json:"source_checksum"`
+
+	// CreatedAt is the time when the snapshot was saved.
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// SaveSnapshot exports the current chain state and saves it as a snapshot with the given name.
+// The chain must not be running while the state is exported.
+func (c *Chain) SaveSnapshot(ctx context.Context, name string, overwrite bool) (Snapshot, error) {
+	snapshotPath, err := c.snapshotPath(name)
+	if err != nil {
+		return Snapshot{}, err
+	}
+
+	if _, err := os.Stat(snapshotPath); err == nil {
+		if !overwrite {
+			return Snapshot{}, errors.Wrap(ErrSnapshotExists, name)
+		}
+	} else if !os.IsNotExist(err) {
+		return Snapshot{}, err
+	}
+
+	chainID, err := c.ID()
+	if err != nil {
+		return Snapshot{}, err
+	}
+
+	conf, err := c.Config()
+	if err != nil {
+		return Snapshot{}, err
+	}
+
+	checksum, err := dirchange.ChecksumFromPaths(c.app.Path, appBackendSourceWatchPaths(conf.Build.Proto.Path)...)
+	if err != nil && !errors.Is(err, dirchange.ErrNoFile) {
+		return Snapshot{}, err
+	}
+
+	commands, err := c.Commands(ctx)
+	if err != nil {
+		return Snapshot{}, err
+	}
+
+	// Export the state into a temporary directory next to the snapshot, so an
+	// existing snapshot is only replaced once the new one is complete.
+	if err := os.MkdirAll(filepath.Dir(snapshotPath), 0o700); err != nil {
+		return Snapshot{}, err
+	}
+	tmpPath, err := os.MkdirTemp(filepath.Dir(snapshotPath), "."+name+"-*")
+	if err != nil {
+		return Snapshot{}, err
+	}
+	defer os.RemoveAll(tmpPath)
+
+	c.ev.Send("Exporting the chain state...", events.ProgressStart())
+
+	genesisPath := filepath.Join(tmpPath, snapshotGenesis)
+	if err := commands.Export(ctx, genesisPath); err != nil {
+		return Snapshot{}, errors.Errorf("cannot export the chain state, make sure the chain is not running: %w", err)
+	}
+
+	height, err := exportedGenesisHeight(genesisPath)
+	if err != nil {
+		return Snapshot{}, err
+	}
+
+	snapshot := Snapshot{
+		Name:           name,
+		ChainID:        chainID,
+		Height:         height,
+		Commit:         c.sourceVersion.hash,
+		SourceChecksum: hex.EncodeToString(checksum),
+		CreatedAt:      time.Now().UTC(),
+	}
+
+	data, err := json.MarshalIndent(snapshot, "", "  ")
+	if err != nil {
+		return Snapshot{}, err
+	}
+
+	if err := os.WriteFile(filepath.Join(tmpPath, snapshotMetadata), data, 0o600); err != nil {
+		return Snapshot{}, err
+	}
+
+	if err := replaceDir(tmpPath, snapshotPath); err != nil {
+		return Snapshot{}, err
+	}
+
+	c.ev.Send("Chain state exported", events.ProgressFinish())
+
+	return snapshot, nil
+}
+
+// Snapshots returns the saved snapshots of the chain sorted by creation time.
+func (c *Chain) Snapshots() ([]Snapshot, error) {
+	dir, err := c.snapshotsPath()
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := os.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	var snapshots []Snapshot
+	for _, e := range entries {
+		// ignore the temporary directories of snapshots being saved
+		if !e.IsDir() || strings.HasPrefix(e.Name(), ".") {
+			continue
+		}
+
+		snapshot, err := readSnapshot(filepath.Join(dir, e.Name()))
+		if os.IsNotExist(err) {
+			// ignore incomplete snapshots
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+
+		snapshots = append(snapshots, snapshot)
+	}
+
+	sort.SliceStable(snapshots, func(i, j int) bool {
+		return snapshots[i].CreatedAt.Before(snapshots[j].CreatedAt)
+	})
+
+	return snapshots, nil
+}
+
+// Snapshot returns a saved snapshot by name.
+func (c *Chain) Snapshot(name string) (Snapshot, error) {
+	snapshotPath, err := c.snapshotPath(name)
+	if err != nil {
+		return Snapshot{}, err
+	}
+
+	snapshot, err := readSnapshot(snapshotPath)
+	if os.IsNotExist(err) {
+		return Snapshot{}, errors.Wrap(ErrSnapshotNotFound, name)
+	}
+
+	return snapshot, err
+}
+
+// RestoreSnapshot resets the chain database and uses the snapshot state as the chain genesis.
+// The restored state is also used as the exported genesis that is imported by serve when
+// the app source changes. The chain must not be running while the state is restored.
+func (c *Chain) RestoreSnapshot(ctx context.Context, name string) (Snapshot, error) {
+	snapshot, err := c.Snapshot(name)
+	if err != nil {
+		return Snapshot{}, err
+	}
+
+	snapshotPath, err := c.snapshotPath(name)
+	if err != nil {
+		return Snapshot{}, err
+	}
+
+	commands, err := c.Commands(ctx)
+	if err != nil {
+		return Snapshot{}, err
+	}
+
+	c.ev.Send("Restoring the chain state...", events.ProgressStart())
+
+	if err := commands.UnsafeReset(ctx); err != nil {
+		return Snapshot{}, err
+	}
+
+	genesisPath, err := c.GenesisPath()
+	if err != nil {
+		return Snapshot{}, err
+	}
+
+	exportedGenesisPath, err := c.exportedGenesisPath()
+	if err != nil {
+		return Snapshot{}, err
+	}
+
+	for _, dst := range []string{genesisPath, exportedGenesisPath} {
+		if err := copy.Copy(filepath.Join(snapshotPath, snapshotGenesis), dst); err != nil {
+			return Snapshot{}, err
+		}
+	}
+
+	c.ev.Send("Chain state restored", events.ProgressFinish())
+
+	return snapshot, nil
+}
+
+// DeleteSnapshot deletes a saved snapshot by name.
+func (c *Chain) DeleteSnapshot(name string) error {
+	if _, err := c.Snapshot(name); err != nil {
+		return err
+	}
+
+	snapshotPath, err := c.snapshotPath(name)
+	if err != nil {
+		return err
+	}
+
+	return os.RemoveAll(snapshotPath)
+}
+
+// snapshotsPath returns the path of the directory where the chain snapshots are saved.
+func (c *Chain) snapshotsPath() (string, error) {
+	savePath, err := c.chainSavePath()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(savePath, snapshotsDir), nil
+}
+
+// snapshotPath returns the path of the directory of a snapshot.
+func (c *Chain) snapshotPath(name string) (string, error) {
+	if !snapshotNameRe.MatchString(name) {
+		return "", errors.Errorf("invalid snapshot name %q: only letters, digits, '.', '_' and '-' are allowed", name)
+	}
+
+	dir, err := c.snapshotsPath()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(dir, name), nil
+}
+
+// replaceDir moves the src directory to dst, replacing dst if it exists.
+// The previous dst directory is restored when the move fails.
+func replaceDir(src, dst string) error {
+	backup := filepath.Join(filepath.Dir(dst), "."+filepath.Base(dst)+".old")
+	if err := os.RemoveAll(backup); err != nil {
+		return err
+	}
+	if err := os.Rename(dst, backup); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	if err := os.Rename(src, dst); err != nil {
+		_ = os.Rename(backup, dst)
+		return err
+	}
+
+	return os.RemoveAll(backup)
+}
+
+func readSnapshot(snapshotPath string) (Snapshot, error) {
+	data, err := os.ReadFile(filepath.Join(snapshotPath, snapshotMetadata))
+	if err != nil {
+		return Snapshot{}, err
+	}
+
+	var snapshot Snapshot
+	if err := json.Unmarshal(data, &snapshot); err != nil {
+		return Snapshot{}, errors.Errorf("invalid snapshot metadata in %s: %w", snapshotPath, err)
+	}
+
+	return snapshot, nil
+}
+
+// exportedGenesisHeight returns the last block height of an exported genesis.
+// Exported genesis files start at the block that follows the exported state.
+func exportedGenesisHeight(genesisPath string) (int64, error) {
+	data, err := os.ReadFile(genesisPath)
+	if err != nil {
+		return 0, err
+	}
+
+	var genesis struct {
+		InitialHeight json.RawMessage `json:"initial_height"`
+	}
+	if err := json.Unmarshal(data, &genesis); err != nil {
+		return 0, errors.Errorf("invalid exported genesis: %w", err)
+	}
+
+	// the initial height can be encoded either as a number or as a string
+	value := strings.Trim(string(genesis.InitialHeight), `"`)
+	if value == "" {
+		return 0, nil
+	}
+
+	height, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return 0, errors.Errorf("invalid exported genesis initial height %s: %w", value, err)
+	}
+
+	if height > 0 {
+		height--
+	}
+
+	return height, nil
+}
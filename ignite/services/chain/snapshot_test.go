@@ -0,0 +1,129 @@
+package chain
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/v29/ignite/pkg/env"
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+)
+
+func TestSnapshots(t *testing.T) {
+	t.Setenv(env.ConfigDirEnvVar, t.TempDir())
+
+	dir, err := tempSourceWithApp(t)
+	require.NoError(t, err)
+
+	c, err := New(dir, ID("mars"))
+	require.NoError(t, err)
+
+	snapshots, err := c.Snapshots()
+	require.NoError(t, err)
+	require.Empty(t, snapshots)
+
+	now := time.Now().UTC().Truncate(time.Second)
+	writeSnapshot(t, c, Snapshot{Name: "second", ChainID: "mars", Height: 20, CreatedAt: now})
+	writeSnapshot(t, c, Snapshot{Name: "first", ChainID: "mars", Height: 10, CreatedAt: now.Add(-time.Hour)})
+
+	snapshots, err = c.Snapshots()
+	require.NoError(t, err)
+	require.Len(t, snapshots, 2)
+	require.Equal(t, "first", snapshots[0].Name)
+	require.Equal(t, int64(10), snapshots[0].Height)
+	require.Equal(t, "second", snapshots[1].Name)
+
+	snapshot, err := c.Snapshot("second")
+	require.NoError(t, err)
+	require.Equal(t, int64(20), snapshot.Height)
+
+	_, err = c.Snapshot("unknown")
+	require.True(t, errors.Is(err, ErrSnapshotNotFound))
+
+	_, err = c.Snapshot("../second")
+	require.Error(t, err)
+
+	require.NoError(t, c.DeleteSnapshot("first"))
+	require.True(t, errors.Is(c.DeleteSnapshot("first"), ErrSnapshotNotFound))
+
+	snapshots, err = c.Snapshots()
+	require.NoError(t, err)
+	require.Len(t, snapshots, 1)
+}
+
+func TestExportedGenesisHeight(t *testing.T) {
+	tests := []struct {
+		name    string
+		genesis string
+		want    int64
+		wantErr bool
+	}{
+		{name: "number", genesis: `{"initial_height":51}`, want: 50},
+		{name: "string", genesis: `{"initial_height":"51"}`, want: 50},
+		{name: "missing", genesis: `{}`, want: 0},
+		{name: "invalid", genesis: `{"initial_height":"abc"}`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "genesis.json")
+			require.NoError(t, os.WriteFile(path, []byte(tt.genesis), 0o600))
+
+			got, err := exportedGenesisHeight(path)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestReplaceDir(t *testing.T) {
+	dir := t.TempDir()
+	dst := filepath.Join(dir, "snapshot")
+	writeFile := func(path, content string) {
+		require.NoError(t, os.MkdirAll(path, 0o700))
+		require.NoError(t, os.WriteFile(filepath.Join(path, snapshotGenesis), []byte(content), 0o600))
+	}
+	requireContent := func(content string) {
+		data, err := os.ReadFile(filepath.Join(dst, snapshotGenesis))
+		require.NoError(t, err)
+		require.Equal(t, content, string(data))
+	}
+
+	// new snapshot
+	writeFile(filepath.Join(dir, ".tmp1"), "first")
+	require.NoError(t, replaceDir(filepath.Join(dir, ".tmp1"), dst))
+	requireContent("first")
+
+	// overwritten snapshot
+	writeFile(filepath.Join(dir, ".tmp2"), "second")
+	require.NoError(t, replaceDir(filepath.Join(dir, ".tmp2"), dst))
+	requireContent("second")
+
+	// a failed move keeps the existing snapshot
+	require.Error(t, replaceDir(filepath.Join(dir, ".missing"), dst))
+	requireContent("second")
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+}
+
+func writeSnapshot(t *testing.T, c *Chain, s Snapshot) {
+	t.Helper()
+
+	path, err := c.snapshotPath(s.Name)
+	require.NoError(t, err)
+	require.NoError(t, os.MkdirAll(path, 0o700))
+
+	data, err := json.Marshal(s)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(path, snapshotMetadata), data, 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(path, snapshotGenesis), []byte("{}"), 0o600))
+}
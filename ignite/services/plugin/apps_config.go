@@ -1,14 +1,26 @@
 package plugin
 
+// AppsConfigFile is the name of the file describing the apps of a repository.
+const AppsConfigFile = "app.ignite.yml"
+
 // AppsConfig is the structure of app.ignite.yml file.
 type AppsConfig struct {
 	Version uint               `yaml:"version"`
 	Apps    map[string]AppInfo `yaml:"apps"`
 }
 
-// AppInfo is the structure of app info in app.ignite.yml file which only holds
-// the description and the relative path of the app.
+// AppInfo is the structure of app info in app.ignite.yml file which holds
+// the description and the relative path of the app, along with its optional
+// prebuilt releases.
 type AppInfo struct {
 	Description string `yaml:"description"`
 	Path        string `yaml:"path"`
+
+	// Releases holds the prebuilt releases of the app.
+	Releases []Release `yaml:"releases,omitempty"`
+
+	// Index holds the location of the release index of the app, either a URL
+	// or a path relative to the repository root. When set, it's used instead
+	// of Releases.
+	Index string `yaml:"index,omitempty"`
 }
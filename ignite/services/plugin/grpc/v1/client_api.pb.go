@@ -13,6 +13,7 @@ import (
 
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
 )
 
 const (
@@ -214,11 +215,1244 @@ func (x *IgniteInfo) GetBuildFromSource() bool {
 	return false
 }
 
+// ChainConfig is the parsed config of the chain, with its defaults applied.
+type ChainConfig struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Version of the config file.
+	Version uint64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	// Path of the config file.
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	// YAML encoding of the parsed config.
+	// It keeps the sections that don't have a typed field.
+	Yaml []byte `protobuf:"bytes,3,opt,name=yaml,proto3" json:"yaml,omitempty"`
+	// Build configuration of the chain.
+	Build *ChainBuild `protobuf:"bytes,4,opt,name=build,proto3" json:"build,omitempty"`
+	// Accounts created in the genesis.
+	Accounts []*ChainAccount `protobuf:"bytes,5,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	// Validators of the chain.
+	Validators []*ChainValidator `protobuf:"bytes,6,rep,name=validators,proto3" json:"validators,omitempty"`
+	// Genesis modifications applied to the genesis file.
+	Genesis *structpb.Struct `protobuf:"bytes,7,opt,name=genesis,proto3" json:"genesis,omitempty"`
+	// Faucet configuration of the chain.
+	Faucet        *ChainFaucet `protobuf:"bytes,8,opt,name=faucet,proto3" json:"faucet,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChainConfig) Reset() {
+	*x = ChainConfig{}
+	mi := &file_ignite_services_plugin_grpc_v1_client_api_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChainConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChainConfig) ProtoMessage() {}
+
+func (x *ChainConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_ignite_services_plugin_grpc_v1_client_api_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChainConfig.ProtoReflect.Descriptor instead.
+func (*ChainConfig) Descriptor() ([]byte, []int) {
+	return file_ignite_services_plugin_grpc_v1_client_api_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ChainConfig) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *ChainConfig) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *ChainConfig) GetYaml() []byte {
+	if x != nil {
+		return x.Yaml
+	}
+	return nil
+}
+
+func (x *ChainConfig) GetBuild() *ChainBuild {
+	if x != nil {
+		return x.Build
+	}
+	return nil
+}
+
+func (x *ChainConfig) GetAccounts() []*ChainAccount {
+	if x != nil {
+		return x.Accounts
+	}
+	return nil
+}
+
+func (x *ChainConfig) GetValidators() []*ChainValidator {
+	if x != nil {
+		return x.Validators
+	}
+	return nil
+}
+
+func (x *ChainConfig) GetGenesis() *structpb.Struct {
+	if x != nil {
+		return x.Genesis
+	}
+	return nil
+}
+
+func (x *ChainConfig) GetFaucet() *ChainFaucet {
+	if x != nil {
+		return x.Faucet
+	}
+	return nil
+}
+
+// ChainBuild is the build section of the chain config.
+type ChainBuild struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Main          string                 `protobuf:"bytes,1,opt,name=main,proto3" json:"main,omitempty"`
+	Binary        string                 `protobuf:"bytes,2,opt,name=binary,proto3" json:"binary,omitempty"`
+	Ldflags       []string               `protobuf:"bytes,3,rep,name=ldflags,proto3" json:"ldflags,omitempty"`
+	ProtoPath     string                 `protobuf:"bytes,4,opt,name=proto_path,json=protoPath,proto3" json:"proto_path,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChainBuild) Reset() {
+	*x = ChainBuild{}
+	mi := &file_ignite_services_plugin_grpc_v1_client_api_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChainBuild) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChainBuild) ProtoMessage() {}
+
+func (x *ChainBuild) ProtoReflect() protoreflect.Message {
+	mi := &file_ignite_services_plugin_grpc_v1_client_api_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChainBuild.ProtoReflect.Descriptor instead.
+func (*ChainBuild) Descriptor() ([]byte, []int) {
+	return file_ignite_services_plugin_grpc_v1_client_api_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ChainBuild) GetMain() string {
+	if x != nil {
+		return x.Main
+	}
+	return ""
+}
+
+func (x *ChainBuild) GetBinary() string {
+	if x != nil {
+		return x.Binary
+	}
+	return ""
+}
+
+func (x *ChainBuild) GetLdflags() []string {
+	if x != nil {
+		return x.Ldflags
+	}
+	return nil
+}
+
+func (x *ChainBuild) GetProtoPath() string {
+	if x != nil {
+		return x.ProtoPath
+	}
+	return ""
+}
+
+// ChainAccount is an account of the chain config.
+type ChainAccount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Coins         []string               `protobuf:"bytes,2,rep,name=coins,proto3" json:"coins,omitempty"`
+	Mnemonic      string                 `protobuf:"bytes,3,opt,name=mnemonic,proto3" json:"mnemonic,omitempty"`
+	Address       string                 `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
+	CoinType      string                 `protobuf:"bytes,5,opt,name=coin_type,json=coinType,proto3" json:"coin_type,omitempty"`
+	AccountNumber string                 `protobuf:"bytes,6,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
+	AddressIndex  string                 `protobuf:"bytes,7,opt,name=address_index,json=addressIndex,proto3" json:"address_index,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChainAccount) Reset() {
+	*x = ChainAccount{}
+	mi := &file_ignite_services_plugin_grpc_v1_client_api_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChainAccount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChainAccount) ProtoMessage() {}
+
+func (x *ChainAccount) ProtoReflect() protoreflect.Message {
+	mi := &file_ignite_services_plugin_grpc_v1_client_api_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChainAccount.ProtoReflect.Descriptor instead.
+func (*ChainAccount) Descriptor() ([]byte, []int) {
+	return file_ignite_services_plugin_grpc_v1_client_api_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ChainAccount) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ChainAccount) GetCoins() []string {
+	if x != nil {
+		return x.Coins
+	}
+	return nil
+}
+
+func (x *ChainAccount) GetMnemonic() string {
+	if x != nil {
+		return x.Mnemonic
+	}
+	return ""
+}
+
+func (x *ChainAccount) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *ChainAccount) GetCoinType() string {
+	if x != nil {
+		return x.CoinType
+	}
+	return ""
+}
+
+func (x *ChainAccount) GetAccountNumber() string {
+	if x != nil {
+		return x.AccountNumber
+	}
+	return ""
+}
+
+func (x *ChainAccount) GetAddressIndex() string {
+	if x != nil {
+		return x.AddressIndex
+	}
+	return ""
+}
+
+// ChainValidator is a validator of the chain config.
+type ChainValidator struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Bonded        string                 `protobuf:"bytes,2,opt,name=bonded,proto3" json:"bonded,omitempty"`
+	Home          string                 `protobuf:"bytes,3,opt,name=home,proto3" json:"home,omitempty"`
+	App           *structpb.Struct       `protobuf:"bytes,4,opt,name=app,proto3" json:"app,omitempty"`
+	Config        *structpb.Struct       `protobuf:"bytes,5,opt,name=config,proto3" json:"config,omitempty"`
+	Client        *structpb.Struct       `protobuf:"bytes,6,opt,name=client,proto3" json:"client,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChainValidator) Reset() {
+	*x = ChainValidator{}
+	mi := &file_ignite_services_plugin_grpc_v1_client_api_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChainValidator) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChainValidator) ProtoMessage() {}
+
+func (x *ChainValidator) ProtoReflect() protoreflect.Message {
+	mi := &file_ignite_services_plugin_grpc_v1_client_api_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChainValidator.ProtoReflect.Descriptor instead.
+func (*ChainValidator) Descriptor() ([]byte, []int) {
+	return file_ignite_services_plugin_grpc_v1_client_api_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ChainValidator) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ChainValidator) GetBonded() string {
+	if x != nil {
+		return x.Bonded
+	}
+	return ""
+}
+
+func (x *ChainValidator) GetHome() string {
+	if x != nil {
+		return x.Home
+	}
+	return ""
+}
+
+func (x *ChainValidator) GetApp() *structpb.Struct {
+	if x != nil {
+		return x.App
+	}
+	return nil
+}
+
+func (x *ChainValidator) GetConfig() *structpb.Struct {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *ChainValidator) GetClient() *structpb.Struct {
+	if x != nil {
+		return x.Client
+	}
+	return nil
+}
+
+// ChainFaucet is the faucet section of the chain config.
+type ChainFaucet struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Name            string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Coins           []string               `protobuf:"bytes,2,rep,name=coins,proto3" json:"coins,omitempty"`
+	CoinsMax        []string               `protobuf:"bytes,3,rep,name=coins_max,json=coinsMax,proto3" json:"coins_max,omitempty"`
+	RateLimitWindow string                 `protobuf:"bytes,4,opt,name=rate_limit_window,json=rateLimitWindow,proto3" json:"rate_limit_window,omitempty"`
+	Host            string                 `protobuf:"bytes,5,opt,name=host,proto3" json:"host,omitempty"`
+	Port            uint64                 `protobuf:"varint,6,opt,name=port,proto3" json:"port,omitempty"`
+	TxFee           string                 `protobuf:"bytes,7,opt,name=tx_fee,json=txFee,proto3" json:"tx_fee,omitempty"`
+	BatchWindow     string                 `protobuf:"bytes,8,opt,name=batch_window,json=batchWindow,proto3" json:"batch_window,omitempty"`
+	BatchSize       uint64                 `protobuf:"varint,9,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ChainFaucet) Reset() {
+	*x = ChainFaucet{}
+	mi := &file_ignite_services_plugin_grpc_v1_client_api_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChainFaucet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChainFaucet) ProtoMessage() {}
+
+func (x *ChainFaucet) ProtoReflect() protoreflect.Message {
+	mi := &file_ignite_services_plugin_grpc_v1_client_api_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChainFaucet.ProtoReflect.Descriptor instead.
+func (*ChainFaucet) Descriptor() ([]byte, []int) {
+	return file_ignite_services_plugin_grpc_v1_client_api_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ChainFaucet) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ChainFaucet) GetCoins() []string {
+	if x != nil {
+		return x.Coins
+	}
+	return nil
+}
+
+func (x *ChainFaucet) GetCoinsMax() []string {
+	if x != nil {
+		return x.CoinsMax
+	}
+	return nil
+}
+
+func (x *ChainFaucet) GetRateLimitWindow() string {
+	if x != nil {
+		return x.RateLimitWindow
+	}
+	return ""
+}
+
+func (x *ChainFaucet) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *ChainFaucet) GetPort() uint64 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *ChainFaucet) GetTxFee() string {
+	if x != nil {
+		return x.TxFee
+	}
+	return ""
+}
+
+func (x *ChainFaucet) GetBatchWindow() string {
+	if x != nil {
+		return x.BatchWindow
+	}
+	return ""
+}
+
+func (x *ChainFaucet) GetBatchSize() uint64 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+// Module keeps metadata about a Cosmos SDK module of the chain.
+type Module struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	GoModulePath  string                 `protobuf:"bytes,2,opt,name=go_module_path,json=goModulePath,proto3" json:"go_module_path,omitempty"`
+	Package       *ProtoPackage          `protobuf:"bytes,3,opt,name=package,proto3" json:"package,omitempty"`
+	Msgs          []*ModuleMsg           `protobuf:"bytes,4,rep,name=msgs,proto3" json:"msgs,omitempty"`
+	Queries       []*ModuleQuery         `protobuf:"bytes,5,rep,name=queries,proto3" json:"queries,omitempty"`
+	Types         []*ModuleType          `protobuf:"bytes,6,rep,name=types,proto3" json:"types,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Module) Reset() {
+	*x = Module{}
+	mi := &file_ignite_services_plugin_grpc_v1_client_api_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Module) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Module) ProtoMessage() {}
+
+func (x *Module) ProtoReflect() protoreflect.Message {
+	mi := &file_ignite_services_plugin_grpc_v1_client_api_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Module.ProtoReflect.Descriptor instead.
+func (*Module) Descriptor() ([]byte, []int) {
+	return file_ignite_services_plugin_grpc_v1_client_api_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Module) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Module) GetGoModulePath() string {
+	if x != nil {
+		return x.GoModulePath
+	}
+	return ""
+}
+
+func (x *Module) GetPackage() *ProtoPackage {
+	if x != nil {
+		return x.Package
+	}
+	return nil
+}
+
+func (x *Module) GetMsgs() []*ModuleMsg {
+	if x != nil {
+		return x.Msgs
+	}
+	return nil
+}
+
+func (x *Module) GetQueries() []*ModuleQuery {
+	if x != nil {
+		return x.Queries
+	}
+	return nil
+}
+
+func (x *Module) GetTypes() []*ModuleType {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+// ModuleMsg keeps metadata about an sdk.Msg implementation of a module.
+type ModuleMsg struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Uri           string                 `protobuf:"bytes,2,opt,name=uri,proto3" json:"uri,omitempty"`
+	FilePath      string                 `protobuf:"bytes,3,opt,name=file_path,json=filePath,proto3" json:"file_path,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModuleMsg) Reset() {
+	*x = ModuleMsg{}
+	mi := &file_ignite_services_plugin_grpc_v1_client_api_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModuleMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModuleMsg) ProtoMessage() {}
+
+func (x *ModuleMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_ignite_services_plugin_grpc_v1_client_api_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModuleMsg.ProtoReflect.Descriptor instead.
+func (*ModuleMsg) Descriptor() ([]byte, []int) {
+	return file_ignite_services_plugin_grpc_v1_client_api_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ModuleMsg) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ModuleMsg) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *ModuleMsg) GetFilePath() string {
+	if x != nil {
+		return x.FilePath
+	}
+	return ""
+}
+
+// ModuleQuery keeps metadata about a query of a module.
+type ModuleQuery struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	RequestType   string                 `protobuf:"bytes,2,opt,name=request_type,json=requestType,proto3" json:"request_type,omitempty"`
+	ResponseType  string                 `protobuf:"bytes,3,opt,name=response_type,json=responseType,proto3" json:"response_type,omitempty"`
+	FullName      string                 `protobuf:"bytes,4,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty"`
+	Rules         []*ProtoHTTPRule       `protobuf:"bytes,5,rep,name=rules,proto3" json:"rules,omitempty"`
+	Paginated     bool                   `protobuf:"varint,6,opt,name=paginated,proto3" json:"paginated,omitempty"`
+	FilePath      string                 `protobuf:"bytes,7,opt,name=file_path,json=filePath,proto3" json:"file_path,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModuleQuery) Reset() {
+	*x = ModuleQuery{}
+	mi := &file_ignite_services_plugin_grpc_v1_client_api_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModuleQuery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModuleQuery) ProtoMessage() {}
+
+func (x *ModuleQuery) ProtoReflect() protoreflect.Message {
+	mi := &file_ignite_services_plugin_grpc_v1_client_api_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModuleQuery.ProtoReflect.Descriptor instead.
+func (*ModuleQuery) Descriptor() ([]byte, []int) {
+	return file_ignite_services_plugin_grpc_v1_client_api_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ModuleQuery) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ModuleQuery) GetRequestType() string {
+	if x != nil {
+		return x.RequestType
+	}
+	return ""
+}
+
+func (x *ModuleQuery) GetResponseType() string {
+	if x != nil {
+		return x.ResponseType
+	}
+	return ""
+}
+
+func (x *ModuleQuery) GetFullName() string {
+	if x != nil {
+		return x.FullName
+	}
+	return ""
+}
+
+func (x *ModuleQuery) GetRules() []*ProtoHTTPRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *ModuleQuery) GetPaginated() bool {
+	if x != nil {
+		return x.Paginated
+	}
+	return false
+}
+
+func (x *ModuleQuery) GetFilePath() string {
+	if x != nil {
+		return x.FilePath
+	}
+	return ""
+}
+
+// ModuleType is a proto type that might be used by a module.
+type ModuleType struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	FilePath      string                 `protobuf:"bytes,2,opt,name=file_path,json=filePath,proto3" json:"file_path,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModuleType) Reset() {
+	*x = ModuleType{}
+	mi := &file_ignite_services_plugin_grpc_v1_client_api_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModuleType) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModuleType) ProtoMessage() {}
+
+func (x *ModuleType) ProtoReflect() protoreflect.Message {
+	mi := &file_ignite_services_plugin_grpc_v1_client_api_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModuleType.ProtoReflect.Descriptor instead.
+func (*ModuleType) Descriptor() ([]byte, []int) {
+	return file_ignite_services_plugin_grpc_v1_client_api_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ModuleType) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ModuleType) GetFilePath() string {
+	if x != nil {
+		return x.FilePath
+	}
+	return ""
+}
+
+// ProtoPackage is a proto package of the chain.
+type ProtoPackage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Path          string                 `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Files         []*ProtoFile           `protobuf:"bytes,3,rep,name=files,proto3" json:"files,omitempty"`
+	GoImportName  string                 `protobuf:"bytes,4,opt,name=go_import_name,json=goImportName,proto3" json:"go_import_name,omitempty"`
+	Messages      []*ProtoMessage        `protobuf:"bytes,5,rep,name=messages,proto3" json:"messages,omitempty"`
+	Services      []*ProtoService        `protobuf:"bytes,6,rep,name=services,proto3" json:"services,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProtoPackage) Reset() {
+	*x = ProtoPackage{}
+	mi := &file_ignite_services_plugin_grpc_v1_client_api_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProtoPackage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProtoPackage) ProtoMessage() {}
+
+func (x *ProtoPackage) ProtoReflect() protoreflect.Message {
+	mi := &file_ignite_services_plugin_grpc_v1_client_api_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProtoPackage.ProtoReflect.Descriptor instead.
+func (*ProtoPackage) Descriptor() ([]byte, []int) {
+	return file_ignite_services_plugin_grpc_v1_client_api_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ProtoPackage) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ProtoPackage) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *ProtoPackage) GetFiles() []*ProtoFile {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+func (x *ProtoPackage) GetGoImportName() string {
+	if x != nil {
+		return x.GoImportName
+	}
+	return ""
+}
+
+func (x *ProtoPackage) GetMessages() []*ProtoMessage {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+func (x *ProtoPackage) GetServices() []*ProtoService {
+	if x != nil {
+		return x.Services
+	}
+	return nil
+}
+
+// ProtoFile is a proto file of a package.
+type ProtoFile struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Dependencies  []string               `protobuf:"bytes,2,rep,name=dependencies,proto3" json:"dependencies,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProtoFile) Reset() {
+	*x = ProtoFile{}
+	mi := &file_ignite_services_plugin_grpc_v1_client_api_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProtoFile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProtoFile) ProtoMessage() {}
+
+func (x *ProtoFile) ProtoReflect() protoreflect.Message {
+	mi := &file_ignite_services_plugin_grpc_v1_client_api_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProtoFile.ProtoReflect.Descriptor instead.
+func (*ProtoFile) Descriptor() ([]byte, []int) {
+	return file_ignite_services_plugin_grpc_v1_client_api_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ProtoFile) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *ProtoFile) GetDependencies() []string {
+	if x != nil {
+		return x.Dependencies
+	}
+	return nil
+}
+
+// ProtoMessage is a proto message of a package.
+type ProtoMessage struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Name               string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Path               string                 `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	HighestFieldNumber int64                  `protobuf:"varint,3,opt,name=highest_field_number,json=highestFieldNumber,proto3" json:"highest_field_number,omitempty"`
+	Fields             map[string]string      `protobuf:"bytes,4,rep,name=fields,proto3" json:"fields,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ProtoMessage) Reset() {
+	*x = ProtoMessage{}
+	mi := &file_ignite_services_plugin_grpc_v1_client_api_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProtoMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProtoMessage) ProtoMessage() {}
+
+func (x *ProtoMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_ignite_services_plugin_grpc_v1_client_api_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProtoMessage.ProtoReflect.Descriptor instead.
+func (*ProtoMessage) Descriptor() ([]byte, []int) {
+	return file_ignite_services_plugin_grpc_v1_client_api_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ProtoMessage) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ProtoMessage) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *ProtoMessage) GetHighestFieldNumber() int64 {
+	if x != nil {
+		return x.HighestFieldNumber
+	}
+	return 0
+}
+
+func (x *ProtoMessage) GetFields() map[string]string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+// ProtoService is an RPC service of a package.
+type ProtoService struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	RpcFuncs      []*ProtoRPCFunc        `protobuf:"bytes,2,rep,name=rpc_funcs,json=rpcFuncs,proto3" json:"rpc_funcs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProtoService) Reset() {
+	*x = ProtoService{}
+	mi := &file_ignite_services_plugin_grpc_v1_client_api_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProtoService) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProtoService) ProtoMessage() {}
+
+func (x *ProtoService) ProtoReflect() protoreflect.Message {
+	mi := &file_ignite_services_plugin_grpc_v1_client_api_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProtoService.ProtoReflect.Descriptor instead.
+func (*ProtoService) Descriptor() ([]byte, []int) {
+	return file_ignite_services_plugin_grpc_v1_client_api_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ProtoService) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ProtoService) GetRpcFuncs() []*ProtoRPCFunc {
+	if x != nil {
+		return x.RpcFuncs
+	}
+	return nil
+}
+
+// ProtoRPCFunc is an RPC func of a service.
+type ProtoRPCFunc struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	RequestType   string                 `protobuf:"bytes,2,opt,name=request_type,json=requestType,proto3" json:"request_type,omitempty"`
+	ReturnsType   string                 `protobuf:"bytes,3,opt,name=returns_type,json=returnsType,proto3" json:"returns_type,omitempty"`
+	HttpRules     []*ProtoHTTPRule       `protobuf:"bytes,4,rep,name=http_rules,json=httpRules,proto3" json:"http_rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProtoRPCFunc) Reset() {
+	*x = ProtoRPCFunc{}
+	mi := &file_ignite_services_plugin_grpc_v1_client_api_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProtoRPCFunc) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProtoRPCFunc) ProtoMessage() {}
+
+func (x *ProtoRPCFunc) ProtoReflect() protoreflect.Message {
+	mi := &file_ignite_services_plugin_grpc_v1_client_api_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProtoRPCFunc.ProtoReflect.Descriptor instead.
+func (*ProtoRPCFunc) Descriptor() ([]byte, []int) {
+	return file_ignite_services_plugin_grpc_v1_client_api_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ProtoRPCFunc) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ProtoRPCFunc) GetRequestType() string {
+	if x != nil {
+		return x.RequestType
+	}
+	return ""
+}
+
+func (x *ProtoRPCFunc) GetReturnsType() string {
+	if x != nil {
+		return x.ReturnsType
+	}
+	return ""
+}
+
+func (x *ProtoRPCFunc) GetHttpRules() []*ProtoHTTPRule {
+	if x != nil {
+		return x.HttpRules
+	}
+	return nil
+}
+
+// ProtoHTTPRule is an HTTP rule of an RPC func.
+type ProtoHTTPRule struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Endpoint      string                 `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	Params        []string               `protobuf:"bytes,2,rep,name=params,proto3" json:"params,omitempty"`
+	HasQuery      bool                   `protobuf:"varint,3,opt,name=has_query,json=hasQuery,proto3" json:"has_query,omitempty"`
+	QueryFields   map[string]string      `protobuf:"bytes,4,rep,name=query_fields,json=queryFields,proto3" json:"query_fields,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	HasBody       bool                   `protobuf:"varint,5,opt,name=has_body,json=hasBody,proto3" json:"has_body,omitempty"`
+	BodyFields    map[string]string      `protobuf:"bytes,6,rep,name=body_fields,json=bodyFields,proto3" json:"body_fields,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProtoHTTPRule) Reset() {
+	*x = ProtoHTTPRule{}
+	mi := &file_ignite_services_plugin_grpc_v1_client_api_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProtoHTTPRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProtoHTTPRule) ProtoMessage() {}
+
+func (x *ProtoHTTPRule) ProtoReflect() protoreflect.Message {
+	mi := &file_ignite_services_plugin_grpc_v1_client_api_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProtoHTTPRule.ProtoReflect.Descriptor instead.
+func (*ProtoHTTPRule) Descriptor() ([]byte, []int) {
+	return file_ignite_services_plugin_grpc_v1_client_api_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ProtoHTTPRule) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *ProtoHTTPRule) GetParams() []string {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+func (x *ProtoHTTPRule) GetHasQuery() bool {
+	if x != nil {
+		return x.HasQuery
+	}
+	return false
+}
+
+func (x *ProtoHTTPRule) GetQueryFields() map[string]string {
+	if x != nil {
+		return x.QueryFields
+	}
+	return nil
+}
+
+func (x *ProtoHTTPRule) GetHasBody() bool {
+	if x != nil {
+		return x.HasBody
+	}
+	return false
+}
+
+func (x *ProtoHTTPRule) GetBodyFields() map[string]string {
+	if x != nil {
+		return x.BodyFields
+	}
+	return nil
+}
+
+// Account is an account of the chain's keyring.
+type Account struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Address       string                 `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	PubKey        string                 `protobuf:"bytes,3,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Account) Reset() {
+	*x = Account{}
+	mi := &file_ignite_services_plugin_grpc_v1_client_api_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Account) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Account) ProtoMessage() {}
+
+func (x *Account) ProtoReflect() protoreflect.Message {
+	mi := &file_ignite_services_plugin_grpc_v1_client_api_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Account.ProtoReflect.Descriptor instead.
+func (*Account) Descriptor() ([]byte, []int) {
+	return file_ignite_services_plugin_grpc_v1_client_api_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *Account) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Account) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Account) GetPubKey() string {
+	if x != nil {
+		return x.PubKey
+	}
+	return ""
+}
+
 var File_ignite_services_plugin_grpc_v1_client_api_proto protoreflect.FileDescriptor
 
 const file_ignite_services_plugin_grpc_v1_client_api_proto_rawDesc = "" +
 	"\n" +
-	"/ignite/services/plugin/grpc/v1/client_api.proto\x12\x1eignite.services.plugin.grpc.v1\"\x97\x01\n" +
+	"/ignite/services/plugin/grpc/v1/client_api.proto\x12\x1eignite.services.plugin.grpc.v1\x1a\x1cgoogle/protobuf/struct.proto\"\x97\x01\n" +
 	"\tChainInfo\x12\x19\n" +
 	"\bchain_id\x18\x01 \x01(\tR\achainId\x12\x19\n" +
 	"\bapp_path\x18\x02 \x01(\tR\aappPath\x12\x1f\n" +
@@ -245,7 +1479,119 @@ const file_ignite_services_plugin_grpc_v1_client_api_proto_rawDesc = "" +
 	"\x02os\x18\b \x01(\tR\x02os\x12\x12\n" +
 	"\x04arch\x18\t \x01(\tR\x04arch\x12*\n" +
 	"\x11build_from_source\x18\n" +
-	" \x01(\bR\x0fbuildFromSourceB:Z8github.com/ignite/cli/v29/ignite/services/plugin/grpc/v1b\x06proto3"
+	" \x01(\bR\x0fbuildFromSource\"\xa3\x03\n" +
+	"\vChainConfig\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\x04R\aversion\x12\x12\n" +
+	"\x04path\x18\x02 \x01(\tR\x04path\x12\x12\n" +
+	"\x04yaml\x18\x03 \x01(\fR\x04yaml\x12@\n" +
+	"\x05build\x18\x04 \x01(\v2*.ignite.services.plugin.grpc.v1.ChainBuildR\x05build\x12H\n" +
+	"\baccounts\x18\x05 \x03(\v2,.ignite.services.plugin.grpc.v1.ChainAccountR\baccounts\x12N\n" +
+	"\n" +
+	"validators\x18\x06 \x03(\v2..ignite.services.plugin.grpc.v1.ChainValidatorR\n" +
+	"validators\x121\n" +
+	"\agenesis\x18\a \x01(\v2\x17.google.protobuf.StructR\agenesis\x12C\n" +
+	"\x06faucet\x18\b \x01(\v2+.ignite.services.plugin.grpc.v1.ChainFaucetR\x06faucet\"q\n" +
+	"\n" +
+	"ChainBuild\x12\x12\n" +
+	"\x04main\x18\x01 \x01(\tR\x04main\x12\x16\n" +
+	"\x06binary\x18\x02 \x01(\tR\x06binary\x12\x18\n" +
+	"\aldflags\x18\x03 \x03(\tR\aldflags\x12\x1d\n" +
+	"\n" +
+	"proto_path\x18\x04 \x01(\tR\tprotoPath\"\xd7\x01\n" +
+	"\fChainAccount\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05coins\x18\x02 \x03(\tR\x05coins\x12\x1a\n" +
+	"\bmnemonic\x18\x03 \x01(\tR\bmnemonic\x12\x18\n" +
+	"\aaddress\x18\x04 \x01(\tR\aaddress\x12\x1b\n" +
+	"\tcoin_type\x18\x05 \x01(\tR\bcoinType\x12%\n" +
+	"\x0eaccount_number\x18\x06 \x01(\tR\raccountNumber\x12#\n" +
+	"\raddress_index\x18\a \x01(\tR\faddressIndex\"\xdd\x01\n" +
+	"\x0eChainValidator\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06bonded\x18\x02 \x01(\tR\x06bonded\x12\x12\n" +
+	"\x04home\x18\x03 \x01(\tR\x04home\x12)\n" +
+	"\x03app\x18\x04 \x01(\v2\x17.google.protobuf.StructR\x03app\x12/\n" +
+	"\x06config\x18\x05 \x01(\v2\x17.google.protobuf.StructR\x06config\x12/\n" +
+	"\x06client\x18\x06 \x01(\v2\x17.google.protobuf.StructR\x06client\"\x81\x02\n" +
+	"\vChainFaucet\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05coins\x18\x02 \x03(\tR\x05coins\x12\x1b\n" +
+	"\tcoins_max\x18\x03 \x03(\tR\bcoinsMax\x12*\n" +
+	"\x11rate_limit_window\x18\x04 \x01(\tR\x0frateLimitWindow\x12\x12\n" +
+	"\x04host\x18\x05 \x01(\tR\x04host\x12\x12\n" +
+	"\x04port\x18\x06 \x01(\x04R\x04port\x12\x15\n" +
+	"\x06tx_fee\x18\a \x01(\tR\x05txFee\x12!\n" +
+	"\fbatch_window\x18\b \x01(\tR\vbatchWindow\x12\x1d\n" +
+	"\n" +
+	"batch_size\x18\t \x01(\x04R\tbatchSize\"\xd2\x02\n" +
+	"\x06Module\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12$\n" +
+	"\x0ego_module_path\x18\x02 \x01(\tR\fgoModulePath\x12F\n" +
+	"\apackage\x18\x03 \x01(\v2,.ignite.services.plugin.grpc.v1.ProtoPackageR\apackage\x12=\n" +
+	"\x04msgs\x18\x04 \x03(\v2).ignite.services.plugin.grpc.v1.ModuleMsgR\x04msgs\x12E\n" +
+	"\aqueries\x18\x05 \x03(\v2+.ignite.services.plugin.grpc.v1.ModuleQueryR\aqueries\x12@\n" +
+	"\x05types\x18\x06 \x03(\v2*.ignite.services.plugin.grpc.v1.ModuleTypeR\x05types\"N\n" +
+	"\tModuleMsg\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x10\n" +
+	"\x03uri\x18\x02 \x01(\tR\x03uri\x12\x1b\n" +
+	"\tfile_path\x18\x03 \x01(\tR\bfilePath\"\x86\x02\n" +
+	"\vModuleQuery\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12!\n" +
+	"\frequest_type\x18\x02 \x01(\tR\vrequestType\x12#\n" +
+	"\rresponse_type\x18\x03 \x01(\tR\fresponseType\x12\x1b\n" +
+	"\tfull_name\x18\x04 \x01(\tR\bfullName\x12C\n" +
+	"\x05rules\x18\x05 \x03(\v2-.ignite.services.plugin.grpc.v1.ProtoHTTPRuleR\x05rules\x12\x1c\n" +
+	"\tpaginated\x18\x06 \x01(\bR\tpaginated\x12\x1b\n" +
+	"\tfile_path\x18\a \x01(\tR\bfilePath\"=\n" +
+	"\n" +
+	"ModuleType\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1b\n" +
+	"\tfile_path\x18\x02 \x01(\tR\bfilePath\"\xb1\x02\n" +
+	"\fProtoPackage\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04path\x18\x02 \x01(\tR\x04path\x12?\n" +
+	"\x05files\x18\x03 \x03(\v2).ignite.services.plugin.grpc.v1.ProtoFileR\x05files\x12$\n" +
+	"\x0ego_import_name\x18\x04 \x01(\tR\fgoImportName\x12H\n" +
+	"\bmessages\x18\x05 \x03(\v2,.ignite.services.plugin.grpc.v1.ProtoMessageR\bmessages\x12H\n" +
+	"\bservices\x18\x06 \x03(\v2,.ignite.services.plugin.grpc.v1.ProtoServiceR\bservices\"C\n" +
+	"\tProtoFile\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12\"\n" +
+	"\fdependencies\x18\x02 \x03(\tR\fdependencies\"\xf5\x01\n" +
+	"\fProtoMessage\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04path\x18\x02 \x01(\tR\x04path\x120\n" +
+	"\x14highest_field_number\x18\x03 \x01(\x03R\x12highestFieldNumber\x12P\n" +
+	"\x06fields\x18\x04 \x03(\v28.ignite.services.plugin.grpc.v1.ProtoMessage.FieldsEntryR\x06fields\x1a9\n" +
+	"\vFieldsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"m\n" +
+	"\fProtoService\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12I\n" +
+	"\trpc_funcs\x18\x02 \x03(\v2,.ignite.services.plugin.grpc.v1.ProtoRPCFuncR\brpcFuncs\"\xb6\x01\n" +
+	"\fProtoRPCFunc\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12!\n" +
+	"\frequest_type\x18\x02 \x01(\tR\vrequestType\x12!\n" +
+	"\freturns_type\x18\x03 \x01(\tR\vreturnsType\x12L\n" +
+	"\n" +
+	"http_rules\x18\x04 \x03(\v2-.ignite.services.plugin.grpc.v1.ProtoHTTPRuleR\thttpRules\"\xbd\x03\n" +
+	"\rProtoHTTPRule\x12\x1a\n" +
+	"\bendpoint\x18\x01 \x01(\tR\bendpoint\x12\x16\n" +
+	"\x06params\x18\x02 \x03(\tR\x06params\x12\x1b\n" +
+	"\thas_query\x18\x03 \x01(\bR\bhasQuery\x12a\n" +
+	"\fquery_fields\x18\x04 \x03(\v2>.ignite.services.plugin.grpc.v1.ProtoHTTPRule.QueryFieldsEntryR\vqueryFields\x12\x19\n" +
+	"\bhas_body\x18\x05 \x01(\bR\ahasBody\x12^\n" +
+	"\vbody_fields\x18\x06 \x03(\v2=.ignite.services.plugin.grpc.v1.ProtoHTTPRule.BodyFieldsEntryR\n" +
+	"bodyFields\x1a>\n" +
+	"\x10QueryFieldsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a=\n" +
+	"\x0fBodyFieldsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"P\n" +
+	"\aAccount\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\aaddress\x18\x02 \x01(\tR\aaddress\x12\x17\n" +
+	"\apub_key\x18\x03 \x01(\tR\x06pubKeyB:Z8github.com/ignite/cli/v29/ignite/services/plugin/grpc/v1b\x06proto3"
 
 var (
 	file_ignite_services_plugin_grpc_v1_client_api_proto_rawDescOnce sync.Once
@@ -259,17 +1605,58 @@ func file_ignite_services_plugin_grpc_v1_client_api_proto_rawDescGZIP() []byte {
 	return file_ignite_services_plugin_grpc_v1_client_api_proto_rawDescData
 }
 
-var file_ignite_services_plugin_grpc_v1_client_api_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_ignite_services_plugin_grpc_v1_client_api_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
 var file_ignite_services_plugin_grpc_v1_client_api_proto_goTypes = []any{
-	(*ChainInfo)(nil),  // 0: ignite.services.plugin.grpc.v1.ChainInfo
-	(*IgniteInfo)(nil), // 1: ignite.services.plugin.grpc.v1.IgniteInfo
+	(*ChainInfo)(nil),       // 0: ignite.services.plugin.grpc.v1.ChainInfo
+	(*IgniteInfo)(nil),      // 1: ignite.services.plugin.grpc.v1.IgniteInfo
+	(*ChainConfig)(nil),     // 2: ignite.services.plugin.grpc.v1.ChainConfig
+	(*ChainBuild)(nil),      // 3: ignite.services.plugin.grpc.v1.ChainBuild
+	(*ChainAccount)(nil),    // 4: ignite.services.plugin.grpc.v1.ChainAccount
+	(*ChainValidator)(nil),  // 5: ignite.services.plugin.grpc.v1.ChainValidator
+	(*ChainFaucet)(nil),     // 6: ignite.services.plugin.grpc.v1.ChainFaucet
+	(*Module)(nil),          // 7: ignite.services.plugin.grpc.v1.Module
+	(*ModuleMsg)(nil),       // 8: ignite.services.plugin.grpc.v1.ModuleMsg
+	(*ModuleQuery)(nil),     // 9: ignite.services.plugin.grpc.v1.ModuleQuery
+	(*ModuleType)(nil),      // 10: ignite.services.plugin.grpc.v1.ModuleType
+	(*ProtoPackage)(nil),    // 11: ignite.services.plugin.grpc.v1.ProtoPackage
+	(*ProtoFile)(nil),       // 12: ignite.services.plugin.grpc.v1.ProtoFile
+	(*ProtoMessage)(nil),    // 13: ignite.services.plugin.grpc.v1.ProtoMessage
+	(*ProtoService)(nil),    // 14: ignite.services.plugin.grpc.v1.ProtoService
+	(*ProtoRPCFunc)(nil),    // 15: ignite.services.plugin.grpc.v1.ProtoRPCFunc
+	(*ProtoHTTPRule)(nil),   // 16: ignite.services.plugin.grpc.v1.ProtoHTTPRule
+	(*Account)(nil),         // 17: ignite.services.plugin.grpc.v1.Account
+	nil,                     // 18: ignite.services.plugin.grpc.v1.ProtoMessage.FieldsEntry
+	nil,                     // 19: ignite.services.plugin.grpc.v1.ProtoHTTPRule.QueryFieldsEntry
+	nil,                     // 20: ignite.services.plugin.grpc.v1.ProtoHTTPRule.BodyFieldsEntry
+	(*structpb.Struct)(nil), // 21: google.protobuf.Struct
 }
 var file_ignite_services_plugin_grpc_v1_client_api_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	3,  // 0: ignite.services.plugin.grpc.v1.ChainConfig.build:type_name -> ignite.services.plugin.grpc.v1.ChainBuild
+	4,  // 1: ignite.services.plugin.grpc.v1.ChainConfig.accounts:type_name -> ignite.services.plugin.grpc.v1.ChainAccount
+	5,  // 2: ignite.services.plugin.grpc.v1.ChainConfig.validators:type_name -> ignite.services.plugin.grpc.v1.ChainValidator
+	21, // 3: ignite.services.plugin.grpc.v1.ChainConfig.genesis:type_name -> google.protobuf.Struct
+	6,  // 4: ignite.services.plugin.grpc.v1.ChainConfig.faucet:type_name -> ignite.services.plugin.grpc.v1.ChainFaucet
+	21, // 5: ignite.services.plugin.grpc.v1.ChainValidator.app:type_name -> google.protobuf.Struct
+	21, // 6: ignite.services.plugin.grpc.v1.ChainValidator.config:type_name -> google.protobuf.Struct
+	21, // 7: ignite.services.plugin.grpc.v1.ChainValidator.client:type_name -> google.protobuf.Struct
+	11, // 8: ignite.services.plugin.grpc.v1.Module.package:type_name -> ignite.services.plugin.grpc.v1.ProtoPackage
+	8,  // 9: ignite.services.plugin.grpc.v1.Module.msgs:type_name -> ignite.services.plugin.grpc.v1.ModuleMsg
+	9,  // 10: ignite.services.plugin.grpc.v1.Module.queries:type_name -> ignite.services.plugin.grpc.v1.ModuleQuery
+	10, // 11: ignite.services.plugin.grpc.v1.Module.types:type_name -> ignite.services.plugin.grpc.v1.ModuleType
+	16, // 12: ignite.services.plugin.grpc.v1.ModuleQuery.rules:type_name -> ignite.services.plugin.grpc.v1.ProtoHTTPRule
+	12, // 13: ignite.services.plugin.grpc.v1.ProtoPackage.files:type_name -> ignite.services.plugin.grpc.v1.ProtoFile
+	13, // 14: ignite.services.plugin.grpc.v1.ProtoPackage.messages:type_name -> ignite.services.plugin.grpc.v1.ProtoMessage
+	14, // 15: ignite.services.plugin.grpc.v1.ProtoPackage.services:type_name -> ignite.services.plugin.grpc.v1.ProtoService
+	18, // 16: ignite.services.plugin.grpc.v1.ProtoMessage.fields:type_name -> ignite.services.plugin.grpc.v1.ProtoMessage.FieldsEntry
+	15, // 17: ignite.services.plugin.grpc.v1.ProtoService.rpc_funcs:type_name -> ignite.services.plugin.grpc.v1.ProtoRPCFunc
+	16, // 18: ignite.services.plugin.grpc.v1.ProtoRPCFunc.http_rules:type_name -> ignite.services.plugin.grpc.v1.ProtoHTTPRule
+	19, // 19: ignite.services.plugin.grpc.v1.ProtoHTTPRule.query_fields:type_name -> ignite.services.plugin.grpc.v1.ProtoHTTPRule.QueryFieldsEntry
+	20, // 20: ignite.services.plugin.grpc.v1.ProtoHTTPRule.body_fields:type_name -> ignite.services.plugin.grpc.v1.ProtoHTTPRule.BodyFieldsEntry
+	21, // [21:21] is the sub-list for method output_type
+	21, // [21:21] is the sub-list for method input_type
+	21, // [21:21] is the sub-list for extension type_name
+	21, // [21:21] is the sub-list for extension extendee
+	0,  // [0:21] is the sub-list for field type_name
 }
 
 func init() { file_ignite_services_plugin_grpc_v1_client_api_proto_init() }
@@ -283,7 +1670,7 @@ func file_ignite_services_plugin_grpc_v1_client_api_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_ignite_services_plugin_grpc_v1_client_api_proto_rawDesc), len(file_ignite_services_plugin_grpc_v1_client_api_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   21,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
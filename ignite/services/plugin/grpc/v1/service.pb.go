@@ -614,6 +614,326 @@ func (x *GetIgniteInfoResponse) GetIgniteInfo() *IgniteInfo {
 	return nil
 }
 
+type GetChainConfigRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetChainConfigRequest) Reset() {
+	*x = GetChainConfigRequest{}
+	mi := &file_ignite_services_plugin_grpc_v1_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetChainConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChainConfigRequest) ProtoMessage() {}
+
+func (x *GetChainConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ignite_services_plugin_grpc_v1_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChainConfigRequest.ProtoReflect.Descriptor instead.
+func (*GetChainConfigRequest) Descriptor() ([]byte, []int) {
+	return file_ignite_services_plugin_grpc_v1_service_proto_rawDescGZIP(), []int{14}
+}
+
+type GetChainConfigResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChainConfig   *ChainConfig           `protobuf:"bytes,1,opt,name=chain_config,json=chainConfig,proto3" json:"chain_config,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetChainConfigResponse) Reset() {
+	*x = GetChainConfigResponse{}
+	mi := &file_ignite_services_plugin_grpc_v1_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetChainConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChainConfigResponse) ProtoMessage() {}
+
+func (x *GetChainConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ignite_services_plugin_grpc_v1_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChainConfigResponse.ProtoReflect.Descriptor instead.
+func (*GetChainConfigResponse) Descriptor() ([]byte, []int) {
+	return file_ignite_services_plugin_grpc_v1_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetChainConfigResponse) GetChainConfig() *ChainConfig {
+	if x != nil {
+		return x.ChainConfig
+	}
+	return nil
+}
+
+type GetModulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetModulesRequest) Reset() {
+	*x = GetModulesRequest{}
+	mi := &file_ignite_services_plugin_grpc_v1_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetModulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetModulesRequest) ProtoMessage() {}
+
+func (x *GetModulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ignite_services_plugin_grpc_v1_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetModulesRequest.ProtoReflect.Descriptor instead.
+func (*GetModulesRequest) Descriptor() ([]byte, []int) {
+	return file_ignite_services_plugin_grpc_v1_service_proto_rawDescGZIP(), []int{16}
+}
+
+type GetModulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Modules       []*Module              `protobuf:"bytes,1,rep,name=modules,proto3" json:"modules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetModulesResponse) Reset() {
+	*x = GetModulesResponse{}
+	mi := &file_ignite_services_plugin_grpc_v1_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetModulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetModulesResponse) ProtoMessage() {}
+
+func (x *GetModulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ignite_services_plugin_grpc_v1_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetModulesResponse.ProtoReflect.Descriptor instead.
+func (*GetModulesResponse) Descriptor() ([]byte, []int) {
+	return file_ignite_services_plugin_grpc_v1_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetModulesResponse) GetModules() []*Module {
+	if x != nil {
+		return x.Modules
+	}
+	return nil
+}
+
+type GetProtoPackagesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProtoPackagesRequest) Reset() {
+	*x = GetProtoPackagesRequest{}
+	mi := &file_ignite_services_plugin_grpc_v1_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProtoPackagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProtoPackagesRequest) ProtoMessage() {}
+
+func (x *GetProtoPackagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ignite_services_plugin_grpc_v1_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProtoPackagesRequest.ProtoReflect.Descriptor instead.
+func (*GetProtoPackagesRequest) Descriptor() ([]byte, []int) {
+	return file_ignite_services_plugin_grpc_v1_service_proto_rawDescGZIP(), []int{18}
+}
+
+type GetProtoPackagesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Packages      []*ProtoPackage        `protobuf:"bytes,1,rep,name=packages,proto3" json:"packages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProtoPackagesResponse) Reset() {
+	*x = GetProtoPackagesResponse{}
+	mi := &file_ignite_services_plugin_grpc_v1_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProtoPackagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProtoPackagesResponse) ProtoMessage() {}
+
+func (x *GetProtoPackagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ignite_services_plugin_grpc_v1_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProtoPackagesResponse.ProtoReflect.Descriptor instead.
+func (*GetProtoPackagesResponse) Descriptor() ([]byte, []int) {
+	return file_ignite_services_plugin_grpc_v1_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetProtoPackagesResponse) GetPackages() []*ProtoPackage {
+	if x != nil {
+		return x.Packages
+	}
+	return nil
+}
+
+type GetAccountsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAccountsRequest) Reset() {
+	*x = GetAccountsRequest{}
+	mi := &file_ignite_services_plugin_grpc_v1_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccountsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountsRequest) ProtoMessage() {}
+
+func (x *GetAccountsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ignite_services_plugin_grpc_v1_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountsRequest.ProtoReflect.Descriptor instead.
+func (*GetAccountsRequest) Descriptor() ([]byte, []int) {
+	return file_ignite_services_plugin_grpc_v1_service_proto_rawDescGZIP(), []int{20}
+}
+
+type GetAccountsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Accounts      []*Account             `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAccountsResponse) Reset() {
+	*x = GetAccountsResponse{}
+	mi := &file_ignite_services_plugin_grpc_v1_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccountsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountsResponse) ProtoMessage() {}
+
+func (x *GetAccountsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ignite_services_plugin_grpc_v1_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountsResponse.ProtoReflect.Descriptor instead.
+func (*GetAccountsResponse) Descriptor() ([]byte, []int) {
+	return file_ignite_services_plugin_grpc_v1_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetAccountsResponse) GetAccounts() []*Account {
+	if x != nil {
+		return x.Accounts
+	}
+	return nil
+}
+
 var File_ignite_services_plugin_grpc_v1_service_proto protoreflect.FileDescriptor
 
 const file_ignite_services_plugin_grpc_v1_service_proto_rawDesc = "" +
@@ -649,16 +969,33 @@ const file_ignite_services_plugin_grpc_v1_service_proto_rawDesc = "" +
 	"\x14GetIgniteInfoRequest\"d\n" +
 	"\x15GetIgniteInfoResponse\x12K\n" +
 	"\vignite_info\x18\x01 \x01(\v2*.ignite.services.plugin.grpc.v1.IgniteInfoR\n" +
-	"igniteInfo2\x81\x05\n" +
+	"igniteInfo\"\x17\n" +
+	"\x15GetChainConfigRequest\"h\n" +
+	"\x16GetChainConfigResponse\x12N\n" +
+	"\fchain_config\x18\x01 \x01(\v2+.ignite.services.plugin.grpc.v1.ChainConfigR\vchainConfig\"\x13\n" +
+	"\x11GetModulesRequest\"V\n" +
+	"\x12GetModulesResponse\x12@\n" +
+	"\amodules\x18\x01 \x03(\v2&.ignite.services.plugin.grpc.v1.ModuleR\amodules\"\x19\n" +
+	"\x17GetProtoPackagesRequest\"d\n" +
+	"\x18GetProtoPackagesResponse\x12H\n" +
+	"\bpackages\x18\x01 \x03(\v2,.ignite.services.plugin.grpc.v1.ProtoPackageR\bpackages\"\x14\n" +
+	"\x12GetAccountsRequest\"Z\n" +
+	"\x13GetAccountsResponse\x12C\n" +
+	"\baccounts\x18\x01 \x03(\v2'.ignite.services.plugin.grpc.v1.AccountR\baccounts2\x81\x05\n" +
 	"\x10InterfaceService\x12m\n" +
 	"\bManifest\x12/.ignite.services.plugin.grpc.v1.ManifestRequest\x1a0.ignite.services.plugin.grpc.v1.ManifestResponse\x12j\n" +
 	"\aExecute\x12..ignite.services.plugin.grpc.v1.ExecuteRequest\x1a/.ignite.services.plugin.grpc.v1.ExecuteResponse\x12\x7f\n" +
 	"\x0eExecuteHookPre\x125.ignite.services.plugin.grpc.v1.ExecuteHookPreRequest\x1a6.ignite.services.plugin.grpc.v1.ExecuteHookPreResponse\x12\x82\x01\n" +
 	"\x0fExecuteHookPost\x126.ignite.services.plugin.grpc.v1.ExecuteHookPostRequest\x1a7.ignite.services.plugin.grpc.v1.ExecuteHookPostResponse\x12\x8b\x01\n" +
-	"\x12ExecuteHookCleanUp\x129.ignite.services.plugin.grpc.v1.ExecuteHookCleanUpRequest\x1a:.ignite.services.plugin.grpc.v1.ExecuteHookCleanUpResponse2\x8b\x02\n" +
+	"\x12ExecuteHookCleanUp\x129.ignite.services.plugin.grpc.v1.ExecuteHookCleanUpRequest\x1a:.ignite.services.plugin.grpc.v1.ExecuteHookCleanUpResponse2\x81\x06\n" +
 	"\x10ClientAPIService\x12y\n" +
 	"\fGetChainInfo\x123.ignite.services.plugin.grpc.v1.GetChainInfoRequest\x1a4.ignite.services.plugin.grpc.v1.GetChainInfoResponse\x12|\n" +
-	"\rGetIgniteInfo\x124.ignite.services.plugin.grpc.v1.GetIgniteInfoRequest\x1a5.ignite.services.plugin.grpc.v1.GetIgniteInfoResponseB:Z8github.com/ignite/cli/v29/ignite/services/plugin/grpc/v1b\x06proto3"
+	"\rGetIgniteInfo\x124.ignite.services.plugin.grpc.v1.GetIgniteInfoRequest\x1a5.ignite.services.plugin.grpc.v1.GetIgniteInfoResponse\x12\x7f\n" +
+	"\x0eGetChainConfig\x125.ignite.services.plugin.grpc.v1.GetChainConfigRequest\x1a6.ignite.services.plugin.grpc.v1.GetChainConfigResponse\x12s\n" +
+	"\n" +
+	"GetModules\x121.ignite.services.plugin.grpc.v1.GetModulesRequest\x1a2.ignite.services.plugin.grpc.v1.GetModulesResponse\x12\x85\x01\n" +
+	"\x10GetProtoPackages\x127.ignite.services.plugin.grpc.v1.GetProtoPackagesRequest\x1a8.ignite.services.plugin.grpc.v1.GetProtoPackagesResponse\x12v\n" +
+	"\vGetAccounts\x122.ignite.services.plugin.grpc.v1.GetAccountsRequest\x1a3.ignite.services.plugin.grpc.v1.GetAccountsResponseB:Z8github.com/ignite/cli/v29/ignite/services/plugin/grpc/v1b\x06proto3"
 
 var (
 	file_ignite_services_plugin_grpc_v1_service_proto_rawDescOnce sync.Once
@@ -672,7 +1009,7 @@ func file_ignite_services_plugin_grpc_v1_service_proto_rawDescGZIP() []byte {
 	return file_ignite_services_plugin_grpc_v1_service_proto_rawDescData
 }
 
-var file_ignite_services_plugin_grpc_v1_service_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_ignite_services_plugin_grpc_v1_service_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
 var file_ignite_services_plugin_grpc_v1_service_proto_goTypes = []any{
 	(*ManifestRequest)(nil),            // 0: ignite.services.plugin.grpc.v1.ManifestRequest
 	(*ManifestResponse)(nil),           // 1: ignite.services.plugin.grpc.v1.ManifestResponse
@@ -688,39 +1025,63 @@ var file_ignite_services_plugin_grpc_v1_service_proto_goTypes = []any{
 	(*GetChainInfoResponse)(nil),       // 11: ignite.services.plugin.grpc.v1.GetChainInfoResponse
 	(*GetIgniteInfoRequest)(nil),       // 12: ignite.services.plugin.grpc.v1.GetIgniteInfoRequest
 	(*GetIgniteInfoResponse)(nil),      // 13: ignite.services.plugin.grpc.v1.GetIgniteInfoResponse
-	(*Manifest)(nil),                   // 14: ignite.services.plugin.grpc.v1.Manifest
-	(*ExecutedCommand)(nil),            // 15: ignite.services.plugin.grpc.v1.ExecutedCommand
-	(*ExecutedHook)(nil),               // 16: ignite.services.plugin.grpc.v1.ExecutedHook
-	(*ChainInfo)(nil),                  // 17: ignite.services.plugin.grpc.v1.ChainInfo
-	(*IgniteInfo)(nil),                 // 18: ignite.services.plugin.grpc.v1.IgniteInfo
+	(*GetChainConfigRequest)(nil),      // 14: ignite.services.plugin.grpc.v1.GetChainConfigRequest
+	(*GetChainConfigResponse)(nil),     // 15: ignite.services.plugin.grpc.v1.GetChainConfigResponse
+	(*GetModulesRequest)(nil),          // 16: ignite.services.plugin.grpc.v1.GetModulesRequest
+	(*GetModulesResponse)(nil),         // 17: ignite.services.plugin.grpc.v1.GetModulesResponse
+	(*GetProtoPackagesRequest)(nil),    // 18: ignite.services.plugin.grpc.v1.GetProtoPackagesRequest
+	(*GetProtoPackagesResponse)(nil),   // 19: ignite.services.plugin.grpc.v1.GetProtoPackagesResponse
+	(*GetAccountsRequest)(nil),         // 20: ignite.services.plugin.grpc.v1.GetAccountsRequest
+	(*GetAccountsResponse)(nil),        // 21: ignite.services.plugin.grpc.v1.GetAccountsResponse
+	(*Manifest)(nil),                   // 22: ignite.services.plugin.grpc.v1.Manifest
+	(*ExecutedCommand)(nil),            // 23: ignite.services.plugin.grpc.v1.ExecutedCommand
+	(*ExecutedHook)(nil),               // 24: ignite.services.plugin.grpc.v1.ExecutedHook
+	(*ChainInfo)(nil),                  // 25: ignite.services.plugin.grpc.v1.ChainInfo
+	(*IgniteInfo)(nil),                 // 26: ignite.services.plugin.grpc.v1.IgniteInfo
+	(*ChainConfig)(nil),                // 27: ignite.services.plugin.grpc.v1.ChainConfig
+	(*Module)(nil),                     // 28: ignite.services.plugin.grpc.v1.Module
+	(*ProtoPackage)(nil),               // 29: ignite.services.plugin.grpc.v1.ProtoPackage
+	(*Account)(nil),                    // 30: ignite.services.plugin.grpc.v1.Account
 }
 var file_ignite_services_plugin_grpc_v1_service_proto_depIdxs = []int32{
-	14, // 0: ignite.services.plugin.grpc.v1.ManifestResponse.manifest:type_name -> ignite.services.plugin.grpc.v1.Manifest
-	15, // 1: ignite.services.plugin.grpc.v1.ExecuteRequest.cmd:type_name -> ignite.services.plugin.grpc.v1.ExecutedCommand
-	16, // 2: ignite.services.plugin.grpc.v1.ExecuteHookPreRequest.hook:type_name -> ignite.services.plugin.grpc.v1.ExecutedHook
-	16, // 3: ignite.services.plugin.grpc.v1.ExecuteHookPostRequest.hook:type_name -> ignite.services.plugin.grpc.v1.ExecutedHook
-	16, // 4: ignite.services.plugin.grpc.v1.ExecuteHookCleanUpRequest.hook:type_name -> ignite.services.plugin.grpc.v1.ExecutedHook
-	17, // 5: ignite.services.plugin.grpc.v1.GetChainInfoResponse.chain_info:type_name -> ignite.services.plugin.grpc.v1.ChainInfo
-	18, // 6: ignite.services.plugin.grpc.v1.GetIgniteInfoResponse.ignite_info:type_name -> ignite.services.plugin.grpc.v1.IgniteInfo
-	0,  // 7: ignite.services.plugin.grpc.v1.InterfaceService.Manifest:input_type -> ignite.services.plugin.grpc.v1.ManifestRequest
-	2,  // 8: ignite.services.plugin.grpc.v1.InterfaceService.Execute:input_type -> ignite.services.plugin.grpc.v1.ExecuteRequest
-	4,  // 9: ignite.services.plugin.grpc.v1.InterfaceService.ExecuteHookPre:input_type -> ignite.services.plugin.grpc.v1.ExecuteHookPreRequest
-	6,  // 10: ignite.services.plugin.grpc.v1.InterfaceService.ExecuteHookPost:input_type -> ignite.services.plugin.grpc.v1.ExecuteHookPostRequest
-	8,  // 11: ignite.services.plugin.grpc.v1.InterfaceService.ExecuteHookCleanUp:input_type -> ignite.services.plugin.grpc.v1.ExecuteHookCleanUpRequest
-	10, // 12: ignite.services.plugin.grpc.v1.ClientAPIService.GetChainInfo:input_type -> ignite.services.plugin.grpc.v1.GetChainInfoRequest
-	12, // 13: ignite.services.plugin.grpc.v1.ClientAPIService.GetIgniteInfo:input_type -> ignite.services.plugin.grpc.v1.GetIgniteInfoRequest
-	1,  // 14: ignite.services.plugin.grpc.v1.InterfaceService.Manifest:output_type -> ignite.services.plugin.grpc.v1.ManifestResponse
-	3,  // 15: ignite.services.plugin.grpc.v1.InterfaceService.Execute:output_type -> ignite.services.plugin.grpc.v1.ExecuteResponse
-	5,  // 16: ignite.services.plugin.grpc.v1.InterfaceService.ExecuteHookPre:output_type -> ignite.services.plugin.grpc.v1.ExecuteHookPreResponse
-	7,  // 17: ignite.services.plugin.grpc.v1.InterfaceService.ExecuteHookPost:output_type -> ignite.services.plugin.grpc.v1.ExecuteHookPostResponse
-	9,  // 18: ignite.services.plugin.grpc.v1.InterfaceService.ExecuteHookCleanUp:output_type -> ignite.services.plugin.grpc.v1.ExecuteHookCleanUpResponse
-	11, // 19: ignite.services.plugin.grpc.v1.ClientAPIService.GetChainInfo:output_type -> ignite.services.plugin.grpc.v1.GetChainInfoResponse
-	13, // 20: ignite.services.plugin.grpc.v1.ClientAPIService.GetIgniteInfo:output_type -> ignite.services.plugin.grpc.v1.GetIgniteInfoResponse
-	14, // [14:21] is the sub-list for method output_type
-	7,  // [7:14] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
+	22, // 0: ignite.services.plugin.grpc.v1.ManifestResponse.manifest:type_name -> ignite.services.plugin.grpc.v1.Manifest
+	23, // 1: ignite.services.plugin.grpc.v1.ExecuteRequest.cmd:type_name -> ignite.services.plugin.grpc.v1.ExecutedCommand
+	24, // 2: ignite.services.plugin.grpc.v1.ExecuteHookPreRequest.hook:type_name -> ignite.services.plugin.grpc.v1.ExecutedHook
+	24, // 3: ignite.services.plugin.grpc.v1.ExecuteHookPostRequest.hook:type_name -> ignite.services.plugin.grpc.v1.ExecutedHook
+	24, // 4: ignite.services.plugin.grpc.v1.ExecuteHookCleanUpRequest.hook:type_name -> ignite.services.plugin.grpc.v1.ExecutedHook
+	25, // 5: ignite.services.plugin.grpc.v1.GetChainInfoResponse.chain_info:type_name -> ignite.services.plugin.grpc.v1.ChainInfo
+	26, // 6: ignite.services.plugin.grpc.v1.GetIgniteInfoResponse.ignite_info:type_name -> ignite.services.plugin.grpc.v1.IgniteInfo
+	27, // 7: ignite.services.plugin.grpc.v1.GetChainConfigResponse.chain_config:type_name -> ignite.services.plugin.grpc.v1.ChainConfig
+	28, // 8: ignite.services.plugin.grpc.v1.GetModulesResponse.modules:type_name -> ignite.services.plugin.grpc.v1.Module
+	29, // 9: ignite.services.plugin.grpc.v1.GetProtoPackagesResponse.packages:type_name -> ignite.services.plugin.grpc.v1.ProtoPackage
+	30, // 10: ignite.services.plugin.grpc.v1.GetAccountsResponse.accounts:type_name -> ignite.services.plugin.grpc.v1.Account
+	0,  // 11: ignite.services.plugin.grpc.v1.InterfaceService.Manifest:input_type -> ignite.services.plugin.grpc.v1.ManifestRequest
+	2,  // 12: ignite.services.plugin.grpc.v1.InterfaceService.Execute:input_type -> ignite.services.plugin.grpc.v1.ExecuteRequest
+	4,  // 13: ignite.services.plugin.grpc.v1.InterfaceService.ExecuteHookPre:input_type -> ignite.services.plugin.grpc.v1.ExecuteHookPreRequest
+	6,  // 14: ignite.services.plugin.grpc.v1.InterfaceService.ExecuteHookPost:input_type -> ignite.services.plugin.grpc.v1.ExecuteHookPostRequest
+	8,  // 15: ignite.services.plugin.grpc.v1.InterfaceService.ExecuteHookCleanUp:input_type -> ignite.services.plugin.grpc.v1.ExecuteHookCleanUpRequest
+	10, // 16: ignite.services.plugin.grpc.v1.ClientAPIService.GetChainInfo:input_type -> ignite.services.plugin.grpc.v1.GetChainInfoRequest
+	12, // 17: ignite.services.plugin.grpc.v1.ClientAPIService.GetIgniteInfo:input_type -> ignite.services.plugin.grpc.v1.GetIgniteInfoRequest
+	14, // 18: ignite.services.plugin.grpc.v1.ClientAPIService.GetChainConfig:input_type -> ignite.services.plugin.grpc.v1.GetChainConfigRequest
+	16, // 19: ignite.services.plugin.grpc.v1.ClientAPIService.GetModules:input_type -> ignite.services.plugin.grpc.v1.GetModulesRequest
+	18, // 20: ignite.services.plugin.grpc.v1.ClientAPIService.GetProtoPackages:input_type -> ignite.services.plugin.grpc.v1.GetProtoPackagesRequest
+	20, // 21: ignite.services.plugin.grpc.v1.ClientAPIService.GetAccounts:input_type -> ignite.services.plugin.grpc.v1.GetAccountsRequest
+	1,  // 22: ignite.services.plugin.grpc.v1.InterfaceService.Manifest:output_type -> ignite.services.plugin.grpc.v1.ManifestResponse
+	3,  // 23: ignite.services.plugin.grpc.v1.InterfaceService.Execute:output_type -> ignite.services.plugin.grpc.v1.ExecuteResponse
+	5,  // 24: ignite.services.plugin.grpc.v1.InterfaceService.ExecuteHookPre:output_type -> ignite.services.plugin.grpc.v1.ExecuteHookPreResponse
+	7,  // 25: ignite.services.plugin.grpc.v1.InterfaceService.ExecuteHookPost:output_type -> ignite.services.plugin.grpc.v1.ExecuteHookPostResponse
+	9,  // 26: ignite.services.plugin.grpc.v1.InterfaceService.ExecuteHookCleanUp:output_type -> ignite.services.plugin.grpc.v1.ExecuteHookCleanUpResponse
+	11, // 27: ignite.services.plugin.grpc.v1.ClientAPIService.GetChainInfo:output_type -> ignite.services.plugin.grpc.v1.GetChainInfoResponse
+	13, // 28: ignite.services.plugin.grpc.v1.ClientAPIService.GetIgniteInfo:output_type -> ignite.services.plugin.grpc.v1.GetIgniteInfoResponse
+	15, // 29: ignite.services.plugin.grpc.v1.ClientAPIService.GetChainConfig:output_type -> ignite.services.plugin.grpc.v1.GetChainConfigResponse
+	17, // 30: ignite.services.plugin.grpc.v1.ClientAPIService.GetModules:output_type -> ignite.services.plugin.grpc.v1.GetModulesResponse
+	19, // 31: ignite.services.plugin.grpc.v1.ClientAPIService.GetProtoPackages:output_type -> ignite.services.plugin.grpc.v1.GetProtoPackagesResponse
+	21, // 32: ignite.services.plugin.grpc.v1.ClientAPIService.GetAccounts:output_type -> ignite.services.plugin.grpc.v1.GetAccountsResponse
+	22, // [22:33] is the sub-list for method output_type
+	11, // [11:22] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_ignite_services_plugin_grpc_v1_service_proto_init() }
@@ -736,7 +1097,7 @@ func file_ignite_services_plugin_grpc_v1_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_ignite_services_plugin_grpc_v1_service_proto_rawDesc), len(file_ignite_services_plugin_grpc_v1_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   14,
+			NumMessages:   22,
 			NumExtensions: 0,
 			NumServices:   2,
 		},
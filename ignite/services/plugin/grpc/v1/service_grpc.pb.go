@@ -312,8 +312,12 @@ var InterfaceService_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	ClientAPIService_GetChainInfo_FullMethodName  = "/ignite.services.plugin.grpc.v1.ClientAPIService/GetChainInfo"
-	ClientAPIService_GetIgniteInfo_FullMethodName = "/ignite.services.plugin.grpc.v1.ClientAPIService/GetIgniteInfo"
+	ClientAPIService_GetChainInfo_FullMethodName     = "/ignite.services.plugin.grpc.v1.ClientAPIService/GetChainInfo"
+	ClientAPIService_GetIgniteInfo_FullMethodName    = "/ignite.services.plugin.grpc.v1.ClientAPIService/GetIgniteInfo"
+	ClientAPIService_GetChainConfig_FullMethodName   = "/ignite.services.plugin.grpc.v1.ClientAPIService/GetChainConfig"
+	ClientAPIService_GetModules_FullMethodName       = "/ignite.services.plugin.grpc.v1.ClientAPIService/GetModules"
+	ClientAPIService_GetProtoPackages_FullMethodName = "/ignite.services.plugin.grpc.v1.ClientAPIService/GetProtoPackages"
+	ClientAPIService_GetAccounts_FullMethodName      = "/ignite.services.plugin.grpc.v1.ClientAPIService/GetAccounts"
 )
 
 // ClientAPIServiceClient is the client API for ClientAPIService service.
@@ -326,6 +330,14 @@ type ClientAPIServiceClient interface {
 	GetChainInfo(ctx context.Context, in *GetChainInfoRequest, opts ...grpc.CallOption) (*GetChainInfoResponse, error)
 	// GetIgniteInfo returns basic ignite info
 	GetIgniteInfo(ctx context.Context, in *GetIgniteInfoRequest, opts ...grpc.CallOption) (*GetIgniteInfoResponse, error)
+	// GetChainConfig returns the parsed config of the configured app
+	GetChainConfig(ctx context.Context, in *GetChainConfigRequest, opts ...grpc.CallOption) (*GetChainConfigResponse, error)
+	// GetModules returns the Cosmos SDK modules of the configured app
+	GetModules(ctx context.Context, in *GetModulesRequest, opts ...grpc.CallOption) (*GetModulesResponse, error)
+	// GetProtoPackages returns the proto packages of the configured app
+	GetProtoPackages(ctx context.Context, in *GetProtoPackagesRequest, opts ...grpc.CallOption) (*GetProtoPackagesResponse, error)
+	// GetAccounts returns the accounts of the configured app's keyring
+	GetAccounts(ctx context.Context, in *GetAccountsRequest, opts ...grpc.CallOption) (*GetAccountsResponse, error)
 }
 
 type clientAPIServiceClient struct {
@@ -356,6 +368,46 @@ func (c *clientAPIServiceClient) GetIgniteInfo(ctx context.Context, in *GetIgnit
 	return out, nil
 }
 
+func (c *clientAPIServiceClient) GetChainConfig(ctx context.Context, in *GetChainConfigRequest, opts ...grpc.CallOption) (*GetChainConfigResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetChainConfigResponse)
+	err := c.cc.Invoke(ctx, ClientAPIService_GetChainConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientAPIServiceClient) GetModules(ctx context.Context, in *GetModulesRequest, opts ...grpc.CallOption) (*GetModulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetModulesResponse)
+	err := c.cc.Invoke(ctx, ClientAPIService_GetModules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientAPIServiceClient) GetProtoPackages(ctx context.Context, in *GetProtoPackagesRequest, opts ...grpc.CallOption) (*GetProtoPackagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetProtoPackagesResponse)
+	err := c.cc.Invoke(ctx, ClientAPIService_GetProtoPackages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientAPIServiceClient) GetAccounts(ctx context.Context, in *GetAccountsRequest, opts ...grpc.CallOption) (*GetAccountsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAccountsResponse)
+	err := c.cc.Invoke(ctx, ClientAPIService_GetAccounts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ClientAPIServiceServer is the server API for ClientAPIService service.
 // All implementations must embed UnimplementedClientAPIServiceServer
 // for forward compatibility.
@@ -366,6 +418,14 @@ type ClientAPIServiceServer interface {
 	GetChainInfo(context.Context, *GetChainInfoRequest) (*GetChainInfoResponse, error)
 	// GetIgniteInfo returns basic ignite info
 	GetIgniteInfo(context.Context, *GetIgniteInfoRequest) (*GetIgniteInfoResponse, error)
+	// GetChainConfig returns the parsed config of the configured app
+	GetChainConfig(context.Context, *GetChainConfigRequest) (*GetChainConfigResponse, error)
+	// GetModules returns the Cosmos SDK modules of the configured app
+	GetModules(context.Context, *GetModulesRequest) (*GetModulesResponse, error)
+	// GetProtoPackages returns the proto packages of the configured app
+	GetProtoPackages(context.Context, *GetProtoPackagesRequest) (*GetProtoPackagesResponse, error)
+	// GetAccounts returns the accounts of the configured app's keyring
+	GetAccounts(context.Context, *GetAccountsRequest) (*GetAccountsResponse, error)
 	mustEmbedUnimplementedClientAPIServiceServer()
 }
 
@@ -382,6 +442,18 @@ func (UnimplementedClientAPIServiceServer) GetChainInfo(context.Context, *GetCha
 func (UnimplementedClientAPIServiceServer) GetIgniteInfo(context.Context, *GetIgniteInfoRequest) (*GetIgniteInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetIgniteInfo not implemented")
 }
+func (UnimplementedClientAPIServiceServer) GetChainConfig(context.Context, *GetChainConfigRequest) (*GetChainConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChainConfig not implemented")
+}
+func (UnimplementedClientAPIServiceServer) GetModules(context.Context, *GetModulesRequest) (*GetModulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetModules not implemented")
+}
+func (UnimplementedClientAPIServiceServer) GetProtoPackages(context.Context, *GetProtoPackagesRequest) (*GetProtoPackagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProtoPackages not implemented")
+}
+func (UnimplementedClientAPIServiceServer) GetAccounts(context.Context, *GetAccountsRequest) (*GetAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccounts not implemented")
+}
 func (UnimplementedClientAPIServiceServer) mustEmbedUnimplementedClientAPIServiceServer() {}
 func (UnimplementedClientAPIServiceServer) testEmbeddedByValue()                          {}
 
@@ -439,6 +511,78 @@ func _ClientAPIService_GetIgniteInfo_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ClientAPIService_GetChainConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChainConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientAPIServiceServer).GetChainConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClientAPIService_GetChainConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientAPIServiceServer).GetChainConfig(ctx, req.(*GetChainConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientAPIService_GetModules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetModulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientAPIServiceServer).GetModules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClientAPIService_GetModules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientAPIServiceServer).GetModules(ctx, req.(*GetModulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientAPIService_GetProtoPackages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProtoPackagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientAPIServiceServer).GetProtoPackages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClientAPIService_GetProtoPackages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientAPIServiceServer).GetProtoPackages(ctx, req.(*GetProtoPackagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientAPIService_GetAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientAPIServiceServer).GetAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClientAPIService_GetAccounts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientAPIServiceServer).GetAccounts(ctx, req.(*GetAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ClientAPIService_ServiceDesc is the grpc.ServiceDesc for ClientAPIService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -454,6 +598,22 @@ var ClientAPIService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetIgniteInfo",
 			Handler:    _ClientAPIService_GetIgniteInfo_Handler,
 		},
+		{
+			MethodName: "GetChainConfig",
+			Handler:    _ClientAPIService_GetChainConfig_Handler,
+		},
+		{
+			MethodName: "GetModules",
+			Handler:    _ClientAPIService_GetModules_Handler,
+		},
+		{
+			MethodName: "GetProtoPackages",
+			Handler:    _ClientAPIService_GetProtoPackages_Handler,
+		},
+		{
+			MethodName: "GetAccounts",
+			Handler:    _ClientAPIService_GetAccounts_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ignite/services/plugin/grpc/v1/service.proto",
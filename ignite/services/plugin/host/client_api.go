@@ -0,0 +1,465 @@
+// Package host implements the ClientAPI that Ignite serves to the apps.
+package host
+
+import (
+	"context"
+	"encoding/json"
+	"path/filepath"
+
+	"google.golang.org/protobuf/types/known/structpb"
+	"gopkg.in/yaml.v3"
+
+	chainconfig "github.com/ignite/cli/v29/ignite/config/chain"
+	"github.com/ignite/cli/v29/ignite/config/chain/base"
+	"github.com/ignite/cli/v29/ignite/pkg/chaincmd"
+	"github.com/ignite/cli/v29/ignite/pkg/cosmosaccount"
+	"github.com/ignite/cli/v29/ignite/pkg/cosmosanalysis/module"
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/pkg/protoanalysis"
+	"github.com/ignite/cli/v29/ignite/pkg/xyaml"
+	v1 "github.com/ignite/cli/v29/ignite/services/plugin/grpc/v1"
+	"github.com/ignite/cli/v29/ignite/version"
+)
+
+// ErrAppChainNotFound indicates that the plugin command is not running inside a blockchain app.
+var ErrAppChainNotFound = errors.New("blockchain app not found")
+
+//go:generate mockery --srcpkg . --name Chainer --structname ChainerInterface --filename chainer.go --output ../mocks --with-expecter
+type Chainer interface {
+	// AppPath returns the configured App's path.
+	AppPath() string
+
+	// ID returns the configured App's chain id.
+	ID() (string, error)
+
+	// ConfigPath returns the path to the App's config file.
+	ConfigPath() string
+
+	// RPCPublicAddress returns the configured App's rpc endpoint.
+	RPCPublicAddress() (string, error)
+
+	// Home returns the App's home dir.
+	Home() (string, error)
+
+	// Config returns the parsed App's config.
+	Config() (*chainconfig.Config, error)
+
+	// KeyringBackend returns the keyring backend of the App.
+	KeyringBackend() (chaincmd.KeyringBackend, error)
+
+	// Bech32Prefix returns the bech32 prefix of the App's addresses.
+	Bech32Prefix() (string, error)
+}
+
+// APIOption defines options for the client API.
+type APIOption func(*apiOptions)
+
+type apiOptions struct {
+	chain Chainer
+}
+
+// WithChain configures the chain to use for the client API.
+func WithChain(c Chainer) APIOption {
+	return func(o *apiOptions) {
+		o.chain = c
+	}
+}
+
+// NewClientAPI creates a new app ClientAPI.
+func NewClientAPI(options ...APIOption) ClientAPI {
+	o := apiOptions{}
+	for _, apply := range options {
+		apply(&o)
+	}
+	return ClientAPI{o}
+}
+
+// ClientAPI implements the plugin.ClientAPI interface served to the apps.
+// The plugin package isn't imported here because it forwards to this package.
+type ClientAPI struct {
+	o apiOptions
+}
+
+func (api ClientAPI) GetChainInfo(context.Context) (*v1.ChainInfo, error) {
+	chain, err := api.getChain()
+	if err != nil {
+		return nil, err
+	}
+
+	chainID, err := chain.ID()
+	if err != nil {
+		return nil, err
+	}
+
+	rpc, err := chain.RPCPublicAddress()
+	if err != nil {
+		return nil, err
+	}
+
+	home, err := chain.Home()
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.ChainInfo{
+		ChainId:    chainID,
+		AppPath:    chain.AppPath(),
+		ConfigPath: chain.ConfigPath(),
+		RpcAddress: rpc,
+		Home:       home,
+	}, nil
+}
+
+func (api ClientAPI) getChain() (Chainer, error) {
+	if api.o.chain == nil {
+		return nil, ErrAppChainNotFound
+	}
+	return api.o.chain, nil
+}
+
+func (api ClientAPI) GetIgniteInfo(ctx context.Context) (*v1.IgniteInfo, error) {
+	info, err := version.GetInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.IgniteInfo{
+		CliVersion:      info.CLIVersion,
+		GoVersion:       info.GoVersion,
+		SdkVersion:      info.SDKVersion,
+		BufVersion:      info.BufVersion,
+		BuildDate:       info.BuildDate,
+		SourceHash:      info.SourceHash,
+		ConfigVersion:   info.ConfigVersion,
+		Os:              info.OS,
+		Arch:            info.Arch,
+		BuildFromSource: info.BuildFromSource,
+	}, nil
+}
+
+func (api ClientAPI) GetChainConfig(context.Context) (*v1.ChainConfig, error) {
+	chain, err := api.getChain()
+	if err != nil {
+		return nil, err
+	}
+
+	cfg, err := chain.Config()
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := yaml.Marshal(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	genesis, err := toStruct(cfg.Genesis)
+	if err != nil {
+		return nil, err
+	}
+
+	validators := make([]*v1.ChainValidator, 0, len(cfg.Validators))
+	for _, v := range cfg.Validators {
+		validator, err := toChainValidator(v)
+		if err != nil {
+			return nil, err
+		}
+		validators = append(validators, validator)
+	}
+
+	accounts := make([]*v1.ChainAccount, 0, len(cfg.Accounts))
+	for _, a := range cfg.Accounts {
+		accounts = append(accounts, &v1.ChainAccount{
+			Name:          a.Name,
+			Coins:         a.Coins,
+			Mnemonic:      a.Mnemonic,
+			Address:       a.Address,
+			CoinType:      a.CoinType,
+			AccountNumber: a.AccountNumber,
+			AddressIndex:  a.AddressIndex,
+		})
+	}
+
+	return &v1.ChainConfig{
+		Version: uint64(cfg.Version),
+		Path:    chain.ConfigPath(),
+		Yaml:    bz,
+		Build: &v1.ChainBuild{
+			Main:      cfg.Build.Main,
+			Binary:    cfg.Build.Binary,
+			Ldflags:   cfg.Build.LDFlags,
+			ProtoPath: cfg.Build.Proto.Path,
+		},
+		Accounts:   accounts,
+		Validators: validators,
+		Genesis:    genesis,
+		Faucet:     toChainFaucet(cfg.Faucet),
+	}, nil
+}
+
+func (api ClientAPI) GetModules(ctx context.Context) ([]*v1.Module, error) {
+	chain, err := api.getChain()
+	if err != nil {
+		return nil, err
+	}
+
+	cfg, err := chain.Config()
+	if err != nil {
+		return nil, err
+	}
+
+	appPath := chain.AppPath()
+	modules, err := module.Discover(ctx, appPath, appPath, module.WithProtoDir(cfg.Build.Proto.Path))
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*v1.Module, 0, len(modules))
+	for _, m := range modules {
+		result = append(result, toModule(m))
+	}
+	return result, nil
+}
+
+func (api ClientAPI) GetProtoPackages(ctx context.Context) ([]*v1.ProtoPackage, error) {
+	chain, err := api.getChain()
+	if err != nil {
+		return nil, err
+	}
+
+	cfg, err := chain.Config()
+	if err != nil {
+		return nil, err
+	}
+
+	pkgs, err := protoanalysis.Parse(ctx, nil, filepath.Join(chain.AppPath(), cfg.Build.Proto.Path))
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*v1.ProtoPackage, 0, len(pkgs))
+	for _, pkg := range pkgs {
+		result = append(result, toProtoPackage(pkg))
+	}
+	return result, nil
+}
+
+func (api ClientAPI) GetAccounts(context.Context) ([]*v1.Account, error) {
+	chain, err := api.getChain()
+	if err != nil {
+		return nil, err
+	}
+
+	home, err := chain.Home()
+	if err != nil {
+		return nil, err
+	}
+
+	backend, err := chain.KeyringBackend()
+	if err != nil {
+		return nil, err
+	}
+
+	prefix, err := chain.Bech32Prefix()
+	if err != nil {
+		return nil, err
+	}
+
+	registry, err := cosmosaccount.New(
+		cosmosaccount.WithKeyringBackend(cosmosaccount.KeyringBackend(backend)),
+		cosmosaccount.WithHome(home),
+		cosmosaccount.WithBech32Prefix(prefix),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	accounts, err := registry.List()
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*v1.Account, 0, len(accounts))
+	for _, a := range accounts {
+		address, err := a.Address(prefix)
+		if err != nil {
+			return nil, err
+		}
+
+		pubKey, err := a.PubKey()
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, &v1.Account{
+			Name:    a.Name,
+			Address: address,
+			PubKey:  pubKey,
+		})
+	}
+	return result, nil
+}
+
+func toChainValidator(v chainconfig.Validator) (*v1.ChainValidator, error) {
+	app, err := toStruct(v.App)
+	if err != nil {
+		return nil, err
+	}
+
+	config, err := toStruct(v.Config)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := toStruct(v.Client)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.ChainValidator{
+		Name:   v.Name,
+		Bonded: v.Bonded,
+		Home:   v.Home,
+		App:    app,
+		Config: config,
+		Client: client,
+	}, nil
+}
+
+func toChainFaucet(f base.Faucet) *v1.ChainFaucet {
+	faucet := &v1.ChainFaucet{
+		Coins:           f.Coins,
+		CoinsMax:        f.CoinsMax,
+		RateLimitWindow: f.RateLimitWindow,
+		Host:            f.Host,
+		Port:            uint64(f.Port),
+		TxFee:           f.TxFee,
+		BatchWindow:     f.BatchWindow,
+		BatchSize:       uint64(f.BatchSize),
+	}
+	if f.Name != nil {
+		faucet.Name = *f.Name
+	}
+	return faucet
+}
+
+// toStruct converts a config map to a proto struct.
+// The map is encoded as JSON first because its values can have any type.
+func toStruct(m xyaml.Map) (*structpb.Struct, error) {
+	if len(m) == 0 {
+		return nil, nil
+	}
+
+	bz, err := json.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &structpb.Struct{}
+	if err := s.UnmarshalJSON(bz); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func toModule(m module.Module) *v1.Module {
+	msgs := make([]*v1.ModuleMsg, 0, len(m.Msgs))
+	for _, msg := range m.Msgs {
+		msgs = append(msgs, &v1.ModuleMsg{
+			Name:     msg.Name,
+			Uri:      msg.URI,
+			FilePath: msg.FilePath,
+		})
+	}
+
+	queries := make([]*v1.ModuleQuery, 0, len(m.HTTPQueries))
+	for _, q := range m.HTTPQueries {
+		queries = append(queries, &v1.ModuleQuery{
+			Name:         q.Name,
+			RequestType:  q.RequestType,
+			ResponseType: q.ResponseType,
+			FullName:     q.FullName,
+			Rules:        toProtoHTTPRules(q.Rules),
+			Paginated:    q.Paginated,
+			FilePath:     q.FilePath,
+		})
+	}
+
+	types := make([]*v1.ModuleType, 0, len(m.Types))
+	for _, t := range m.Types {
+		types = append(types, &v1.ModuleType{
+			Name:     t.Name,
+			FilePath: t.FilePath,
+		})
+	}
+
+	return &v1.Module{
+		Name:         m.Name,
+		GoModulePath: m.GoModulePath,
+		Package:      toProtoPackage(m.Pkg),
+		Msgs:         msgs,
+		Queries:      queries,
+		Types:        types,
+	}
+}
+
+func toProtoPackage(pkg protoanalysis.Package) *v1.ProtoPackage {
+	files := make([]*v1.ProtoFile, 0, len(pkg.Files))
+	for _, f := range pkg.Files {
+		files = append(files, &v1.ProtoFile{
+			Path:         f.Path,
+			Dependencies: f.Dependencies,
+		})
+	}
+
+	messages := make([]*v1.ProtoMessage, 0, len(pkg.Messages))
+	for _, m := range pkg.Messages {
+		messages = append(messages, &v1.ProtoMessage{
+			Name:               m.Name,
+			Path:               m.Path,
+			HighestFieldNumber: int64(m.HighestFieldNumber),
+			Fields:             m.Fields,
+		})
+	}
+
+	services := make([]*v1.ProtoService, 0, len(pkg.Services))
+	for _, s := range pkg.Services {
+		funcs := make([]*v1.ProtoRPCFunc, 0, len(s.RPCFuncs))
+		for _, f := range s.RPCFuncs {
+			funcs = append(funcs, &v1.ProtoRPCFunc{
+				Name:        f.Name,
+				RequestType: f.RequestType,
+				ReturnsType: f.ReturnsType,
+				HttpRules:   toProtoHTTPRules(f.HTTPRules),
+			})
+		}
+		services = append(services, &v1.ProtoService{
+			Name:     s.Name,
+			RpcFuncs: funcs,
+		})
+	}
+
+	return &v1.ProtoPackage{
+		Name:         pkg.Name,
+		Path:         pkg.Path,
+		Files:        files,
+		GoImportName: pkg.GoImportName,
+		Messages:     messages,
+		Services:     services,
+	}
+}
+
+func toProtoHTTPRules(rules []protoanalysis.HTTPRule) []*v1.ProtoHTTPRule {
+	result := make([]*v1.ProtoHTTPRule, 0, len(rules))
+	for _, r := range rules {
+		result = append(result, &v1.ProtoHTTPRule{
+			Endpoint:    r.Endpoint,
+			Params:      r.Params,
+			HasQuery:    r.HasQuery,
+			QueryFields: r.QueryFields,
+			HasBody:     r.HasBody,
+			BodyFields:  r.BodyFields,
+		})
+	}
+	return result
+}
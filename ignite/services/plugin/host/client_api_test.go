@@ -0,0 +1,119 @@
+package host_test
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	chainconfig "github.com/ignite/cli/v29/ignite/config/chain"
+	"github.com/ignite/cli/v29/ignite/config/chain/base"
+	"github.com/ignite/cli/v29/ignite/pkg/xyaml"
+	"github.com/ignite/cli/v29/ignite/services/plugin/host"
+	"github.com/ignite/cli/v29/ignite/services/plugin/mocks"
+)
+
+const testProto = `syntax = "proto3";
+
+package foo.bar.v1;
+
+import "google/api/annotations.proto";
+
+option go_package = "github.com/foo/bar/x/bar/types";
+
+message QueryParamsRequest {}
+
+message QueryParamsResponse {
+  string denom = 1;
+  uint64 amount = 3;
+}
+
+service Query {
+  rpc Params(QueryParamsRequest) returns (QueryParamsResponse) {
+    option (google.api.http).get = "/foo/bar/v1/params";
+  }
+}
+`
+
+func TestClientAPIGetChainConfig(t *testing.T) {
+	cfg := chainconfig.DefaultChainConfig()
+	cfg.Build.Proto.Path = "custom/proto"
+	cfg.Accounts = []base.Account{{Name: "alice", Coins: []string{"100token"}}}
+	cfg.Validators = []chainconfig.Validator{{Name: "alice", Bonded: "100000000stake"}}
+	cfg.Genesis = xyaml.Map{"app_state": map[string]interface{}{"foo": map[string]interface{}{"enabled": true}}}
+
+	chainer := mocks.NewChainerInterface(t)
+	chainer.EXPECT().ConfigPath().Return("config.yml")
+	chainer.EXPECT().Config().Return(cfg, nil)
+	api := host.NewClientAPI(host.WithChain(chainer))
+
+	chainCfg, err := api.GetChainConfig(context.Background())
+
+	require.NoError(t, err)
+	require.EqualValues(t, chainconfig.LatestVersion, chainCfg.Version)
+	require.Equal(t, "config.yml", chainCfg.Path)
+	require.Equal(t, "custom/proto", chainCfg.Build.ProtoPath)
+	require.Len(t, chainCfg.Accounts, 1)
+	require.Equal(t, "alice", chainCfg.Accounts[0].Name)
+	require.Equal(t, []string{"100token"}, chainCfg.Accounts[0].Coins)
+	require.Len(t, chainCfg.Validators, 1)
+	require.Equal(t, "100000000stake", chainCfg.Validators[0].Bonded)
+	require.Equal(t, cfg.Faucet.Coins, chainCfg.Faucet.Coins)
+	require.Equal(t, map[string]interface{}{
+		"app_state": map[string]interface{}{"foo": map[string]interface{}{"enabled": true}},
+	}, chainCfg.Genesis.AsMap())
+
+	decoded, err := chainconfig.Parse(bytes.NewReader(chainCfg.Yaml))
+	require.NoError(t, err)
+	require.Equal(t, cfg.Build.Proto.Path, decoded.Build.Proto.Path)
+	require.Equal(t, cfg.Accounts, decoded.Accounts)
+}
+
+func TestClientAPIGetProtoPackages(t *testing.T) {
+	appPath := t.TempDir()
+	protoPath := filepath.Join(appPath, "proto", "foo", "bar", "v1")
+	require.NoError(t, os.MkdirAll(protoPath, 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(protoPath, "query.proto"), []byte(testProto), 0o644))
+
+	chainer := mocks.NewChainerInterface(t)
+	chainer.EXPECT().AppPath().Return(appPath)
+	chainer.EXPECT().Config().Return(chainconfig.DefaultChainConfig(), nil)
+	api := host.NewClientAPI(host.WithChain(chainer))
+
+	pkgs, err := api.GetProtoPackages(context.Background())
+
+	require.NoError(t, err)
+	require.Len(t, pkgs, 1)
+	pkg := pkgs[0]
+	require.Equal(t, "foo.bar.v1", pkg.Name)
+	require.Equal(t, "github.com/foo/bar/x/bar/types", pkg.GoImportName)
+	require.Len(t, pkg.Files, 1)
+	require.Equal(t, []string{"google/api/annotations.proto"}, pkg.Files[0].Dependencies)
+	require.Len(t, pkg.Messages, 2)
+	require.Equal(t, "QueryParamsResponse", pkg.Messages[1].Name)
+	require.EqualValues(t, 3, pkg.Messages[1].HighestFieldNumber)
+	require.Len(t, pkg.Services, 1)
+	require.Len(t, pkg.Services[0].RpcFuncs, 1)
+	rpc := pkg.Services[0].RpcFuncs[0]
+	require.Equal(t, "Params", rpc.Name)
+	require.Equal(t, "QueryParamsResponse", rpc.ReturnsType)
+	require.Len(t, rpc.HttpRules, 1)
+	require.Equal(t, "/foo/bar/v1/params", rpc.HttpRules[0].Endpoint)
+}
+
+func TestClientAPIWithoutChain(t *testing.T) {
+	api := host.NewClientAPI()
+	ctx := context.Background()
+
+	_, err := api.GetChainConfig(ctx)
+	require.ErrorIs(t, err, host.ErrAppChainNotFound)
+	_, err = api.GetModules(ctx)
+	require.ErrorIs(t, err, host.ErrAppChainNotFound)
+	_, err = api.GetProtoPackages(ctx)
+	require.ErrorIs(t, err, host.ErrAppChainNotFound)
+	_, err = api.GetAccounts(ctx)
+	require.ErrorIs(t, err, host.ErrAppChainNotFound)
+}
@@ -21,7 +21,11 @@ const (
 type (
 	Command         = v1.Command
 	ChainInfo       = v1.ChainInfo
+	ChainConfig     = v1.ChainConfig
 	IgniteInfo      = v1.IgniteInfo
+	Module          = v1.Module
+	ProtoPackage    = v1.ProtoPackage
+	Account         = v1.Account
 	ExecutedCommand = v1.ExecutedCommand
 	ExecutedHook    = v1.ExecutedHook
 	Flag            = v1.Flag
@@ -74,4 +78,13 @@ type ClientAPI interface {
 	GetChainInfo(context.Context) (*ChainInfo, error)
 	// GetIgniteInfo returns basic info for the Ignite.
 	GetIgniteInfo(context.Context) (*IgniteInfo, error)
+	// GetChainConfig returns the parsed config of the configured blockchain app.
+	GetChainConfig(context.Context) (*ChainConfig, error)
+	// GetModules returns the Cosmos SDK modules of the configured blockchain app,
+	// with their messages, queries and types.
+	GetModules(context.Context) ([]*Module, error)
+	// GetProtoPackages returns the proto packages of the configured blockchain app.
+	GetProtoPackages(context.Context) ([]*ProtoPackage, error)
+	// GetAccounts returns the accounts of the configured blockchain app's keyring.
+	GetAccounts(context.Context) ([]*Account, error)
 }
@@ -0,0 +1,103 @@
+package plugin
+
+import (
+	"os"
+
+	pluginsconfig "github.com/ignite/cli/v29/ignite/config/plugins"
+	"github.com/ignite/cli/v29/ignite/pkg/checksum"
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/pkg/xgit"
+)
+
+// ErrLockMismatch is returned when a remote plugin doesn't match its lock.
+var ErrLockMismatch = errors.New("app doesn't match the lock")
+
+// locked returns the lock of a remote plugin, if any.
+func (p *Plugin) locked() (pluginsconfig.LockedPlugin, bool) {
+	if p.lock == nil || p.IsLocalPath() {
+		return pluginsconfig.LockedPlugin{}, false
+	}
+	return p.lock.Get(p.Path)
+}
+
+// unlock removes the plugin from its lock, so the plugin reference is resolved
+// again on the next fetch.
+func (p *Plugin) unlock() {
+	if _, ok := p.locked(); ok {
+		p.lock.Remove(p.Path)
+	}
+}
+
+// outdatedLock returns true if the fetched plugin isn't at the locked commit,
+// or if the plugin isn't locked yet.
+func (p *Plugin) outdatedLock() bool {
+	if p.lock == nil || p.IsLocalPath() {
+		return false
+	}
+	locked, ok := p.locked()
+	if !ok {
+		// the plugin must be fetched again to be locked, its source tree may
+		// have been modified by a previous build.
+		return true
+	}
+	commit, err := xgit.HeadCommit(p.cloneDir)
+	return err != nil || commit != locked.Commit
+}
+
+// verifyChecksum checks that the fetched sources of a locked plugin match the
+// checksum of the lock.
+func (p *Plugin) verifyChecksum() error {
+	locked, ok := p.locked()
+	if !ok {
+		return nil
+	}
+	sum, err := checksum.Dir(p.srcPath, ".git")
+	if err != nil {
+		return errors.Wrapf(err, "computing %q checksum", p.Path)
+	}
+	if sum != locked.Checksum {
+		return errors.Errorf(
+			"%w: app %q sources have checksum %s, expected checksum %s in %s",
+			ErrLockMismatch, p.Path, sum, locked.Checksum, p.lock.Path(),
+		)
+	}
+	return nil
+}
+
+// verifyLock checks that the fetched plugin matches its lock.
+// If the plugin isn't locked, the fetched commit is recorded in the lock.
+func (p *Plugin) verifyLock() {
+	if p.lock == nil {
+		return
+	}
+
+	commit, err := xgit.HeadCommit(p.cloneDir)
+	if err != nil {
+		p.Error = errors.Wrapf(err, "reading %q commit", p.repoPath)
+		return
+	}
+	sum, err := checksum.Dir(p.srcPath, ".git")
+	if err != nil {
+		p.Error = errors.Wrapf(err, "computing %q checksum", p.Path)
+		return
+	}
+
+	locked, ok := p.locked()
+	if !ok {
+		p.lock.Set(pluginsconfig.LockedPlugin{
+			Path:     p.Path,
+			Commit:   commit,
+			Checksum: sum,
+		})
+		return
+	}
+
+	if commit != locked.Commit || sum != locked.Checksum {
+		p.Error = errors.Errorf(
+			"%w: app %q fetched at commit %s with checksum %s, expected commit %s with checksum %s in %s",
+			ErrLockMismatch, p.Path, commit, sum, locked.Commit, locked.Checksum, p.lock.Path(),
+		)
+		// don't keep a source tree that doesn't match the lock
+		_ = os.RemoveAll(p.cloneDir)
+	}
+}
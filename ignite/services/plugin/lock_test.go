@@ -0,0 +1,117 @@
+package plugin
+
+import (
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/stretchr/testify/require"
+
+	pluginsconfig "github.com/ignite/cli/v29/ignite/config/plugins"
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/pkg/xgit"
+)
+
+func commitFile(t *testing.T, repo *git.Repository, dir, name, content string) {
+	t.Helper()
+	require.NoError(t, os.MkdirAll(path.Dir(path.Join(dir, name)), 0o755))
+	require.NoError(t, os.WriteFile(path.Join(dir, name), []byte(content), 0o644))
+	w, err := repo.Worktree()
+	require.NoError(t, err)
+	_, err = w.Add(name)
+	require.NoError(t, err)
+	_, err = w.Commit("msg", &git.CommitOptions{
+		Author: &object.Signature{Name: "bob", Email: "bob@example.com", When: time.Now()},
+	})
+	require.NoError(t, err)
+}
+
+func TestPluginLock(t *testing.T) {
+	repoDir := t.TempDir()
+	repo, err := git.PlainInit(repoDir, false)
+	require.NoError(t, err)
+	commitFile(t, repo, repoDir, "remote-lock/main.go", "package main\n")
+	head, err := repo.Head()
+	require.NoError(t, err)
+	lockedCommit := head.Hash().String()
+
+	newPlugin := func(t *testing.T, lock *pluginsconfig.Lock, options ...Option) *Plugin {
+		t.Helper()
+		cloneDir := t.TempDir()
+		p := &Plugin{
+			Plugin:   pluginsconfig.Plugin{Path: "github.com/ignite/remote-lock"},
+			repoPath: "github.com/ignite/remote-lock",
+			cloneURL: repoDir,
+			cloneDir: cloneDir,
+			srcPath:  path.Join(cloneDir, "remote-lock"),
+			name:     "remote-lock",
+		}
+		WithLock(lock, nil)(p)
+		for _, apply := range options {
+			apply(p)
+		}
+		return p
+	}
+
+	lock, err := pluginsconfig.ParseLock(t.TempDir())
+	require.NoError(t, err)
+
+	// Fetching an unlocked plugin records it
+	p := newPlugin(t, lock)
+	p.fetch()
+	require.NoError(t, p.Error)
+	locked, ok := lock.Get(p.Path)
+	require.True(t, ok)
+	require.Equal(t, lockedCommit, locked.Commit)
+	require.NotEmpty(t, locked.Checksum)
+
+	// Add a commit to the plugin repository
+	commitFile(t, repo, repoDir, "remote-lock/README.md", "new")
+
+	// Fetching a locked plugin checks out the locked commit
+	p = newPlugin(t, lock)
+	p.fetch()
+	require.NoError(t, p.Error)
+	commit, err := xgit.HeadCommit(p.cloneDir)
+	require.NoError(t, err)
+	require.Equal(t, lockedCommit, commit)
+	require.False(t, p.outdatedLock())
+
+	// Updating a plugin locks the new commit
+	require.NoError(t, Update(p))
+	require.NoError(t, p.Error)
+	updated, ok := lock.Get(p.Path)
+	require.True(t, ok)
+	require.NotEqual(t, lockedCommit, updated.Commit)
+	require.NotEqual(t, locked.Checksum, updated.Checksum)
+	require.True(t, newPlugin(t, lock).outdatedLock())
+
+	// Modified sources are fetched again, or fail in frozen mode
+	require.NoError(t, os.WriteFile(path.Join(p.srcPath, "README.md"), []byte("modified"), 0o644))
+	frozen := newPlugin(t, lock, Frozen())
+	frozen.cloneDir, frozen.srcPath = p.cloneDir, p.srcPath
+	frozen.sync()
+	require.ErrorIs(t, frozen.Error, ErrLockMismatch)
+	p.sync()
+	require.NoError(t, p.Error)
+	bz, err := os.ReadFile(path.Join(p.srcPath, "README.md"))
+	require.NoError(t, err)
+	require.Equal(t, "new", string(bz))
+
+	// Fetching a plugin that doesn't match its checksum fails
+	lock.Set(pluginsconfig.LockedPlugin{Path: p.Path, Commit: updated.Commit, Checksum: "xxx"})
+	p = newPlugin(t, lock)
+	p.fetch()
+	require.True(t, errors.Is(p.Error, ErrLockMismatch))
+	require.NoDirExists(t, p.cloneDir)
+
+	// Fetching an unlocked plugin fails in frozen mode
+	lock.Remove(p.Path)
+	p = newPlugin(t, lock, Frozen())
+	p.fetch()
+	require.ErrorContains(t, p.Error, "is not locked")
+	require.Empty(t, lock.Apps)
+}
@@ -2,7 +2,12 @@
 
 package mocks
 
-import mock "github.com/stretchr/testify/mock"
+import (
+	mock "github.com/stretchr/testify/mock"
+
+	v1 "github.com/ignite/cli/v29/ignite/config/chain/v1"
+	chaincmd "github.com/ignite/cli/v29/ignite/pkg/chaincmd"
+)
 
 // ChainerInterface is an autogenerated mock type for the Chainer type
 type ChainerInterface struct {
@@ -62,6 +67,118 @@ func (_c *ChainerInterface_AppPath_Call) RunAndReturn(run func() string) *Chaine
 	return _c
 }
 
+// Bech32Prefix provides a mock function with no fields
+func (_m *ChainerInterface) Bech32Prefix() (string, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Bech32Prefix")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (string, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ChainerInterface_Bech32Prefix_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Bech32Prefix'
+type ChainerInterface_Bech32Prefix_Call struct {
+	*mock.Call
+}
+
+// Bech32Prefix is a helper method to define mock.On call
+func (_e *ChainerInterface_Expecter) Bech32Prefix() *ChainerInterface_Bech32Prefix_Call {
+	return &ChainerInterface_Bech32Prefix_Call{Call: _e.mock.On("Bech32Prefix")}
+}
+
+func (_c *ChainerInterface_Bech32Prefix_Call) Run(run func()) *ChainerInterface_Bech32Prefix_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *ChainerInterface_Bech32Prefix_Call) Return(_a0 string, _a1 error) *ChainerInterface_Bech32Prefix_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ChainerInterface_Bech32Prefix_Call) RunAndReturn(run func() (string, error)) *ChainerInterface_Bech32Prefix_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Config provides a mock function with no fields
+func (_m *ChainerInterface) Config() (*v1.Config, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Config")
+	}
+
+	var r0 *v1.Config
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (*v1.Config, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() *v1.Config); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1.Config)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ChainerInterface_Config_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Config'
+type ChainerInterface_Config_Call struct {
+	*mock.Call
+}
+
+// Config is a helper method to define mock.On call
+func (_e *ChainerInterface_Expecter) Config() *ChainerInterface_Config_Call {
+	return &ChainerInterface_Config_Call{Call: _e.mock.On("Config")}
+}
+
+func (_c *ChainerInterface_Config_Call) Run(run func()) *ChainerInterface_Config_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *ChainerInterface_Config_Call) Return(_a0 *v1.Config, _a1 error) *ChainerInterface_Config_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ChainerInterface_Config_Call) RunAndReturn(run func() (*v1.Config, error)) *ChainerInterface_Config_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ConfigPath provides a mock function with no fields
 func (_m *ChainerInterface) ConfigPath() string {
 	ret := _m.Called()
@@ -217,6 +334,61 @@ func (_c *ChainerInterface_ID_Call) RunAndReturn(run func() (string, error)) *Ch
 	return _c
 }
 
+// KeyringBackend provides a mock function with no fields
+func (_m *ChainerInterface) KeyringBackend() (chaincmd.KeyringBackend, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for KeyringBackend")
+	}
+
+	var r0 chaincmd.KeyringBackend
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (chaincmd.KeyringBackend, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() chaincmd.KeyringBackend); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(chaincmd.KeyringBackend)
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ChainerInterface_KeyringBackend_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'KeyringBackend'
+type ChainerInterface_KeyringBackend_Call struct {
+	*mock.Call
+}
+
+// KeyringBackend is a helper method to define mock.On call
+func (_e *ChainerInterface_Expecter) KeyringBackend() *ChainerInterface_KeyringBackend_Call {
+	return &ChainerInterface_KeyringBackend_Call{Call: _e.mock.On("KeyringBackend")}
+}
+
+func (_c *ChainerInterface_KeyringBackend_Call) Run(run func()) *ChainerInterface_KeyringBackend_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *ChainerInterface_KeyringBackend_Call) Return(_a0 chaincmd.KeyringBackend, _a1 error) *ChainerInterface_KeyringBackend_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ChainerInterface_KeyringBackend_Call) RunAndReturn(run func() (chaincmd.KeyringBackend, error)) *ChainerInterface_KeyringBackend_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RPCPublicAddress provides a mock function with no fields
 func (_m *ChainerInterface) RPCPublicAddress() (string, error) {
 	ret := _m.Called()
@@ -23,6 +23,122 @@ func (_m *PluginClientAPI) EXPECT() *PluginClientAPI_Expecter {
 	return &PluginClientAPI_Expecter{mock: &_m.Mock}
 }
 
+// GetAccounts provides a mock function with given fields: _a0
+func (_m *PluginClientAPI) GetAccounts(_a0 context.Context) ([]*v1.Account, error) {
+	ret := _m.Called(_a0)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAccounts")
+	}
+
+	var r0 []*v1.Account
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*v1.Account, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*v1.Account); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*v1.Account)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PluginClientAPI_GetAccounts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAccounts'
+type PluginClientAPI_GetAccounts_Call struct {
+	*mock.Call
+}
+
+// GetAccounts is a helper method to define mock.On call
+//   - _a0 context.Context
+func (_e *PluginClientAPI_Expecter) GetAccounts(_a0 interface{}) *PluginClientAPI_GetAccounts_Call {
+	return &PluginClientAPI_GetAccounts_Call{Call: _e.mock.On("GetAccounts", _a0)}
+}
+
+func (_c *PluginClientAPI_GetAccounts_Call) Run(run func(_a0 context.Context)) *PluginClientAPI_GetAccounts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *PluginClientAPI_GetAccounts_Call) Return(_a0 []*v1.Account, _a1 error) *PluginClientAPI_GetAccounts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PluginClientAPI_GetAccounts_Call) RunAndReturn(run func(context.Context) ([]*v1.Account, error)) *PluginClientAPI_GetAccounts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetChainConfig provides a mock function with given fields: _a0
+func (_m *PluginClientAPI) GetChainConfig(_a0 context.Context) (*v1.ChainConfig, error) {
+	ret := _m.Called(_a0)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetChainConfig")
+	}
+
+	var r0 *v1.ChainConfig
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*v1.ChainConfig, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *v1.ChainConfig); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1.ChainConfig)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PluginClientAPI_GetChainConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetChainConfig'
+type PluginClientAPI_GetChainConfig_Call struct {
+	*mock.Call
+}
+
+// GetChainConfig is a helper method to define mock.On call
+//   - _a0 context.Context
+func (_e *PluginClientAPI_Expecter) GetChainConfig(_a0 interface{}) *PluginClientAPI_GetChainConfig_Call {
+	return &PluginClientAPI_GetChainConfig_Call{Call: _e.mock.On("GetChainConfig", _a0)}
+}
+
+func (_c *PluginClientAPI_GetChainConfig_Call) Run(run func(_a0 context.Context)) *PluginClientAPI_GetChainConfig_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *PluginClientAPI_GetChainConfig_Call) Return(_a0 *v1.ChainConfig, _a1 error) *PluginClientAPI_GetChainConfig_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PluginClientAPI_GetChainConfig_Call) RunAndReturn(run func(context.Context) (*v1.ChainConfig, error)) *PluginClientAPI_GetChainConfig_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetChainInfo provides a mock function with given fields: _a0
 func (_m *PluginClientAPI) GetChainInfo(_a0 context.Context) (*v1.ChainInfo, error) {
 	ret := _m.Called(_a0)
@@ -139,6 +255,122 @@ func (_c *PluginClientAPI_GetIgniteInfo_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
+// GetModules provides a mock function with given fields: _a0
+func (_m *PluginClientAPI) GetModules(_a0 context.Context) ([]*v1.Module, error) {
+	ret := _m.Called(_a0)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetModules")
+	}
+
+	var r0 []*v1.Module
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*v1.Module, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*v1.Module); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*v1.Module)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PluginClientAPI_GetModules_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetModules'
+type PluginClientAPI_GetModules_Call struct {
+	*mock.Call
+}
+
+// GetModules is a helper method to define mock.On call
+//   - _a0 context.Context
+func (_e *PluginClientAPI_Expecter) GetModules(_a0 interface{}) *PluginClientAPI_GetModules_Call {
+	return &PluginClientAPI_GetModules_Call{Call: _e.mock.On("GetModules", _a0)}
+}
+
+func (_c *PluginClientAPI_GetModules_Call) Run(run func(_a0 context.Context)) *PluginClientAPI_GetModules_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *PluginClientAPI_GetModules_Call) Return(_a0 []*v1.Module, _a1 error) *PluginClientAPI_GetModules_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PluginClientAPI_GetModules_Call) RunAndReturn(run func(context.Context) ([]*v1.Module, error)) *PluginClientAPI_GetModules_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetProtoPackages provides a mock function with given fields: _a0
+func (_m *PluginClientAPI) GetProtoPackages(_a0 context.Context) ([]*v1.ProtoPackage, error) {
+	ret := _m.Called(_a0)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProtoPackages")
+	}
+
+	var r0 []*v1.ProtoPackage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*v1.ProtoPackage, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*v1.ProtoPackage); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*v1.ProtoPackage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PluginClientAPI_GetProtoPackages_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProtoPackages'
+type PluginClientAPI_GetProtoPackages_Call struct {
+	*mock.Call
+}
+
+// GetProtoPackages is a helper method to define mock.On call
+//   - _a0 context.Context
+func (_e *PluginClientAPI_Expecter) GetProtoPackages(_a0 interface{}) *PluginClientAPI_GetProtoPackages_Call {
+	return &PluginClientAPI_GetProtoPackages_Call{Call: _e.mock.On("GetProtoPackages", _a0)}
+}
+
+func (_c *PluginClientAPI_GetProtoPackages_Call) Run(run func(_a0 context.Context)) *PluginClientAPI_GetProtoPackages_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *PluginClientAPI_GetProtoPackages_Call) Return(_a0 []*v1.ProtoPackage, _a1 error) *PluginClientAPI_GetProtoPackages_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PluginClientAPI_GetProtoPackages_Call) RunAndReturn(run func(context.Context) ([]*v1.ProtoPackage, error)) *PluginClientAPI_GetProtoPackages_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewPluginClientAPI creates a new instance of PluginClientAPI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewPluginClientAPI(t interface {
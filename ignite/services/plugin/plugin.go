@@ -54,6 +54,17 @@ type Plugin struct {
 	reference string
 	srcPath   string
 
+	// binDir holds the binaries of remote plugins, apart from their sources
+	// so a prebuilt binary can be installed without fetching them.
+	binDir string
+
+	// configPath is the path of the config declaring the plugin.
+	configPath string
+
+	// lock pins remote plugins to the commit they were built from.
+	lock   *pluginsconfig.Lock
+	frozen bool
+
 	client *hplugin.Client
 
 	// Holds a cache of the plugin manifest to prevent mant calls over the rpc boundary.
@@ -92,13 +103,50 @@ func RedirectStderr(w io.Writer) Option {
 	}
 }
 
+// WithLock verifies the remote plugins against the lock of their config and
+// records the plugins that are not locked yet.
+// globalLock is used for the plugins installed globally.
+func WithLock(lock, globalLock *pluginsconfig.Lock) Option {
+	return func(p *Plugin) {
+		if p.Global {
+			p.lock = globalLock
+		} else {
+			p.lock = lock
+		}
+	}
+}
+
+// WithConfigPath sets the path of the config declaring the plugins, relative
+// release indexes are resolved from its directory.
+// globalPath is used for the plugins installed globally.
+func WithConfigPath(path, globalPath string) Option {
+	return func(p *Plugin) {
+		if p.Global {
+			p.configPath = globalPath
+		} else {
+			p.configPath = path
+		}
+	}
+}
+
+// Frozen fails the load of remote plugins that are not locked instead of
+// recording them in the lock.
+func Frozen() Option {
+	return func(p *Plugin) {
+		p.frozen = true
+	}
+}
+
 // Load loads the plugins found in the chain config.
 //
 // There's 2 kinds of plugins, local or remote.
 // Local plugins have their path starting with a `/`, while remote plugins don't.
 // Local plugins are useful for development purpose.
 // Remote plugins require to be fetched first, in $HOME/.ignite/apps folder,
-// then they are loaded from there.
+// then they are loaded from there. When a release of the remote plugin has a
+// prebuilt binary for the current platform, the binary is installed instead of
+// building the plugin from source. A binary listed in the release index of the
+// plugin is installed without fetching the plugin.
 //
 // If an error occurs during a plugin load, it's not returned but rather stored in
 // the `Plugin.Error` field. This prevents the loading of other plugins to be interrupted.
@@ -118,11 +166,17 @@ func Load(ctx context.Context, plugins []pluginsconfig.Plugin, options ...Option
 }
 
 // Update removes the cache directory of plugins and fetch them again.
+// The reference of locked plugins is resolved again and their lock is rewritten.
 func Update(plugins ...*Plugin) error {
 	for _, p := range plugins {
+		if errors.Is(p.Error, ErrLockMismatch) {
+			// Updating the lock is the way out of a mismatch
+			p.Error = nil
+		}
 		if err := p.clean(); err != nil {
 			return err
 		}
+		p.unlock()
 		p.fetch()
 	}
 	return nil
@@ -179,19 +233,20 @@ func newPlugin(pluginsDir string, cp pluginsconfig.Plugin, options ...Option) *P
 	p.repoPath = path.Join(parts[:3]...)
 	p.cloneURL, _ = xurl.HTTPS(p.repoPath)
 
+	cloneName := p.repoPath
 	if len(p.reference) > 0 {
 		ref := strings.ReplaceAll(p.reference, "/", "-")
-		p.cloneDir = path.Join(pluginsDir, fmt.Sprintf("%s-%s", p.repoPath, ref))
+		cloneName = fmt.Sprintf("%s-%s", p.repoPath, ref)
 		p.repoPath += "@" + p.reference
-	} else {
-		p.cloneDir = path.Join(pluginsDir, p.repoPath)
 	}
+	p.cloneDir = path.Join(pluginsDir, cloneName)
 
 	// Plugin can have a subpath within its repository.
 	// For example, "github.com/ignite/apps/app1" where "app1" is the subpath.
 	repoSubPath := path.Join(parts[3:]...)
 
 	p.srcPath = path.Join(p.cloneDir, repoSubPath)
+	p.binDir = path.Join(pluginsDir, "bin", cloneName, repoSubPath)
 	p.name = path.Base(pluginPath)
 
 	return p
@@ -225,8 +280,17 @@ func (p Plugin) binaryName() string {
 	return fmt.Sprintf("%s.ign", p.name)
 }
 
+// binaryPath returns the path of the plugin binary.
+// The binary of a locked remote plugin is keyed by the locked commit, so it's
+// installed again when the lock changes.
 func (p Plugin) binaryPath() string {
-	return path.Join(p.srcPath, p.binaryName())
+	if p.IsLocalPath() {
+		return path.Join(p.srcPath, p.binaryName())
+	}
+	if locked, ok := p.locked(); ok {
+		return path.Join(p.binDir, locked.Commit, p.binaryName())
+	}
+	return path.Join(p.binDir, p.binaryName())
 }
 
 // load tries to fill p.Interface, ensuring the plugin is usable.
@@ -234,26 +298,21 @@ func (p *Plugin) load(ctx context.Context) {
 	if p.Error != nil {
 		return
 	}
-	_, err := os.Stat(p.srcPath)
-	if err != nil {
-		// srcPath found, need to fetch the plugin
-		p.fetch()
-		if p.Error != nil {
-			return
-		}
-	}
-
 	if p.IsLocalPath() {
 		// trigger rebuild for local plugin if binary is outdated
 		if p.outdatedBinary() {
 			p.build(ctx)
 		}
-	} else {
-		// Check if binary is already build
-		_, err = os.Stat(p.binaryPath())
-		if err != nil {
-			// binary not found, need to build it
-			p.build(ctx)
+	} else if !p.installed() {
+		// binary not found, install a prebuilt binary or build it.
+		// The release index doesn't require the plugin sources, so the binary
+		// is installed from it before fetching them, which allows to load the
+		// plugin without network access.
+		if !p.installIndex(ctx) {
+			p.sync()
+			if !p.install(ctx) {
+				p.build(ctx)
+			}
 		}
 	}
 	if p.Error != nil {
@@ -344,6 +403,46 @@ func (p *Plugin) load(ctx context.Context) {
 	}
 }
 
+// installed returns true if the binary of a remote plugin is installed.
+// A plugin missing from the lock isn't considered as installed, because it
+// must be fetched to be locked.
+func (p *Plugin) installed() bool {
+	if _, ok := p.locked(); !ok && p.lock != nil {
+		return false
+	}
+	_, err := os.Stat(p.binaryPath())
+	return err == nil
+}
+
+// sync fetches the plugin sources, unless they're already fetched at the
+// locked commit and match the lock checksum.
+// In frozen mode, sources that don't match the lock checksum are an error.
+func (p *Plugin) sync() {
+	if _, err := os.Stat(p.srcPath); err != nil {
+		// srcPath not found, need to fetch the plugin
+		p.fetch()
+		return
+	}
+	// the plugin was fetched at another commit than the locked one
+	outdated := p.outdatedLock()
+	if !outdated {
+		if err := p.verifyChecksum(); err != nil {
+			if p.frozen {
+				p.Error = err
+				return
+			}
+			// the sources were modified since they were fetched
+			outdated = true
+		}
+	}
+	if outdated {
+		if p.Error = p.clean(); p.Error != nil {
+			return
+		}
+		p.fetch()
+	}
+}
+
 // fetch clones the plugin repository at the expected reference.
 func (p *Plugin) fetch() {
 	if p.IsLocalPath() {
@@ -355,11 +454,21 @@ func (p *Plugin) fetch() {
 	p.ev.Send(fmt.Sprintf("Fetching app %q", p.cloneURL), events.ProgressStart())
 	defer p.ev.Send(fmt.Sprintf("%s App fetched %q", icons.OK, p.cloneURL), events.ProgressFinish())
 
-	urlref := strings.Join([]string{p.cloneURL, p.reference}, "@")
+	ref := p.reference
+	if locked, ok := p.locked(); ok {
+		ref = locked.Commit
+	} else if p.frozen && p.lock != nil {
+		p.Error = errors.Errorf("%w: app %q is not locked in %s", ErrLockMismatch, p.Path, p.lock.Path())
+		return
+	}
+
+	urlref := strings.Join([]string{p.cloneURL, ref}, "@")
 	err := xgit.Clone(context.Background(), urlref, p.cloneDir)
 	if err != nil {
 		p.Error = errors.Wrapf(err, "cloning %q", p.repoPath)
+		return
 	}
+	p.verifyLock()
 }
 
 // build compiles the plugin binary.
@@ -374,7 +483,11 @@ func (p *Plugin) build(ctx context.Context) {
 		p.Error = errors.Wrapf(err, "go mod tidy")
 		return
 	}
-	if err := gocmd.Build(ctx, p.binaryName(), p.srcPath, nil); err != nil {
+	if err := os.MkdirAll(path.Dir(p.binaryPath()), 0o755); err != nil {
+		p.Error = errors.WithStack(err)
+		return
+	}
+	if err := gocmd.Build(ctx, p.binaryPath(), p.srcPath, nil); err != nil {
 		p.Error = errors.Wrapf(err, "go build")
 		return
 	}
@@ -390,9 +503,12 @@ func (p *Plugin) clean() error {
 		// Not a remote plugin, nothing to clean
 		return nil
 	}
-	// Clean the cloneDir, next time the ignite command will be invoked, the
-	// plugin will be fetched again.
-	err := os.RemoveAll(p.cloneDir)
+	// Clean the cloneDir and the binaries, next time the ignite command will be
+	// invoked, the plugin will be fetched again.
+	if err := os.RemoveAll(p.cloneDir); err != nil {
+		return errors.WithStack(err)
+	}
+	err := os.RemoveAll(p.binDir)
 	return errors.WithStack(err)
 }
 
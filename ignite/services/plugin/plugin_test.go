@@ -95,6 +95,7 @@ func TestNewPlugin(t *testing.T) {
 				cloneDir:  ".ignite/apps/github.com/ignite/app",
 				reference: "",
 				srcPath:   ".ignite/apps/github.com/ignite/app",
+				binDir:    ".ignite/apps/bin/github.com/ignite/app",
 				name:      "app",
 				stdout:    os.Stdout,
 				stderr:    os.Stderr,
@@ -109,6 +110,7 @@ func TestNewPlugin(t *testing.T) {
 				cloneDir:  ".ignite/apps/github.com/ignite/app-develop",
 				reference: "develop",
 				srcPath:   ".ignite/apps/github.com/ignite/app-develop",
+				binDir:    ".ignite/apps/bin/github.com/ignite/app-develop",
 				name:      "app",
 				stdout:    os.Stdout,
 				stderr:    os.Stderr,
@@ -123,6 +125,7 @@ func TestNewPlugin(t *testing.T) {
 				cloneDir:  ".ignite/apps/github.com/ignite/app-package-v1.0.0",
 				reference: "package/v1.0.0",
 				srcPath:   ".ignite/apps/github.com/ignite/app-package-v1.0.0",
+				binDir:    ".ignite/apps/bin/github.com/ignite/app-package-v1.0.0",
 				name:      "app",
 				stdout:    os.Stdout,
 				stderr:    os.Stderr,
@@ -137,6 +140,7 @@ func TestNewPlugin(t *testing.T) {
 				cloneDir:  ".ignite/apps/github.com/ignite/app",
 				reference: "",
 				srcPath:   ".ignite/apps/github.com/ignite/app/plugin1",
+				binDir:    ".ignite/apps/bin/github.com/ignite/app/plugin1",
 				name:      "plugin1",
 				stdout:    os.Stdout,
 				stderr:    os.Stderr,
@@ -151,6 +155,7 @@ func TestNewPlugin(t *testing.T) {
 				cloneDir:  ".ignite/apps/github.com/ignite/app-develop",
 				reference: "develop",
 				srcPath:   ".ignite/apps/github.com/ignite/app-develop/plugin1",
+				binDir:    ".ignite/apps/bin/github.com/ignite/app-develop/plugin1",
 				name:      "plugin1",
 				stdout:    os.Stdout,
 				stderr:    os.Stderr,
@@ -165,6 +170,7 @@ func TestNewPlugin(t *testing.T) {
 				cloneDir:  ".ignite/apps/github.com/ignite/app-package-v1.0.0",
 				reference: "package/v1.0.0",
 				srcPath:   ".ignite/apps/github.com/ignite/app-package-v1.0.0/plugin1",
+				binDir:    ".ignite/apps/bin/github.com/ignite/app-package-v1.0.0/plugin1",
 				name:      "plugin1",
 				stdout:    os.Stdout,
 				stderr:    os.Stderr,
@@ -259,6 +265,7 @@ func TestPluginLoad(t *testing.T) {
 				t.Helper()
 				path := scaffoldPlugin(t, t.TempDir(), "github.com/foo/bar", false)
 				return Plugin{
+					Plugin:  pluginsconfig.Plugin{Path: path},
 					srcPath: path,
 					name:    "bar",
 				}
@@ -275,6 +282,7 @@ func TestPluginLoad(t *testing.T) {
 					cloneURL: repoDir,
 					cloneDir: cloneDir,
 					srcPath:  path.Join(cloneDir, "remote"),
+					binDir:   t.TempDir(),
 					name:     "remote",
 				}
 			},
@@ -314,6 +322,7 @@ func TestPluginLoad(t *testing.T) {
 					reference: "v1",
 					cloneDir:  cloneDir,
 					srcPath:   path.Join(cloneDir, "remote-tag"),
+					binDir:    t.TempDir(),
 					name:      "remote-tag",
 				}
 			},
@@ -338,6 +347,7 @@ func TestPluginLoad(t *testing.T) {
 					reference: "branch1",
 					cloneDir:  cloneDir,
 					srcPath:   path.Join(cloneDir, "remote-branch"),
+					binDir:    t.TempDir(),
 					name:      "remote-branch",
 				}
 			},
@@ -357,6 +367,7 @@ func TestPluginLoad(t *testing.T) {
 					reference: h.Hash().String(),
 					cloneDir:  cloneDir,
 					srcPath:   path.Join(cloneDir, "remote-hash"),
+					binDir:    t.TempDir(),
 					name:      "remote-hash",
 				}
 			},
@@ -374,6 +385,7 @@ func TestPluginLoad(t *testing.T) {
 					reference: "doesnt_exists",
 					cloneDir:  cloneDir,
 					srcPath:   path.Join(cloneDir, "remote-no-ref"),
+					binDir:    t.TempDir(),
 					name:      "remote-no-ref",
 				}
 			},
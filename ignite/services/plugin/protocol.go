@@ -238,6 +238,42 @@ func (c clientAPIClient) GetIgniteInfo(ctx context.Context) (*IgniteInfo, error)
 	return r.IgniteInfo, nil
 }
 
+func (c clientAPIClient) GetChainConfig(ctx context.Context) (*ChainConfig, error) {
+	r, err := c.grpc.GetChainConfig(ctx, &v1.GetChainConfigRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return r.ChainConfig, nil
+}
+
+func (c clientAPIClient) GetModules(ctx context.Context) ([]*Module, error) {
+	r, err := c.grpc.GetModules(ctx, &v1.GetModulesRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return r.Modules, nil
+}
+
+func (c clientAPIClient) GetProtoPackages(ctx context.Context) ([]*ProtoPackage, error) {
+	r, err := c.grpc.GetProtoPackages(ctx, &v1.GetProtoPackagesRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return r.Packages, nil
+}
+
+func (c clientAPIClient) GetAccounts(ctx context.Context) ([]*Account, error) {
+	r, err := c.grpc.GetAccounts(ctx, &v1.GetAccountsRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return r.Accounts, nil
+}
+
 type clientAPIServer struct {
 	v1.UnimplementedClientAPIServiceServer
 
@@ -261,3 +297,39 @@ func (s clientAPIServer) GetIgniteInfo(ctx context.Context, _ *v1.GetIgniteInfoR
 
 	return &v1.GetIgniteInfoResponse{IgniteInfo: igniteInfo}, nil
 }
+
+func (s clientAPIServer) GetChainConfig(ctx context.Context, _ *v1.GetChainConfigRequest) (*v1.GetChainConfigResponse, error) {
+	chainConfig, err := s.impl.GetChainConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.GetChainConfigResponse{ChainConfig: chainConfig}, nil
+}
+
+func (s clientAPIServer) GetModules(ctx context.Context, _ *v1.GetModulesRequest) (*v1.GetModulesResponse, error) {
+	modules, err := s.impl.GetModules(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.GetModulesResponse{Modules: modules}, nil
+}
+
+func (s clientAPIServer) GetProtoPackages(ctx context.Context, _ *v1.GetProtoPackagesRequest) (*v1.GetProtoPackagesResponse, error) {
+	packages, err := s.impl.GetProtoPackages(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.GetProtoPackagesResponse{Packages: packages}, nil
+}
+
+func (s clientAPIServer) GetAccounts(ctx context.Context, _ *v1.GetAccountsRequest) (*v1.GetAccountsResponse, error) {
+	accounts, err := s.impl.GetAccounts(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.GetAccountsResponse{Accounts: accounts}, nil
+}
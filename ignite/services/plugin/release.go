@@ -0,0 +1,285 @@
+package plugin
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/ignite/cli/v29/ignite/pkg/cliui/icons"
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/pkg/events"
+	"github.com/ignite/cli/v29/ignite/pkg/tarball"
+	"github.com/ignite/cli/v29/ignite/pkg/xgit"
+)
+
+// ErrArtifactChecksum is returned when a prebuilt binary doesn't match its checksum.
+var ErrArtifactChecksum = errors.New("app artifact checksum mismatch")
+
+type (
+	// ReleaseIndex lists the prebuilt releases of an app.
+	ReleaseIndex struct {
+		Releases []Release `yaml:"releases"`
+	}
+
+	// Release holds the prebuilt binaries of an app version.
+	// A release matches a plugin when its version is the plugin reference, or
+	// when its commit is the commit the plugin was fetched at.
+	Release struct {
+		// Version is the reference the release was built from, like a tag.
+		Version string `yaml:"version,omitempty"`
+
+		// Commit is the hash of the commit the release was built from.
+		Commit string `yaml:"commit,omitempty"`
+
+		// Artifacts holds the binaries of the release.
+		Artifacts []Artifact `yaml:"artifacts"`
+	}
+
+	// Artifact is a prebuilt app binary for a platform.
+	Artifact struct {
+		// OS and Arch are the GOOS and GOARCH of the binary.
+		OS   string `yaml:"os"`
+		Arch string `yaml:"arch"`
+
+		// URL is the location of the binary, or of a tar.gz archive containing it.
+		// It is either a URL or a file path, relative paths are resolved from
+		// the location of the index.
+		URL string `yaml:"url"`
+
+		// Checksum is the SHA256 checksum of the file at URL.
+		Checksum string `yaml:"checksum"`
+	}
+)
+
+// install installs the prebuilt binary of the plugin matching the current
+// platform. It returns false when no prebuilt binary matches, or when the
+// releases can't be read, in which case the plugin must be built from source.
+func (p *Plugin) install(ctx context.Context) bool {
+	if p.Error != nil {
+		return false
+	}
+
+	artifact, location, err := p.findArtifact(ctx)
+	if err != nil {
+		p.ev.Send(
+			fmt.Sprintf("Can't read the releases of app %q, building it from source: %s", p.Path, err),
+			events.Icon(icons.NotOK),
+		)
+		return false
+	}
+	if artifact == nil {
+		return false
+	}
+
+	p.ev.Send(fmt.Sprintf("Installing app %q", p.Path), events.ProgressStart())
+	defer p.ev.Send(fmt.Sprintf("%s App installed %q", icons.OK, p.Path), events.ProgressFinish())
+
+	if err := p.installArtifact(ctx, *artifact, location); err != nil {
+		p.Error = errors.Wrapf(err, "installing %q", location)
+	}
+	return true
+}
+
+// installIndex installs the prebuilt binary of the plugin from its release
+// index, without fetching the plugin sources. A plugin missing from the lock
+// isn't installed, because it must be fetched to be locked.
+func (p *Plugin) installIndex(ctx context.Context) bool {
+	if p.Index == "" {
+		return false
+	}
+	if _, ok := p.locked(); !ok && p.lock != nil {
+		return false
+	}
+	return p.install(ctx)
+}
+
+// findArtifact returns the artifact of the plugin matching the current platform
+// and its resolved location, or nil if there's none.
+func (p *Plugin) findArtifact(ctx context.Context) (*Artifact, string, error) {
+	releases, base, err := p.releases(ctx)
+	if err != nil || len(releases) == 0 {
+		return nil, "", err
+	}
+
+	// The locked commit is known before the plugin is fetched
+	locked, ok := p.locked()
+	commit := locked.Commit
+	if !ok {
+		commit, _ = xgit.HeadCommit(p.cloneDir)
+	}
+	for _, r := range releases {
+		matchVersion := r.Version != "" && r.Version == p.reference
+		matchCommit := r.Commit != "" && r.Commit == commit
+		if !matchVersion && !matchCommit {
+			continue
+		}
+		for _, a := range r.Artifacts {
+			if a.OS == runtime.GOOS && a.Arch == runtime.GOARCH {
+				location, err := resolveLocation(base, a.URL)
+				if err != nil {
+					return nil, "", err
+				}
+				return &a, location, nil
+			}
+		}
+	}
+	return nil, "", nil
+}
+
+// releases returns the releases of the plugin and the location they're read
+// from. The index of the plugin config takes precedence over the releases of
+// the app.ignite.yml file of the plugin repository.
+func (p *Plugin) releases(ctx context.Context) ([]Release, string, error) {
+	if p.Index != "" {
+		location, err := resolveLocation(p.configPath, p.Index)
+		if err != nil {
+			return nil, "", err
+		}
+		index, err := readReleaseIndex(ctx, location)
+		return index.Releases, location, err
+	}
+
+	configPath := filepath.Join(p.cloneDir, AppsConfigFile)
+	bz, err := os.ReadFile(configPath)
+	if os.IsNotExist(err) {
+		return nil, "", nil
+	} else if err != nil {
+		return nil, "", err
+	}
+
+	var cfg AppsConfig
+	if err := yaml.Unmarshal(bz, &cfg); err != nil {
+		return nil, "", errors.Errorf("invalid %s: %w", configPath, err)
+	}
+
+	subPath, err := filepath.Rel(p.cloneDir, p.srcPath)
+	if err != nil {
+		return nil, "", err
+	}
+	for _, app := range cfg.Apps {
+		if filepath.Clean(app.Path) != subPath {
+			continue
+		}
+		if app.Index == "" {
+			return app.Releases, configPath, nil
+		}
+
+		location, err := resolveLocation(configPath, app.Index)
+		if err != nil {
+			return nil, "", err
+		}
+		index, err := readReleaseIndex(ctx, location)
+		return index.Releases, location, err
+	}
+	return nil, "", nil
+}
+
+// installArtifact downloads the artifact, verifies its checksum and writes the
+// plugin binary.
+func (p *Plugin) installArtifact(ctx context.Context, a Artifact, location string) error {
+	data, err := readLocation(ctx, location)
+	if err != nil {
+		return err
+	}
+
+	sum := fmt.Sprintf("%x", sha256.Sum256(data))
+	if sum != strings.TrimPrefix(a.Checksum, "sha256:") {
+		return errors.Errorf("%w: got %s, expected %s", ErrArtifactChecksum, sum, a.Checksum)
+	}
+
+	// The artifact is either a binary or an archive containing it
+	var binary bytes.Buffer
+	for _, name := range []string{p.binaryName(), p.name} {
+		_, err = tarball.ExtractFile(bytes.NewReader(data), &binary, name)
+		if !errors.Is(err, tarball.ErrGzipFileNotFound) {
+			break
+		}
+	}
+	switch {
+	case errors.Is(err, tarball.ErrNotGzipType):
+		binary.Write(data)
+	case errors.Is(err, tarball.ErrGzipFileNotFound):
+		return errors.Errorf("archive doesn't contain the %s binary", p.name)
+	case err != nil:
+		return err
+	}
+
+	// Write the binary atomically, so an interrupted install doesn't leave a
+	// partial binary which would be considered as installed.
+	if err := os.MkdirAll(filepath.Dir(p.binaryPath()), 0o755); err != nil {
+		return err
+	}
+	tmp := p.binaryPath() + ".tmp"
+	if err := os.WriteFile(tmp, binary.Bytes(), 0o755); err != nil { //nolint:gosec
+		return err
+	}
+	return os.Rename(tmp, p.binaryPath())
+}
+
+// readReleaseIndex reads a release index from a URL or a file path.
+func readReleaseIndex(ctx context.Context, location string) (ReleaseIndex, error) {
+	var index ReleaseIndex
+	bz, err := readLocation(ctx, location)
+	if err != nil {
+		return index, err
+	}
+	if err := yaml.Unmarshal(bz, &index); err != nil {
+		return index, errors.Errorf("invalid release index %s: %w", location, err)
+	}
+	return index, nil
+}
+
+// readLocation reads the content at a URL or a file path.
+func readLocation(ctx context.Context, location string) ([]byte, error) {
+	if !isURL(location) {
+		return os.ReadFile(strings.TrimPrefix(location, "file://"))
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, location, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("download %s: %s", location, resp.Status)
+	}
+	return io.ReadAll(resp.Body)
+}
+
+// resolveLocation resolves ref from the location of the file base.
+func resolveLocation(base, ref string) (string, error) {
+	if isURL(ref) || filepath.IsAbs(ref) || strings.HasPrefix(ref, "file://") {
+		return ref, nil
+	}
+	if isURL(base) {
+		u, err := url.Parse(base)
+		if err != nil {
+			return "", err
+		}
+		r, err := url.Parse(ref)
+		if err != nil {
+			return "", err
+		}
+		return u.ResolveReference(r).String(), nil
+	}
+	return filepath.Join(filepath.Dir(strings.TrimPrefix(base, "file://")), ref), nil
+}
+
+func isURL(location string) bool {
+	return strings.HasPrefix(location, "http://") || strings.HasPrefix(location, "https://")
+}
@@ -0,0 +1,284 @@
+package plugin
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+
+	pluginsconfig "github.com/ignite/cli/v29/ignite/config/plugins"
+)
+
+var testBinary = []byte("#!/bin/sh\necho app\n")
+
+func sha256Hex(data []byte) string {
+	return fmt.Sprintf("%x", sha256.Sum256(data))
+}
+
+func tarGz(t *testing.T, name string, data []byte) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	gw := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gw)
+	require.NoError(t, tw.WriteHeader(&tar.Header{
+		Name:     "app/" + name,
+		Mode:     0o755,
+		Size:     int64(len(data)),
+		Typeflag: tar.TypeReg,
+	}))
+	_, err := tw.Write(data)
+	require.NoError(t, err)
+	require.NoError(t, tw.Close())
+	require.NoError(t, gw.Close())
+	return buf.Bytes()
+}
+
+func writeYAML(t *testing.T, path string, v any) {
+	t.Helper()
+	bz, err := yaml.Marshal(v)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(path, bz, 0o644))
+}
+
+func TestPluginInstall(t *testing.T) {
+	newPlugin := func(t *testing.T, index string) *Plugin {
+		t.Helper()
+		cloneDir := t.TempDir()
+		srcPath := filepath.Join(cloneDir, "app")
+		require.NoError(t, os.MkdirAll(srcPath, 0o755))
+		return &Plugin{
+			Plugin:    pluginsconfig.Plugin{Path: "github.com/ignite/apps/app@v1", Index: index},
+			reference: "v1",
+			cloneDir:  cloneDir,
+			srcPath:   srcPath,
+			binDir:    t.TempDir(),
+			name:      "app",
+		}
+	}
+	release := func(url string, data []byte) Release {
+		return Release{
+			Version: "v1",
+			Artifacts: []Artifact{
+				{OS: "plan9", Arch: "mips", URL: "other", Checksum: "xxx"},
+				{OS: runtime.GOOS, Arch: runtime.GOARCH, URL: url, Checksum: sha256Hex(data)},
+			},
+		}
+	}
+
+	tests := []struct {
+		name          string
+		plugin        func(t *testing.T) *Plugin
+		expectInstall bool
+		expectedError string
+	}{
+		{
+			name: "ok: binary from local index",
+			plugin: func(t *testing.T) *Plugin {
+				t.Helper()
+				dir := t.TempDir()
+				require.NoError(t, os.WriteFile(filepath.Join(dir, "app.ign"), testBinary, 0o755))
+				writeYAML(t, filepath.Join(dir, "index.yml"), ReleaseIndex{
+					Releases: []Release{release("app.ign", testBinary)},
+				})
+				return newPlugin(t, filepath.Join(dir, "index.yml"))
+			},
+			expectInstall: true,
+		},
+		{
+			name: "ok: relative index resolved from the config",
+			plugin: func(t *testing.T) *Plugin {
+				t.Helper()
+				dir := t.TempDir()
+				require.NoError(t, os.MkdirAll(filepath.Join(dir, "mirror"), 0o755))
+				require.NoError(t, os.WriteFile(filepath.Join(dir, "mirror", "app.ign"), testBinary, 0o755))
+				writeYAML(t, filepath.Join(dir, "mirror", "index.yml"), ReleaseIndex{
+					Releases: []Release{release("app.ign", testBinary)},
+				})
+				p := newPlugin(t, "mirror/index.yml")
+				WithConfigPath(filepath.Join(dir, "igniteapps.yml"), "")(p)
+				return p
+			},
+			expectInstall: true,
+		},
+		{
+			name: "ok: archive from remote index",
+			plugin: func(t *testing.T) *Plugin {
+				t.Helper()
+				archive := tarGz(t, "app", testBinary)
+				mux := http.NewServeMux()
+				mux.HandleFunc("/releases/index.yml", func(w http.ResponseWriter, _ *http.Request) {
+					_ = yaml.NewEncoder(w).Encode(ReleaseIndex{
+						Releases: []Release{release("app_v1.tar.gz", archive)},
+					})
+				})
+				mux.HandleFunc("/releases/app_v1.tar.gz", func(w http.ResponseWriter, _ *http.Request) {
+					_, _ = w.Write(archive)
+				})
+				server := httptest.NewServer(mux)
+				t.Cleanup(server.Close)
+				return newPlugin(t, server.URL+"/releases/index.yml")
+			},
+			expectInstall: true,
+		},
+		{
+			name: "ok: releases of the repository matching the commit",
+			plugin: func(t *testing.T) *Plugin {
+				t.Helper()
+				p := newPlugin(t, "")
+				p.reference = ""
+				repo, err := git.PlainInit(p.cloneDir, false)
+				require.NoError(t, err)
+				commitFile(t, repo, p.cloneDir, "app/main.go", "package main\n")
+				head, err := repo.Head()
+				require.NoError(t, err)
+
+				archive := tarGz(t, "app.ign", testBinary)
+				require.NoError(t, os.WriteFile(filepath.Join(p.cloneDir, "app.tar.gz"), archive, 0o644))
+				r := release("app.tar.gz", archive)
+				r.Version, r.Commit = "", head.Hash().String()
+				writeYAML(t, filepath.Join(p.cloneDir, AppsConfigFile), AppsConfig{
+					Version: 1,
+					Apps: map[string]AppInfo{
+						"other": {Path: "./other", Releases: []Release{release("xxx", nil)}},
+						"app":   {Path: "./app", Releases: []Release{r}},
+					},
+				})
+				return p
+			},
+			expectInstall: true,
+		},
+		{
+			name: "ok: no release matching the reference",
+			plugin: func(t *testing.T) *Plugin {
+				t.Helper()
+				dir := t.TempDir()
+				r := release("app.ign", testBinary)
+				r.Version = "v2"
+				writeYAML(t, filepath.Join(dir, "index.yml"), ReleaseIndex{Releases: []Release{r}})
+				return newPlugin(t, filepath.Join(dir, "index.yml"))
+			},
+		},
+		{
+			name: "ok: no releases",
+			plugin: func(t *testing.T) *Plugin {
+				t.Helper()
+				return newPlugin(t, "")
+			},
+		},
+		{
+			name: "fail: checksum mismatch",
+			plugin: func(t *testing.T) *Plugin {
+				t.Helper()
+				dir := t.TempDir()
+				require.NoError(t, os.WriteFile(filepath.Join(dir, "app.ign"), []byte("tampered"), 0o755))
+				writeYAML(t, filepath.Join(dir, "index.yml"), ReleaseIndex{
+					Releases: []Release{release("app.ign", testBinary)},
+				})
+				return newPlugin(t, filepath.Join(dir, "index.yml"))
+			},
+			expectInstall: true,
+			expectedError: "app artifact checksum mismatch",
+		},
+		{
+			name: "fail: archive without binary",
+			plugin: func(t *testing.T) *Plugin {
+				t.Helper()
+				dir := t.TempDir()
+				archive := tarGz(t, "README.md", testBinary)
+				require.NoError(t, os.WriteFile(filepath.Join(dir, "app.tar.gz"), archive, 0o644))
+				writeYAML(t, filepath.Join(dir, "index.yml"), ReleaseIndex{
+					Releases: []Release{release("app.tar.gz", archive)},
+				})
+				return newPlugin(t, filepath.Join(dir, "index.yml"))
+			},
+			expectInstall: true,
+			expectedError: "archive doesn't contain the app binary",
+		},
+		{
+			name: "ok: index not found",
+			plugin: func(t *testing.T) *Plugin {
+				t.Helper()
+				return newPlugin(t, filepath.Join(t.TempDir(), "index.yml"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := tt.plugin(t)
+
+			installed := p.install(context.Background())
+
+			require.Equal(t, tt.expectInstall, installed)
+			if tt.expectedError != "" {
+				require.ErrorContains(t, p.Error, tt.expectedError)
+				require.NoFileExists(t, p.binaryPath())
+				return
+			}
+			require.NoError(t, p.Error)
+			if !tt.expectInstall {
+				require.NoFileExists(t, p.binaryPath())
+				return
+			}
+			bz, err := os.ReadFile(p.binaryPath())
+			require.NoError(t, err)
+			require.Equal(t, testBinary, bz)
+		})
+	}
+}
+
+func TestPluginInstallIndex(t *testing.T) {
+	const commit = "0123456789abcdef0123456789abcdef01234567"
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "app.ign"), testBinary, 0o755))
+	writeYAML(t, filepath.Join(dir, "index.yml"), ReleaseIndex{
+		Releases: []Release{{
+			Commit: commit,
+			Artifacts: []Artifact{
+				{OS: runtime.GOOS, Arch: runtime.GOARCH, URL: "app.ign", Checksum: sha256Hex(testBinary)},
+			},
+		}},
+	})
+
+	lock, err := pluginsconfig.ParseLock(t.TempDir())
+	require.NoError(t, err)
+	newPlugin := func() *Plugin {
+		// The sources of the plugin are never fetched
+		cloneDir := filepath.Join(t.TempDir(), "clone")
+		return &Plugin{
+			Plugin:   pluginsconfig.Plugin{Path: "github.com/ignite/apps/app", Index: filepath.Join(dir, "index.yml")},
+			cloneURL: "/xxxx/yyyy",
+			cloneDir: cloneDir,
+			srcPath:  filepath.Join(cloneDir, "app"),
+			binDir:   t.TempDir(),
+			name:     "app",
+			lock:     lock,
+		}
+	}
+
+	// A plugin missing from the lock must be fetched to be locked
+	p := newPlugin()
+	require.False(t, p.installIndex(context.Background()))
+	require.False(t, p.installed())
+
+	// A locked plugin is installed from the release of the locked commit
+	lock.Set(pluginsconfig.LockedPlugin{Path: p.Path, Commit: commit, Checksum: "xxx"})
+	p = newPlugin()
+	require.True(t, p.installIndex(context.Background()))
+	require.NoError(t, p.Error)
+	require.True(t, p.installed())
+	require.Equal(t, filepath.Join(p.binDir, commit, "app.ign"), p.binaryPath())
+	require.NoDirExists(t, p.cloneDir)
+}
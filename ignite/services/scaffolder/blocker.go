@@ -0,0 +1,54 @@
+package scaffolder
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/pkg/multiformatname"
+	moduleblocker "github.com/ignite/cli/v29/ignite/templates/module/blocker"
+)
+
+// CreateBlocker scaffolds a begin, end or pre blocker inside an existing module.
+// The module implements the blocker interface by calling a keeper blocker stub.
+func (s Scaffolder) CreateBlocker(moduleName, kind string) error {
+	if moduleName == "" {
+		moduleName = s.modpath.Package
+	}
+
+	mfModuleName, err := multiformatname.NewName(moduleName, multiformatname.NoNumber)
+	if err != nil {
+		return err
+	}
+	moduleName = mfModuleName.LowerCase
+
+	ok, err := moduleExists(s.appPath, moduleName)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errors.Errorf("the module %s doesn't exist", moduleName)
+	}
+
+	opts := &moduleblocker.Options{
+		ModuleName: moduleName,
+		ModulePath: s.modpath.RawPath,
+		Kind:       kind,
+	}
+	if err := opts.Validate(); err != nil {
+		return err
+	}
+
+	if _, err := os.Stat(filepath.Join(s.appPath, opts.KeeperFile())); err == nil {
+		return errors.Errorf("%s blocker already exists for module %s", kind, moduleName)
+	} else if !os.IsNotExist(err) {
+		return err
+	}
+
+	g, err := moduleblocker.NewGenerator(opts)
+	if err != nil {
+		return err
+	}
+
+	return s.Run(g)
+}
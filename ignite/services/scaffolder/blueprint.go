@@ -0,0 +1,470 @@
+package scaffolder
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/pkg/multiformatname"
+	"github.com/ignite/cli/v29/ignite/pkg/protoanalysis"
+	"github.com/ignite/cli/v29/ignite/pkg/xgenny"
+	"github.com/ignite/cli/v29/ignite/templates/field"
+	modulecreate "github.com/ignite/cli/v29/ignite/templates/module/create"
+)
+
+const (
+	// BlueprintTypeList is the kind of a type stored in a list, as scaffolded with "ignite scaffold list".
+	BlueprintTypeList = "list"
+
+	// BlueprintTypeMap is the kind of a type stored in a map, as scaffolded with "ignite scaffold map".
+	BlueprintTypeMap = "map"
+
+	// BlueprintTypeSingle is the kind of a type stored as a single entry, as scaffolded with "ignite scaffold single".
+	BlueprintTypeSingle = "single"
+
+	// BlueprintTypeDry is the kind of a type without a storage, as scaffolded with "ignite scaffold type".
+	BlueprintTypeDry = "type"
+
+	paramsMessageName = "Params"
+	configMessageName = "Module"
+)
+
+var isValidDependencyName = regexp.MustCompile(`^[a-zA-Z]+$`).MatchString
+
+// Blueprint describes the modules of an app and the components scaffolded in them.
+type Blueprint struct {
+	Modules []BlueprintModule `yaml:"modules"`
+}
+
+// BlueprintModule describes a module and its components.
+// The params and configs missing in an existing module are added to it.
+type BlueprintModule struct {
+	Name         string             `yaml:"name"`
+	IBC          bool               `yaml:"ibc,omitempty"`
+	Ordering     string             `yaml:"ordering,omitempty"`
+	Dependencies []string           `yaml:"dependencies,omitempty"`
+	Params       []string           `yaml:"params,omitempty"`
+	Configs      []string           `yaml:"configs,omitempty"`
+	Types        []BlueprintType    `yaml:"types,omitempty"`
+	Messages     []BlueprintMessage `yaml:"messages,omitempty"`
+	Queries      []BlueprintQuery   `yaml:"queries,omitempty"`
+	Packets      []BlueprintPacket  `yaml:"packets,omitempty"`
+}
+
+// BlueprintType describes a type of a module.
+type BlueprintType struct {
+	Name             string   `yaml:"name"`
+	Kind             string   `yaml:"kind"`
+	Fields           []string `yaml:"fields,omitempty"`
+	Index            string   `yaml:"index,omitempty"`
+	SecondaryIndexes []string `yaml:"secondary-indexes,omitempty"`
+	Signer           string   `yaml:"signer,omitempty"`
+	NoMessage        bool     `yaml:"no-message,omitempty"`
+	NoSimulation     bool     `yaml:"no-simulation,omitempty"`
+	Events           bool     `yaml:"events,omitempty"`
+}
+
+// BlueprintMessage describes a message of a module.
+type BlueprintMessage struct {
+	Name         string   `yaml:"name"`
+	Fields       []string `yaml:"fields,omitempty"`
+	Response     []string `yaml:"response,omitempty"`
+	Description  string   `yaml:"description,omitempty"`
+	Signer       string   `yaml:"signer,omitempty"`
+	NoSimulation bool     `yaml:"no-simulation,omitempty"`
+}
+
+// BlueprintQuery describes a query of a module.
+type BlueprintQuery struct {
+	Name        string   `yaml:"name"`
+	Fields      []string `yaml:"fields,omitempty"`
+	Response    []string `yaml:"response,omitempty"`
+	Description string   `yaml:"description,omitempty"`
+	Paginated   bool     `yaml:"paginated,omitempty"`
+}
+
+// BlueprintPacket describes an IBC packet of a module.
+type BlueprintPacket struct {
+	Name      string   `yaml:"name"`
+	Fields    []string `yaml:"fields,omitempty"`
+	Ack       []string `yaml:"ack,omitempty"`
+	Signer    string   `yaml:"signer,omitempty"`
+	NoMessage bool     `yaml:"no-message,omitempty"`
+}
+
+// ParseBlueprint reads a blueprint. Unknown fields are rejected.
+func ParseBlueprint(r io.Reader) (*Blueprint, error) {
+	var bp Blueprint
+	decoder := yaml.NewDecoder(r)
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&bp); err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, errors.New("the blueprint is empty")
+		}
+		return nil, errors.Errorf("error parsing blueprint: %w", err)
+	}
+	if err := bp.Validate(); err != nil {
+		return nil, err
+	}
+	return &bp, nil
+}
+
+// ParseBlueprintFile reads a blueprint file.
+func ParseBlueprintFile(path string) (*Blueprint, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return ParseBlueprint(f)
+}
+
+// Validate that the blueprint is usable.
+func (bp Blueprint) Validate() error {
+	if len(bp.Modules) == 0 {
+		return errors.New("the blueprint doesn't describe any module")
+	}
+
+	modules := make(map[string]struct{})
+	for _, m := range bp.Modules {
+		if m.Name == "" {
+			return errors.New("a module of the blueprint has no name")
+		}
+		if _, ok := modules[m.Name]; ok {
+			return errors.Errorf("the module %s is described twice", m.Name)
+		}
+		modules[m.Name] = struct{}{}
+
+		for _, dep := range m.Dependencies {
+			if !isValidDependencyName(dep) {
+				return errors.Errorf("invalid dependency name format '%s' of module %s", dep, m.Name)
+			}
+		}
+		if len(m.Packets) > 0 && !m.IBC {
+			return errors.Errorf("the module %s must be an IBC module to define packets", m.Name)
+		}
+
+		names := make(map[string]struct{})
+		checkName := func(kind, name string) error {
+			if name == "" {
+				return errors.Errorf("a %s of the module %s has no name", kind, m.Name)
+			}
+			if _, ok := names[name]; ok {
+				return errors.Errorf("the component %s is described twice in module %s", name, m.Name)
+			}
+			names[name] = struct{}{}
+			return nil
+		}
+		for _, t := range m.Types {
+			if err := checkName("type", t.Name); err != nil {
+				return err
+			}
+			switch t.Kind {
+			case BlueprintTypeList, BlueprintTypeMap, BlueprintTypeSingle, BlueprintTypeDry:
+			default:
+				return errors.Errorf(
+					"invalid kind %q of the type %s, must be one of: %s, %s, %s, %s",
+					t.Kind,
+					t.Name,
+					BlueprintTypeList,
+					BlueprintTypeMap,
+					BlueprintTypeSingle,
+					BlueprintTypeDry,
+				)
+			}
+			if t.Index != "" && t.Kind != BlueprintTypeMap {
+				return errors.Errorf("the type %s can't have an index, only maps are indexed", t.Name)
+			}
+		}
+		for _, msg := range m.Messages {
+			if err := checkName("message", msg.Name); err != nil {
+				return err
+			}
+		}
+		for _, q := range m.Queries {
+			if err := checkName("query", q.Name); err != nil {
+				return err
+			}
+		}
+		for _, p := range m.Packets {
+			if err := checkName("packet", p.Name); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// ApplyBlueprint scaffolds the modules and the components described by the blueprint,
+// skipping the ones that already exist, and returns the modifications and the skipped components.
+// The modifications are applied after each component, so the next ones can depend on it,
+// and each component is recorded in the app history.
+// The code generated from the proto files is not updated, PostScaffold must be called afterward.
+func (s Scaffolder) ApplyBlueprint(
+	ctx context.Context,
+	bp Blueprint,
+	options ...xgenny.ApplyOption,
+) (sm xgenny.SourceModification, skipped []string, err error) {
+	sm = xgenny.NewSourceModification()
+	apply := func(component string, scaffold func() error) error {
+		if err := scaffold(); err != nil {
+			return errors.Errorf("failed to scaffold %s: %w", component, err)
+		}
+		modifications, err := s.ApplyModifications(options...)
+		if err != nil {
+			return err
+		}
+		sm.Merge(modifications)
+		return nil
+	}
+
+	for _, m := range bp.Modules {
+		mfName, err := multiformatname.NewName(m.Name, multiformatname.NoNumber)
+		if err != nil {
+			return sm, skipped, err
+		}
+		moduleName := mfName.LowerCase
+
+		ok, err := moduleExists(s.appPath, moduleName)
+		if err != nil {
+			return sm, skipped, err
+		}
+
+		var (
+			params  = m.Params
+			configs = m.Configs
+		)
+		if ok {
+			skipped = append(skipped, fmt.Sprintf("module %s", moduleName))
+		} else {
+			err := apply(fmt.Sprintf("module %s", moduleName), func() error {
+				return s.CreateModule(moduleName, blueprintModuleOptions(m)...)
+			})
+			if err != nil {
+				return sm, skipped, err
+			}
+			params, configs = nil, nil
+		}
+
+		pkgs, err := protoanalysis.Parse(ctx, nil, filepath.Join(s.appPath, s.protoDir, s.modpath.Package, moduleName))
+		if err != nil {
+			return sm, skipped, err
+		}
+
+		if params, err = missingFields(pkgs, paramsMessageName, params); err != nil {
+			return sm, skipped, err
+		}
+		if len(params) > 0 {
+			err := apply(fmt.Sprintf("params of module %s", moduleName), func() error {
+				return s.CreateParams(moduleName, params...)
+			})
+			if err != nil {
+				return sm, skipped, err
+			}
+		}
+
+		if configs, err = missingFields(pkgs, configMessageName, configs); err != nil {
+			return sm, skipped, err
+		}
+		if len(configs) > 0 {
+			err := apply(fmt.Sprintf("configs of module %s", moduleName), func() error {
+				return s.CreateConfigs(moduleName, configs...)
+			})
+			if err != nil {
+				return sm, skipped, err
+			}
+		}
+
+		for _, t := range m.Types {
+			component := fmt.Sprintf("%s %s in module %s", t.Kind, t.Name, moduleName)
+			name, err := multiformatname.NewName(t.Name)
+			if err != nil {
+				return sm, skipped, err
+			}
+			if _, ok := findProtoMessage(pkgs, name.PascalCase); ok {
+				skipped = append(skipped, component)
+				continue
+			}
+			err = apply(component, func() error {
+				return s.AddType(ctx, t.Name, blueprintTypeKind(t), blueprintTypeOptions(moduleName, t)...)
+			})
+			if err != nil {
+				return sm, skipped, err
+			}
+		}
+
+		for _, msg := range m.Messages {
+			component := fmt.Sprintf("message %s in module %s", msg.Name, moduleName)
+			name, err := multiformatname.NewName(msg.Name)
+			if err != nil {
+				return sm, skipped, err
+			}
+			if hasRPC(pkgs, msgServiceName, name.PascalCase) {
+				skipped = append(skipped, component)
+				continue
+			}
+			err = apply(component, func() error {
+				return s.AddMessage(ctx, moduleName, msg.Name, msg.Fields, msg.Response, blueprintMessageOptions(msg)...)
+			})
+			if err != nil {
+				return sm, skipped, err
+			}
+		}
+
+		for _, q := range m.Queries {
+			component := fmt.Sprintf("query %s in module %s", q.Name, moduleName)
+			name, err := multiformatname.NewName(q.Name)
+			if err != nil {
+				return sm, skipped, err
+			}
+			if hasRPC(pkgs, queryServiceName, name.PascalCase) {
+				skipped = append(skipped, component)
+				continue
+			}
+			desc := q.Description
+			if desc == "" {
+				desc = fmt.Sprintf("Query %s", q.Name)
+			}
+			err = apply(component, func() error {
+				return s.AddQuery(ctx, moduleName, q.Name, desc, q.Fields, q.Response, q.Paginated)
+			})
+			if err != nil {
+				return sm, skipped, err
+			}
+		}
+
+		for _, p := range m.Packets {
+			component := fmt.Sprintf("packet %s in module %s", p.Name, moduleName)
+			name, err := multiformatname.NewName(p.Name)
+			if err != nil {
+				return sm, skipped, err
+			}
+			if _, ok := findProtoMessage(pkgs, name.PascalCase+"PacketData"); ok {
+				skipped = append(skipped, component)
+				continue
+			}
+			err = apply(component, func() error {
+				return s.AddPacket(ctx, moduleName, p.Name, p.Fields, p.Ack, blueprintPacketOptions(p)...)
+			})
+			if err != nil {
+				return sm, skipped, err
+			}
+		}
+	}
+
+	return sm, skipped, nil
+}
+
+func blueprintModuleOptions(m BlueprintModule) []ModuleCreationOption {
+	options := []ModuleCreationOption{
+		WithParams(m.Params),
+		WithModuleConfigs(m.Configs),
+	}
+	if m.IBC {
+		options = append(options, WithIBCChannelOrdering(m.Ordering), WithIBC())
+	}
+	if len(m.Dependencies) > 0 {
+		deps := make([]modulecreate.Dependency, len(m.Dependencies))
+		for i, name := range m.Dependencies {
+			deps[i] = modulecreate.NewDependency(name)
+		}
+		options = append(options, WithDependencies(deps))
+	}
+	return options
+}
+
+func blueprintTypeKind(t BlueprintType) AddTypeKind {
+	switch t.Kind {
+	case BlueprintTypeList:
+		return ListType()
+	case BlueprintTypeMap:
+		return MapType(t.Index)
+	case BlueprintTypeSingle:
+		return SingletonType()
+	default:
+		return DryType()
+	}
+}
+
+func blueprintTypeOptions(moduleName string, t BlueprintType) []AddTypeOption {
+	options := []AddTypeOption{TypeWithModule(moduleName)}
+	if len(t.Fields) > 0 {
+		options = append(options, TypeWithFields(t.Fields...))
+	}
+	if len(t.SecondaryIndexes) > 0 {
+		options = append(options, TypeWithSecondaryIndexes(t.SecondaryIndexes...))
+	}
+	if t.NoMessage {
+		options = append(options, TypeWithoutMessage())
+	} else {
+		if t.Signer != "" {
+			options = append(options, TypeWithSigner(t.Signer))
+		}
+		if t.NoSimulation {
+			options = append(options, TypeWithoutSimulation())
+		}
+	}
+	if t.Events {
+		options = append(options, TypeWithEvents())
+	}
+	return options
+}
+
+func blueprintMessageOptions(msg BlueprintMessage) []MessageOption {
+	var options []MessageOption
+	if msg.Description != "" {
+		options = append(options, WithDescription(msg.Description))
+	}
+	if msg.Signer != "" {
+		options = append(options, WithSigner(msg.Signer))
+	}
+	if msg.NoSimulation {
+		options = append(options, WithoutSimulation())
+	}
+	return options
+}
+
+func blueprintPacketOptions(p BlueprintPacket) []PacketOption {
+	var options []PacketOption
+	if p.NoMessage {
+		options = append(options, PacketWithoutMessage())
+	}
+	if p.Signer != "" {
+		options = append(options, PacketWithSigner(p.Signer))
+	}
+	return options
+}
+
+// hasRPC checks if an RPC is defined in a service of the module.
+func hasRPC(pkgs protoanalysis.Packages, service, name string) bool {
+	return len(findRPCs(pkgs, service, func(rpc string) bool { return rpc == name })) > 0
+}
+
+// missingFields returns the fields not defined in a proto message of the module.
+func missingFields(pkgs protoanalysis.Packages, messageName string, fields []string) ([]string, error) {
+	if len(fields) == 0 {
+		return nil, nil
+	}
+
+	parsed, err := field.ParseFields(fields, checkForbiddenTypeIndex)
+	if err != nil {
+		return nil, err
+	}
+
+	msg, _ := findProtoMessage(pkgs, messageName)
+	var missing []string
+	for i, f := range parsed {
+		if _, ok := msg.Fields[f.ProtoFieldName()]; !ok && !slices.Contains(missing, fields[i]) {
+			missing = append(missing, fields[i])
+		}
+	}
+	return missing, nil
+}
@@ -0,0 +1,130 @@
+package scaffolder
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseBlueprint(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    *Blueprint
+		err     string
+	}{
+		{
+			name: "modules and components",
+			content: `modules:
+  - name: blog
+    params: [maxTitle:uint]
+    types:
+      - name: post
+        kind: list
+        fields: [title, body]
+      - name: comment
+        kind: map
+        index: postID:uint
+        secondary-indexes: [creator]
+        no-message: true
+    messages:
+      - name: like-post
+        fields: [id:uint]
+        response: [count:uint]
+    queries:
+      - name: count-posts
+        paginated: true
+  - name: transfer
+    ibc: true
+    packets:
+      - name: send-post
+        ack: [postID:uint]
+`,
+			want: &Blueprint{
+				Modules: []BlueprintModule{
+					{
+						Name:   "blog",
+						Params: []string{"maxTitle:uint"},
+						Types: []BlueprintType{
+							{Name: "post", Kind: BlueprintTypeList, Fields: []string{"title", "body"}},
+							{
+								Name:             "comment",
+								Kind:             BlueprintTypeMap,
+								Index:            "postID:uint",
+								SecondaryIndexes: []string{"creator"},
+								NoMessage:        true,
+							},
+						},
+						Messages: []BlueprintMessage{
+							{Name: "like-post", Fields: []string{"id:uint"}, Response: []string{"count:uint"}},
+						},
+						Queries: []BlueprintQuery{{Name: "count-posts", Paginated: true}},
+					},
+					{
+						Name:    "transfer",
+						IBC:     true,
+						Packets: []BlueprintPacket{{Name: "send-post", Ack: []string{"postID:uint"}}},
+					},
+				},
+			},
+		},
+		{
+			name:    "empty blueprint",
+			content: "",
+			err:     "the blueprint is empty",
+		},
+		{
+			name:    "unknown field",
+			content: "modules:\n  - name: blog\n    maps: []\n",
+			err:     "field maps not found",
+		},
+		{
+			name:    "no module",
+			content: "modules: []\n",
+			err:     "the blueprint doesn't describe any module",
+		},
+		{
+			name:    "duplicated module",
+			content: "modules:\n  - name: blog\n  - name: blog\n",
+			err:     "the module blog is described twice",
+		},
+		{
+			name:    "invalid type kind",
+			content: "modules:\n  - name: blog\n    types:\n      - name: post\n        kind: array\n",
+			err:     `invalid kind "array" of the type post`,
+		},
+		{
+			name:    "index of a list",
+			content: "modules:\n  - name: blog\n    types:\n      - name: post\n        kind: list\n        index: id\n",
+			err:     "the type post can't have an index, only maps are indexed",
+		},
+		{
+			name:    "duplicated component",
+			content: "modules:\n  - name: blog\n    types:\n      - name: post\n        kind: list\n    messages:\n      - name: post\n",
+			err:     "the component post is described twice in module blog",
+		},
+		{
+			name:    "packets of a module without ibc",
+			content: "modules:\n  - name: blog\n    packets:\n      - name: send-post\n",
+			err:     "the module blog must be an IBC module to define packets",
+		},
+		{
+			name:    "invalid dependency",
+			content: "modules:\n  - name: blog\n    dependencies: [bank-v2]\n",
+			err:     "invalid dependency name format 'bank-v2' of module blog",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := ParseBlueprint(strings.NewReader(tc.content))
+			if tc.err != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
@@ -21,6 +21,7 @@ const (
 	componentMessage = "message"
 	componentQuery   = "query"
 	componentPacket  = "packet"
+	componentEvent   = "event"
 )
 
 // checkComponentValidity performs various checks common to all components to verify if it can be scaffolded.
@@ -54,6 +55,7 @@ func checkComponentCreated(appPath, moduleName string, compName multiformatname.
 		fmt.Sprintf("query%srequest", compName.LowerCase):     componentQuery,
 		fmt.Sprintf("query%sresponse", compName.LowerCase):    componentQuery,
 		fmt.Sprintf("%spacketdata", compName.LowerCase):       componentPacket,
+		fmt.Sprintf("event%s", compName.LowerCase):            componentEvent,
 	}
 
 	if !noMessage {
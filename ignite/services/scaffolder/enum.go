@@ -0,0 +1,92 @@
+package scaffolder
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+
+	"github.com/emicklei/proto"
+	"github.com/gobuffalo/genny/v2"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/pkg/protoanalysis/protoutil"
+	"github.com/ignite/cli/v29/ignite/templates/enum"
+	"github.com/ignite/cli/v29/ignite/templates/field"
+)
+
+// enumGenerators returns the generators scaffolding the enums declared by the fields.
+// Enums already defined in the module with the same values are reused, and an error
+// is returned if they are defined with different values.
+func (s Scaffolder) enumGenerators(moduleName string, fields ...field.Fields) ([]*genny.Generator, error) {
+	var (
+		gens  []*genny.Generator
+		enums = make(map[string][]string)
+	)
+	for _, f := range fields {
+		for _, enumField := range f.Enums() {
+			values := enum.ValueNames(enumField)
+			if existing, ok := enums[enumField.Datatype]; ok {
+				if !slices.Equal(existing, values) {
+					return nil, errors.Errorf("enum %s is declared with different values", enumField.Datatype)
+				}
+				continue
+			}
+			enums[enumField.Datatype] = values
+
+			path := filepath.Join(s.appPath, s.protoDir, s.modpath.Package, moduleName, "v1", enumField.EnumProtoFile()+".proto")
+			created, err := checkEnumCreated(path, enumField.Datatype, values)
+			if err != nil {
+				return nil, err
+			}
+			if created {
+				continue
+			}
+
+			g, err := enum.NewGenerator(&enum.Options{
+				AppName:    s.modpath.Package,
+				ProtoDir:   s.protoDir,
+				ProtoVer:   "v1", // TODO(@julienrbrt): possibly in the future add flag to specify custom proto version.
+				ModulePath: s.modpath.RawPath,
+				ModuleName: moduleName,
+				Enum:       enumField,
+			})
+			if err != nil {
+				return nil, err
+			}
+			gens = append(gens, g)
+		}
+	}
+	return gens, nil
+}
+
+// checkEnumCreated checks if the enum is already defined in the proto file with the same values.
+func checkEnumCreated(path, name string, values []string) (bool, error) {
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		return false, nil
+	}
+
+	f, err := protoutil.ParseProtoPath(path)
+	if err != nil {
+		return false, err
+	}
+
+	for _, elem := range f.Elements {
+		e, ok := elem.(*proto.Enum)
+		if !ok || e.Name != name {
+			continue
+		}
+
+		var existing []string
+		for _, enumElem := range e.Elements {
+			if value, ok := enumElem.(*proto.EnumField); ok {
+				existing = append(existing, value.Name)
+			}
+		}
+		if !slices.Equal(existing, values) {
+			return false, errors.Errorf("enum %s is already defined with the values %v", name, existing)
+		}
+		return true, nil
+	}
+
+	return false, errors.Errorf("%s already exists and doesn't define the enum %s", path, name)
+}
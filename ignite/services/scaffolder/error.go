@@ -0,0 +1,132 @@
+package scaffolder
+
+import (
+	"go/ast"
+	"os"
+	"path/filepath"
+	"strings"
+	"unicode"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/pkg/multiformatname"
+	"github.com/ignite/cli/v29/ignite/pkg/xast"
+	moduleerrors "github.com/ignite/cli/v29/ignite/templates/module/errors"
+)
+
+// errorOptions represents configuration for the error scaffolding.
+type errorOptions struct {
+	replaceIn string
+}
+
+// ErrorOption configures the error scaffolding.
+type ErrorOption func(*errorOptions)
+
+// ErrorWithReplaceIn replaces the generic invalid request errors returned
+// by a keeper handler with the scaffolded error.
+func ErrorWithReplaceIn(handler string) ErrorOption {
+	return func(o *errorOptions) {
+		o.replaceIn = handler
+	}
+}
+
+// AddError registers a new sentinel error in a module, using the next free error code.
+// if no module is given, the error will be registered inside the app's default module.
+func (s Scaffolder) AddError(moduleName, errorName, description string, options ...ErrorOption) error {
+	var o errorOptions
+	for _, apply := range options {
+		apply(&o)
+	}
+
+	// If no module is provided, we add the error to the app's module
+	if moduleName == "" {
+		moduleName = s.modpath.Package
+	}
+	mfName, err := multiformatname.NewName(moduleName, multiformatname.NoNumber)
+	if err != nil {
+		return err
+	}
+	moduleName = mfName.LowerCase
+
+	ok, err := moduleExists(s.appPath, moduleName)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errors.Errorf("the module %s doesn't exist", moduleName)
+	}
+
+	name, err := multiformatname.NewName(errorName)
+	if err != nil {
+		return err
+	}
+	if strings.TrimSpace(description) == "" {
+		return errors.New("the error description can't be empty")
+	}
+
+	opts := &moduleerrors.Options{
+		ModuleName:  moduleName,
+		ModulePath:  s.modpath.RawPath,
+		ErrorName:   errorVarName(name),
+		Description: description,
+	}
+
+	if _, err := os.Stat(filepath.Join(s.appPath, opts.ErrorsFile())); err != nil {
+		return errors.Errorf("the module %s doesn't have an errors file: %w", moduleName, err)
+	}
+
+	if o.replaceIn != "" {
+		file, err := findKeeperHandler(filepath.Join(s.appPath, moduleDir, moduleName, "keeper"), o.replaceIn)
+		if err != nil {
+			return err
+		}
+		if opts.ReplaceInFile, err = filepath.Rel(s.appPath, file); err != nil {
+			return err
+		}
+		opts.ReplaceInHandler = o.replaceIn
+	}
+
+	g, err := moduleerrors.NewGenerator(opts)
+	if err != nil {
+		return err
+	}
+
+	return s.Run(g)
+}
+
+// errorVarName returns the name of the error variable, prefixed with "Err".
+func errorVarName(name multiformatname.Name) string {
+	varName := name.PascalCase
+	if len(varName) > 3 && strings.HasPrefix(varName, "Err") && unicode.IsUpper(rune(varName[3])) {
+		return varName
+	}
+	return "Err" + varName
+}
+
+// findKeeperHandler returns the path of the keeper file declaring the handler.
+func findKeeperHandler(keeperDir, handler string) (string, error) {
+	pkg, _, err := xast.ParseDir(keeperDir)
+	if err != nil {
+		return "", err
+	}
+
+	var files []string
+	for fileName, f := range pkg.Files {
+		if strings.HasSuffix(fileName, "_test.go") {
+			continue
+		}
+		for _, decl := range f.Decls {
+			if funcDecl, ok := decl.(*ast.FuncDecl); ok && funcDecl.Name.Name == handler {
+				files = append(files, fileName)
+			}
+		}
+	}
+
+	switch len(files) {
+	case 0:
+		return "", errors.Errorf("handler %s not found in %s", handler, keeperDir)
+	case 1:
+		return files[0], nil
+	default:
+		return "", errors.Errorf("handler %s is declared more than once in %s", handler, keeperDir)
+	}
+}
@@ -0,0 +1,61 @@
+package scaffolder
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/v29/ignite/pkg/multiformatname"
+)
+
+func TestErrorVarName(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{name: "post-not-found", want: "ErrPostNotFound"},
+		{name: "err-post-not-found", want: "ErrPostNotFound"},
+		{name: "ErrPostNotFound", want: "ErrPostNotFound"},
+		{name: "errand", want: "ErrErrand"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			name, err := multiformatname.NewName(tc.name)
+			require.NoError(t, err)
+			require.Equal(t, tc.want, errorVarName(name))
+		})
+	}
+}
+
+func TestFindKeeperHandler(t *testing.T) {
+	dir := t.TempDir()
+	writeFile := func(name, content string) {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644))
+	}
+	writeFile("msg_server_post.go", `package keeper
+
+func (k msgServer) CreatePost() error { return nil }
+
+func (k msgServer) DeletePost() error { return nil }
+`)
+	writeFile("keeper.go", `package keeper
+
+func (k Keeper) DeletePost() error { return nil }
+`)
+	writeFile("msg_server_post_test.go", `package keeper
+
+func UpdatePost() {}
+`)
+
+	got, err := findKeeperHandler(dir, "CreatePost")
+	require.NoError(t, err)
+	require.Equal(t, filepath.Join(dir, "msg_server_post.go"), got)
+
+	_, err = findKeeperHandler(dir, "DeletePost")
+	require.ErrorContains(t, err, "declared more than once")
+
+	_, err = findKeeperHandler(dir, "UpdatePost")
+	require.ErrorContains(t, err, "not found")
+}
@@ -0,0 +1,76 @@
+package scaffolder
+
+import (
+	"context"
+
+	"github.com/ignite/cli/v29/ignite/pkg/multiformatname"
+	"github.com/ignite/cli/v29/ignite/templates/event"
+	"github.com/ignite/cli/v29/ignite/templates/field"
+)
+
+// AddEvent adds a new typed event to a scaffolded app.
+// The event is defined as a proto message named "Event<Name>" with a keeper helper to emit it.
+// if no module is given, the event will be scaffolded inside the app's default module.
+func (s Scaffolder) AddEvent(
+	ctx context.Context,
+	moduleName,
+	eventName string,
+	fields []string,
+) error {
+	// If no module is provided, we add the event to the app's module
+	if moduleName == "" {
+		moduleName = s.modpath.Package
+	}
+	mfName, err := multiformatname.NewName(moduleName, multiformatname.NoNumber)
+	if err != nil {
+		return err
+	}
+	moduleName = mfName.LowerCase
+
+	name, err := multiformatname.NewName(eventName)
+	if err != nil {
+		return err
+	}
+
+	if err := checkComponentValidity(s.appPath, moduleName, name, true); err != nil {
+		return err
+	}
+
+	eventTypeName, err := multiformatname.NewName("event-" + name.Kebab)
+	if err != nil {
+		return err
+	}
+	if err := checkTypeProtoCreated(ctx, s.appPath, s.modpath.Package, s.protoDir, moduleName, eventTypeName); err != nil {
+		return err
+	}
+
+	// Check and parse provided fields
+	if err := checkCustomTypes(ctx, s.appPath, s.modpath.Package, s.protoDir, moduleName, fields); err != nil {
+		return err
+	}
+	parsedFields, err := field.ParseFields(fields, checkGoReservedWord)
+	if err != nil {
+		return err
+	}
+	parsedFields = parsedFields.ScopeEnums(name)
+
+	g, err := event.NewGenerator(&event.Options{
+		AppName:    s.modpath.Package,
+		ProtoDir:   s.protoDir,
+		ProtoVer:   "v1", // TODO(@julienrbrt): possibly in the future add flag to specify custom proto version.
+		ModulePath: s.modpath.RawPath,
+		ModuleName: moduleName,
+		EventName:  name,
+		Fields:     parsedFields,
+	})
+	if err != nil {
+		return err
+	}
+
+	gens, err := s.enumGenerators(moduleName, parsedFields)
+	if err != nil {
+		return err
+	}
+
+	return s.Run(append(gens, g)...)
+}
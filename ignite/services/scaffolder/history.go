@@ -0,0 +1,347 @@
+package scaffolder
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/pkg/xgenny"
+)
+
+const (
+	// HistoryDir is the folder of an app where the scaffold operations are recorded.
+	HistoryDir = ".ignite/scaffold-history"
+
+	historyEntryFile = "entry.json"
+	historyFilesDir  = "files"
+)
+
+// ErrNoHistory is returned when there are not enough scaffold operations recorded to undo.
+var ErrNoHistory = errors.New("not enough scaffold operations in the history")
+
+// reGoPackage matches the go package option of a proto file.
+var reGoPackage = regexp.MustCompile(`(?m)^option\s+go_package\s*=\s*"([^";]+)(;[^"]*)?"`)
+
+// HistoryEntry is a scaffold operation recorded in the history of an app.
+type HistoryEntry struct {
+	// ID is the sequence number of the operation in the history.
+	ID int `json:"id"`
+
+	// Command is the command line of the operation.
+	Command string `json:"command"`
+
+	// Time is the time of the operation.
+	Time time.Time `json:"time"`
+
+	// Created are the files created by the operation, relative to the app path.
+	Created []string `json:"created,omitempty"`
+
+	// Modified are the files modified by the operation, relative to the app path.
+	// Their original content is saved with the entry.
+	Modified []string `json:"modified,omitempty"`
+
+	// Deleted are the files deleted by the operation, relative to the app path.
+	// Their original content is saved with the entry.
+	Deleted []string `json:"deleted,omitempty"`
+}
+
+// ChangesProto checks if the operation created, modified or deleted proto files.
+func (e HistoryEntry) ChangesProto() bool {
+	for _, file := range slices.Concat(e.Created, e.Modified, e.Deleted) {
+		if filepath.Ext(file) == ".proto" {
+			return true
+		}
+	}
+	return false
+}
+
+// History returns the scaffold operations recorded for the app, from the oldest to the latest.
+func (s Scaffolder) History() ([]HistoryEntry, error) {
+	dirs, err := os.ReadDir(s.historyPath())
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]HistoryEntry, 0, len(dirs))
+	for _, dir := range dirs {
+		if !dir.IsDir() {
+			continue
+		}
+		if _, err := strconv.Atoi(dir.Name()); err != nil {
+			continue
+		}
+
+		data, err := os.ReadFile(filepath.Join(s.historyPath(), dir.Name(), historyEntryFile))
+		if err != nil {
+			return nil, err
+		}
+
+		var entry HistoryEntry
+		if err := json.Unmarshal(data, &entry); err != nil {
+			return nil, errors.Errorf("invalid scaffold history entry %s: %w", dir.Name(), err)
+		}
+		entries = append(entries, entry)
+	}
+
+	sort.Slice(entries, func(i, j int) bool { return entries[i].ID < entries[j].ID })
+
+	return entries, nil
+}
+
+// Undo reverts the latest scaffold operations of the app, restoring the modified and deleted
+// files and removing the created ones. It returns the reverted operations, from the latest to the oldest.
+//
+// The code generated from the proto files is not part of the history, it has to be generated
+// again with PostScaffold when the reverted operations changed proto files.
+func (s Scaffolder) Undo(steps int) ([]HistoryEntry, error) {
+	if steps < 1 {
+		return nil, errors.Errorf("invalid number of steps to undo: %d", steps)
+	}
+
+	entries, err := s.History()
+	if err != nil {
+		return nil, err
+	}
+	if len(entries) < steps {
+		return nil, errors.Wrapf(ErrNoHistory, "%d operations to undo, %d recorded", steps, len(entries))
+	}
+
+	reverted := make([]HistoryEntry, 0, steps)
+	for i := len(entries) - 1; i >= len(entries)-steps; i-- {
+		if err := s.revertHistoryEntry(entries[i]); err != nil {
+			return reverted, errors.Errorf("cannot undo scaffold operation %d: %w", entries[i].ID, err)
+		}
+		reverted = append(reverted, entries[i])
+	}
+
+	return reverted, nil
+}
+
+// recordHistory records a scaffold operation before the files are written to the app.
+// Files that already exist are saved, so they can be restored by Undo.
+func (s Scaffolder) recordHistory(sm xgenny.SourceModification) error {
+	entries, err := s.History()
+	if err != nil {
+		return err
+	}
+
+	entry := HistoryEntry{
+		ID:      1,
+		Command: commandLine(),
+		Time:    time.Now().UTC(),
+	}
+	if len(entries) > 0 {
+		entry.ID = entries[len(entries)-1].ID + 1
+	}
+
+	entryPath := s.historyEntryPath(entry.ID)
+	for _, file := range slices.Concat(sm.CreatedFiles(), sm.ModifiedFiles(), sm.DeletedFiles()) {
+		relPath, ok := s.appRelPath(file)
+		if !ok {
+			// only the files of the app can be reverted.
+			continue
+		}
+
+		saved, err := s.saveOriginal(entryPath, relPath)
+		if err != nil {
+			return err
+		}
+		switch {
+		case slices.Contains(sm.DeletedFiles(), file):
+			if saved {
+				entry.Deleted = append(entry.Deleted, relPath)
+			}
+		case saved:
+			entry.Modified = append(entry.Modified, relPath)
+		default:
+			entry.Created = append(entry.Created, relPath)
+		}
+	}
+	if len(entry.Created) == 0 && len(entry.Modified) == 0 && len(entry.Deleted) == 0 {
+		return os.RemoveAll(entryPath)
+	}
+
+	sort.Strings(entry.Created)
+	sort.Strings(entry.Modified)
+	sort.Strings(entry.Deleted)
+
+	if err := os.MkdirAll(entryPath, 0o755); err != nil {
+		return err
+	}
+
+	// the history is local to the workspace, it must not be committed with the app.
+	gitignore := filepath.Join(s.historyPath(), ".gitignore")
+	if _, err := os.Stat(gitignore); os.IsNotExist(err) {
+		if err := os.WriteFile(gitignore, []byte("*\n"), 0o644); err != nil {
+			return err
+		}
+	}
+
+	data, err := json.MarshalIndent(entry, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(entryPath, historyEntryFile), data, 0o644)
+}
+
+// saveOriginal saves the content of an app file in the history entry, if the file exists.
+func (s Scaffolder) saveOriginal(entryPath, relPath string) (bool, error) {
+	path := filepath.Join(s.appPath, relPath)
+	info, err := os.Stat(path)
+	if os.IsNotExist(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return false, err
+	}
+	original := filepath.Join(entryPath, historyFilesDir, relPath)
+	if err := os.MkdirAll(filepath.Dir(original), 0o755); err != nil {
+		return false, err
+	}
+	return true, os.WriteFile(original, content, info.Mode().Perm())
+}
+
+// appRelPath returns the path of a file relative to the app path, if the file is inside the app.
+func (s Scaffolder) appRelPath(file string) (string, bool) {
+	if !filepath.IsAbs(file) {
+		file = filepath.Join(s.appPath, file)
+	}
+	relPath, err := filepath.Rel(s.appPath, file)
+	if err != nil || strings.HasPrefix(relPath, "..") {
+		return "", false
+	}
+	return relPath, true
+}
+
+// revertHistoryEntry restores the files modified and deleted by the operation, removes the files
+// it created and removes the operation from the history.
+func (s Scaffolder) revertHistoryEntry(entry HistoryEntry) error {
+	entryPath := s.historyEntryPath(entry.ID)
+
+	for _, file := range slices.Concat(entry.Modified, entry.Deleted) {
+		original := filepath.Join(entryPath, historyFilesDir, file)
+		info, err := os.Stat(original)
+		if err != nil {
+			return err
+		}
+		content, err := os.ReadFile(original)
+		if err != nil {
+			return err
+		}
+
+		path := filepath.Join(s.appPath, file)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			return err
+		}
+		if err := os.WriteFile(path, content, info.Mode().Perm()); err != nil {
+			return err
+		}
+	}
+
+	for _, file := range entry.Created {
+		path := filepath.Join(s.appPath, file)
+
+		// the code generated from a created proto file isn't regenerated once the proto file removed.
+		if filepath.Ext(file) == ".proto" {
+			if err := s.removeGeneratedGoFiles(path); err != nil {
+				return err
+			}
+		}
+
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		s.removeEmptyDirs(filepath.Dir(path))
+	}
+
+	return os.RemoveAll(entryPath)
+}
+
+// removeGeneratedGoFiles removes the Go files generated from a proto file of the app.
+func (s Scaffolder) removeGeneratedGoFiles(protoFile string) error {
+	files, err := s.generatedGoFiles(protoFile)
+	if err != nil {
+		return err
+	}
+	for _, file := range files {
+		if err := os.Remove(file); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// generatedGoFiles returns the existing Go files generated from a proto file of the app.
+func (s Scaffolder) generatedGoFiles(protoFile string) ([]string, error) {
+	content, err := os.ReadFile(protoFile)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	match := reGoPackage.FindSubmatch(content)
+	if match == nil {
+		return nil, nil
+	}
+	goPackage := string(match[1])
+	if goPackage != s.modpath.RawPath && !strings.HasPrefix(goPackage, s.modpath.RawPath+"/") {
+		return nil, nil
+	}
+
+	var (
+		dir   = filepath.Join(s.appPath, filepath.FromSlash(strings.TrimPrefix(goPackage, s.modpath.RawPath)))
+		name  = strings.TrimSuffix(filepath.Base(protoFile), ".proto")
+		files []string
+	)
+	for _, file := range []string{name + ".pb.go", name + ".pb.gw.go"} {
+		path := filepath.Join(dir, file)
+		if _, err := os.Stat(path); err == nil {
+			files = append(files, path)
+		}
+	}
+	return files, nil
+}
+
+// removeEmptyDirs removes the directory and its parents while they are empty, up to the app path.
+func (s Scaffolder) removeEmptyDirs(dir string) {
+	for dir != s.appPath && strings.HasPrefix(dir, s.appPath) {
+		if err := os.Remove(dir); err != nil {
+			return
+		}
+		dir = filepath.Dir(dir)
+	}
+}
+
+func (s Scaffolder) historyPath() string {
+	return filepath.Join(s.appPath, HistoryDir)
+}
+
+func (s Scaffolder) historyEntryPath(id int) string {
+	return filepath.Join(s.historyPath(), fmt.Sprintf("%06d", id))
+}
+
+// commandLine returns the command line of the running process.
+func commandLine() string {
+	if len(os.Args) == 0 {
+		return ""
+	}
+	return strings.Join(append([]string{filepath.Base(os.Args[0])}, os.Args[1:]...), " ")
+}
@@ -0,0 +1,105 @@
+package scaffolder
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gobuffalo/genny/v2"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/v29/ignite/pkg/gomodulepath"
+	"github.com/ignite/cli/v29/ignite/pkg/xgenny"
+)
+
+func newHistoryScaffolder(t *testing.T) Scaffolder {
+	t.Helper()
+	appPath := t.TempDir()
+	return Scaffolder{
+		appPath: appPath,
+		modpath: gomodulepath.Path{RawPath: "github.com/test/blog"},
+		runner:  xgenny.NewRunner(context.Background(), appPath),
+	}
+}
+
+func scaffoldFiles(t *testing.T, s Scaffolder, files map[string]string) {
+	t.Helper()
+	g := genny.New()
+	g.RunFn(func(r *genny.Runner) error {
+		for name, content := range files {
+			if err := r.File(genny.NewFileS(filepath.Join(s.appPath, name), content)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	require.NoError(t, s.Run(g))
+	_, err := s.ApplyModifications()
+	require.NoError(t, err)
+}
+
+func TestHistoryUndo(t *testing.T) {
+	s := newHistoryScaffolder(t)
+
+	var (
+		appFile   = filepath.Join(s.appPath, "app", "app.go")
+		protoFile = filepath.Join(s.appPath, "proto", "blog", "blog", "v1", "post.proto")
+		pbFile    = filepath.Join(s.appPath, "x", "blog", "types", "post.pb.go")
+	)
+	require.NoError(t, os.MkdirAll(filepath.Dir(appFile), 0o755))
+	require.NoError(t, os.WriteFile(appFile, []byte("package app\n"), 0o644))
+
+	scaffoldFiles(t, s, map[string]string{
+		"app/app.go": "package app\n\n// first\n",
+		"proto/blog/blog/v1/post.proto": `syntax = "proto3";
+package blog.blog.v1;
+
+option go_package = "github.com/test/blog/x/blog/types";
+`,
+	})
+	// simulate the code generated from the proto file.
+	require.NoError(t, os.MkdirAll(filepath.Dir(pbFile), 0o755))
+	require.NoError(t, os.WriteFile(pbFile, []byte("package types\n"), 0o644))
+
+	scaffoldFiles(t, s, map[string]string{
+		"app/app.go": "package app\n\n// second\n",
+	})
+
+	entries, err := s.History()
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	require.Equal(t, 1, entries[0].ID)
+	require.Equal(t, []string{"proto/blog/blog/v1/post.proto"}, entries[0].Created)
+	require.Equal(t, []string{"app/app.go"}, entries[0].Modified)
+	require.True(t, entries[0].ChangesProto())
+	require.Equal(t, 2, entries[1].ID)
+	require.Empty(t, entries[1].Created)
+	require.False(t, entries[1].ChangesProto())
+	require.FileExists(t, filepath.Join(s.historyPath(), ".gitignore"))
+
+	reverted, err := s.Undo(1)
+	require.NoError(t, err)
+	require.Len(t, reverted, 1)
+	require.Equal(t, 2, reverted[0].ID)
+	content, err := os.ReadFile(appFile)
+	require.NoError(t, err)
+	require.Equal(t, "package app\n\n// first\n", string(content))
+
+	_, err = s.Undo(2)
+	require.ErrorIs(t, err, ErrNoHistory)
+
+	reverted, err = s.Undo(1)
+	require.NoError(t, err)
+	require.Equal(t, 1, reverted[0].ID)
+	content, err = os.ReadFile(appFile)
+	require.NoError(t, err)
+	require.Equal(t, "package app\n", string(content))
+	require.NoFileExists(t, protoFile)
+	require.NoFileExists(t, pbFile)
+	require.NoDirExists(t, filepath.Join(s.appPath, "proto"))
+
+	entries, err = s.History()
+	require.NoError(t, err)
+	require.Empty(t, entries)
+}
@@ -0,0 +1,49 @@
+package scaffolder
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/pkg/multiformatname"
+	modulehooks "github.com/ignite/cli/v29/ignite/templates/module/hooks"
+)
+
+// CreateHooks scaffolds the hooks of an existing module, that other modules can subscribe to.
+func (s Scaffolder) CreateHooks(moduleName string) error {
+	if moduleName == "" {
+		moduleName = s.modpath.Package
+	}
+
+	mfModuleName, err := multiformatname.NewName(moduleName, multiformatname.NoNumber)
+	if err != nil {
+		return err
+	}
+	moduleName = mfModuleName.LowerCase
+
+	ok, err := moduleExists(s.appPath, moduleName)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errors.Errorf("the module %s doesn't exist", moduleName)
+	}
+
+	opts := &modulehooks.Options{
+		ModuleName: moduleName,
+		ModulePath: s.modpath.RawPath,
+	}
+
+	if _, err := os.Stat(filepath.Join(s.appPath, opts.HooksFile())); err == nil {
+		return errors.Errorf("hooks already exist for module %s", moduleName)
+	} else if !os.IsNotExist(err) {
+		return err
+	}
+
+	g, err := modulehooks.NewGenerator(opts)
+	if err != nil {
+		return err
+	}
+
+	return s.Run(g)
+}
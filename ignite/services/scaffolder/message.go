@@ -101,6 +101,7 @@ func (s Scaffolder) AddMessage(
 	if err != nil {
 		return err
 	}
+	parsedMsgFields = parsedMsgFields.ScopeEnums(name)
 
 	// Check and parse provided response fields
 	if err := checkCustomTypes(
@@ -117,6 +118,7 @@ func (s Scaffolder) AddMessage(
 	if err != nil {
 		return err
 	}
+	parsedResFields = parsedResFields.ScopeEnums(name)
 
 	mfSigner, err := multiformatname.NewName(scaffoldingOpts.signer)
 	if err != nil {
@@ -146,7 +148,12 @@ func (s Scaffolder) AddMessage(
 		return err
 	}
 
-	return s.Run(g)
+	gens, err := s.enumGenerators(moduleName, parsedMsgFields, parsedResFields)
+	if err != nil {
+		return err
+	}
+
+	return s.Run(append(gens, g)...)
 }
 
 // checkForbiddenMessageField returns true if the name is forbidden as a message name.
@@ -105,6 +105,7 @@ func (s Scaffolder) AddPacket(
 	if err != nil {
 		return err
 	}
+	parsedPacketFields = parsedPacketFields.ScopeEnums(name)
 
 	// check and parse acknowledgment fields
 	if err := checkCustomTypes(ctx, s.appPath, s.modpath.Package, s.protoDir, moduleName, ackFields); err != nil {
@@ -114,6 +115,7 @@ func (s Scaffolder) AddPacket(
 	if err != nil {
 		return err
 	}
+	parsedAcksFields = parsedAcksFields.ScopeEnums(name)
 
 	// Generate the packet
 	var (
@@ -135,7 +137,12 @@ func (s Scaffolder) AddPacket(
 	if err != nil {
 		return err
 	}
-	return s.Run(g)
+
+	gens, err := s.enumGenerators(moduleName, parsedPacketFields, parsedAcksFields)
+	if err != nil {
+		return err
+	}
+	return s.Run(append(gens, g)...)
 }
 
 // isIBCModule returns true if the provided module implements the IBC module interface
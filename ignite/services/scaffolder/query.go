@@ -48,6 +48,7 @@ func (s Scaffolder) AddQuery(
 	if err != nil {
 		return err
 	}
+	parsedReqFields = parsedReqFields.ScopeEnums(name)
 
 	// Check and parse provided response fields
 	if err := checkCustomTypes(ctx, s.appPath, s.modpath.Package, s.protoDir, moduleName, resFields); err != nil {
@@ -57,6 +58,7 @@ func (s Scaffolder) AddQuery(
 	if err != nil {
 		return err
 	}
+	parsedResFields = parsedResFields.ScopeEnums(name)
 
 	var (
 		g    *genny.Generator
@@ -80,5 +82,10 @@ func (s Scaffolder) AddQuery(
 		return err
 	}
 
-	return s.Run(g)
+	gens, err := s.enumGenerators(moduleName, parsedReqFields, parsedResFields)
+	if err != nil {
+		return err
+	}
+
+	return s.Run(append(gens, g)...)
 }
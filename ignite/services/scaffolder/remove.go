@@ -0,0 +1,292 @@
+package scaffolder
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/pkg/multiformatname"
+	"github.com/ignite/cli/v29/ignite/pkg/protoanalysis"
+	"github.com/ignite/cli/v29/ignite/templates/remove"
+)
+
+const (
+	msgServiceName   = "Msg"
+	queryServiceName = "Query"
+)
+
+// RemoveType removes a type scaffolded with the list, map, single or type commands from a module.
+func (s Scaffolder) RemoveType(ctx context.Context, moduleName, typeName string) error {
+	return s.removeComponent(ctx, remove.KindType, moduleName, typeName)
+}
+
+// RemoveMessage removes a message scaffolded with the message command from a module.
+func (s Scaffolder) RemoveMessage(ctx context.Context, moduleName, msgName string) error {
+	return s.removeComponent(ctx, remove.KindMessage, moduleName, msgName)
+}
+
+// RemoveQuery removes a query scaffolded with the query command from a module.
+func (s Scaffolder) RemoveQuery(ctx context.Context, moduleName, queryName string) error {
+	return s.removeComponent(ctx, remove.KindQuery, moduleName, queryName)
+}
+
+// removeComponent removes the proto definitions of a component from the module proto files,
+// the files scaffolded for the component and the code wiring it into the module.
+func (s Scaffolder) removeComponent(ctx context.Context, kind remove.Kind, moduleName, componentName string) error {
+	if moduleName == "" {
+		moduleName = s.modpath.Package
+	}
+	mfModuleName, err := multiformatname.NewName(moduleName, multiformatname.NoNumber)
+	if err != nil {
+		return err
+	}
+	moduleName = mfModuleName.LowerCase
+
+	name, err := multiformatname.NewName(componentName)
+	if err != nil {
+		return err
+	}
+
+	ok, err := moduleExists(s.appPath, moduleName)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errors.Errorf("the module %s doesn't exist", moduleName)
+	}
+
+	protoPath := filepath.Join(s.appPath, s.protoDir, s.modpath.Package, moduleName)
+	pkgs, err := protoanalysis.Parse(ctx, protoanalysis.NewCache(), protoPath)
+	if err != nil {
+		return err
+	}
+
+	opts := &remove.Options{
+		ModuleName: moduleName,
+		Kind:       kind,
+		Name:       name,
+	}
+
+	var (
+		typeName string
+		deleted  []string
+	)
+	switch kind {
+	case remove.KindType:
+		msg, ok := findProtoMessage(pkgs, name.PascalCase)
+		if !ok {
+			return errors.Errorf("the type %s doesn't exist in module %s", name.Original, moduleName)
+		}
+		typeName = msg.Name
+
+		// the messages scaffolded with the type, like its typed events.
+		typeMessages := []string{msg.Name}
+		for _, event := range []string{"Created", "Updated", "Deleted"} {
+			if eventMsg, ok := findProtoMessage(pkgs, "Event"+msg.Name+event); ok && eventMsg.Path == msg.Path {
+				typeMessages = append(typeMessages, eventMsg.Name)
+			}
+		}
+
+		// the proto file scaffolded for the type is removed, unless other messages are defined in it.
+		if filepath.Base(msg.Path) == name.Snake+".proto" && onlyFileMessages(pkgs, msg.Path, typeMessages) {
+			importPath, err := filepath.Rel(filepath.Join(s.appPath, s.protoDir), msg.Path)
+			if err != nil {
+				return err
+			}
+			generated, err := s.generatedGoFiles(msg.Path)
+			if err != nil {
+				return err
+			}
+			opts.ProtoImport = filepath.ToSlash(importPath)
+			deleted = append(deleted, msg.Path)
+			deleted = append(deleted, generated...)
+		} else {
+			opts.Messages = append(opts.Messages, typeMessages...)
+		}
+
+		opts.RPCs = findRPCs(pkgs, msgServiceName, func(rpc string) bool {
+			return slices.Contains([]string{"Create" + msg.Name, "Update" + msg.Name, "Delete" + msg.Name}, rpc)
+		})
+		opts.RPCs = append(opts.RPCs, findRPCs(pkgs, queryServiceName, func(rpc string) bool {
+			return rpc == "Get"+msg.Name || rpc == "List"+msg.Name ||
+				strings.HasPrefix(rpc, "Get"+msg.Name+"By") || strings.HasPrefix(rpc, "List"+msg.Name+"By")
+		})...)
+	case remove.KindMessage:
+		opts.RPCs = findRPCs(pkgs, msgServiceName, func(rpc string) bool { return rpc == name.PascalCase })
+		if len(opts.RPCs) == 0 {
+			return errors.Errorf("the message %s doesn't exist in module %s", name.Original, moduleName)
+		}
+	case remove.KindQuery:
+		opts.RPCs = findRPCs(pkgs, queryServiceName, func(rpc string) bool { return rpc == name.PascalCase })
+		if len(opts.RPCs) == 0 {
+			return errors.Errorf("the query %s doesn't exist in module %s", name.Original, moduleName)
+		}
+	}
+
+	opts.Messages = append(opts.Messages, unusedRPCMessages(pkgs, opts.RPCs)...)
+
+	if typeName != "" {
+		if err := checkTypeUnused(pkgs, typeName, opts.Messages); err != nil {
+			return err
+		}
+	}
+
+	for _, file := range pkgs.Files().Paths() {
+		if slices.Contains(deleted, file) {
+			continue
+		}
+		relPath, err := filepath.Rel(s.appPath, file)
+		if err != nil {
+			return err
+		}
+		opts.ProtoFiles = append(opts.ProtoFiles, relPath)
+	}
+	opts.GoFiles = s.existingFiles(opts.WiringFiles()...)
+	deleted = append(deleted, s.existingFiles(opts.ComponentFiles()...)...)
+
+	g, err := remove.NewGenerator(opts)
+	if err != nil {
+		return err
+	}
+
+	s.runner.Delete(deleted...)
+	return s.Run(g)
+}
+
+// existingFiles returns the files existing in the app, relative to the app path.
+func (s Scaffolder) existingFiles(files ...string) (existing []string) {
+	for _, file := range files {
+		if _, err := os.Stat(filepath.Join(s.appPath, file)); err == nil {
+			existing = append(existing, file)
+		}
+	}
+	return existing
+}
+
+// findProtoMessage returns the proto message with the given name, ignoring the case.
+func findProtoMessage(pkgs protoanalysis.Packages, name string) (protoanalysis.Message, bool) {
+	for _, pkg := range pkgs {
+		for _, msg := range pkg.Messages {
+			if strings.EqualFold(msg.Name, name) {
+				return msg, true
+			}
+		}
+	}
+	return protoanalysis.Message{}, false
+}
+
+// onlyFileMessages checks if the messages are the only ones defined in a proto file.
+func onlyFileMessages(pkgs protoanalysis.Packages, path string, messages []string) bool {
+	for _, pkg := range pkgs {
+		for _, msg := range pkg.Messages {
+			if msg.Path == path && !slices.Contains(messages, msg.Name) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// findRPCs returns the names of the RPCs of a service matching the filter.
+func findRPCs(pkgs protoanalysis.Packages, service string, filter func(string) bool) (rpcs []string) {
+	for _, pkg := range pkgs {
+		for _, srv := range pkg.Services {
+			if srv.Name != service {
+				continue
+			}
+			for _, rpc := range srv.RPCFuncs {
+				if filter(rpc.Name) {
+					rpcs = append(rpcs, rpc.Name)
+				}
+			}
+		}
+	}
+	return rpcs
+}
+
+// unusedRPCMessages returns the request and response messages of the removed RPCs,
+// that are not used by the other RPCs and messages of the module.
+func unusedRPCMessages(pkgs protoanalysis.Packages, removedRPCs []string) (messages []string) {
+	var candidates []string
+	used := make(map[string]bool)
+	for _, pkg := range pkgs {
+		for _, srv := range pkg.Services {
+			for _, rpc := range srv.RPCFuncs {
+				types := []string{protoTypeName(rpc.RequestType), protoTypeName(rpc.ReturnsType)}
+				if slices.Contains(removedRPCs, rpc.Name) {
+					candidates = append(candidates, types...)
+					continue
+				}
+				for _, t := range types {
+					used[t] = true
+				}
+			}
+		}
+	}
+
+	for _, candidate := range candidates {
+		if used[candidate] || slices.Contains(messages, candidate) {
+			continue
+		}
+		if _, ok := findProtoMessage(pkgs, candidate); !ok {
+			continue
+		}
+		if usedByMessages(pkgs, candidate, candidates) {
+			continue
+		}
+		messages = append(messages, candidate)
+	}
+	return messages
+}
+
+// checkTypeUnused checks that the type isn't used by the messages of the module that are not removed with it.
+// The genesis state fields of the type are removed with the type.
+func checkTypeUnused(pkgs protoanalysis.Packages, typeName string, removedMessages []string) error {
+	for _, pkg := range pkgs {
+		for _, msg := range pkg.Messages {
+			if msg.Name == typeName || msg.Name == "GenesisState" || slices.Contains(removedMessages, msg.Name) {
+				continue
+			}
+			for field, fieldType := range msg.Fields {
+				if protoTypeName(fieldType) == typeName {
+					return errors.Errorf(
+						"the type %s is used by the field %s of the message %s, remove it first",
+						typeName,
+						field,
+						msg.Name,
+					)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// usedByMessages checks if a message is used by the fields of the module messages, except the excluded ones.
+func usedByMessages(pkgs protoanalysis.Packages, name string, excluded []string) bool {
+	for _, pkg := range pkgs {
+		for _, msg := range pkg.Messages {
+			if slices.Contains(excluded, msg.Name) {
+				continue
+			}
+			for _, fieldType := range msg.Fields {
+				if protoTypeName(fieldType) == name {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// protoTypeName returns the name of a proto type without its package and its label.
+func protoTypeName(protoType string) string {
+	protoType = strings.TrimPrefix(protoType, "repeated ")
+	if i := strings.LastIndex(protoType, "."); i >= 0 {
+		protoType = protoType[i+1:]
+	}
+	return protoType
+}
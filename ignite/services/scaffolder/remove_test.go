@@ -0,0 +1,29 @@
+package scaffolder
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/v29/ignite/pkg/protoanalysis"
+)
+
+func TestOnlyFileMessages(t *testing.T) {
+	pkgs := protoanalysis.Packages{
+		{
+			Messages: []protoanalysis.Message{
+				{Name: "Post", Path: "post.proto"},
+				{Name: "EventPostCreated", Path: "post.proto"},
+				{Name: "EventPostUpdated", Path: "post.proto"},
+				{Name: "EventPostDeleted", Path: "post.proto"},
+				{Name: "Comment", Path: "comment.proto"},
+				{Name: "Reply", Path: "comment.proto"},
+			},
+		},
+	}
+
+	events := []string{"Post", "EventPostCreated", "EventPostUpdated", "EventPostDeleted"}
+	require.True(t, onlyFileMessages(pkgs, "post.proto", events))
+	require.False(t, onlyFileMessages(pkgs, "post.proto", []string{"Post"}))
+	require.False(t, onlyFileMessages(pkgs, "comment.proto", []string{"Comment"}))
+}
@@ -77,7 +77,10 @@ func New(context context.Context, appPath, protoDir string) (Scaffolder, error)
 	return s, nil
 }
 
+// ApplyModifications writes the scaffolded files to the app and records the operation
+// in the app history, so it can be reverted with Undo.
 func (s Scaffolder) ApplyModifications(options ...xgenny.ApplyOption) (xgenny.SourceModification, error) {
+	options = append(options, xgenny.ApplyPreCopy(s.recordHistory))
 	return s.runner.ApplyModifications(options...)
 }
 
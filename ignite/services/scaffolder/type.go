@@ -17,7 +17,15 @@ import (
 	"github.com/ignite/cli/v29/ignite/templates/typed/singleton"
 )
 
-const maxLength = 64
+const (
+	maxLength = 64
+
+	// maxMapIndexes is the maximum number of fields composing a map index.
+	maxMapIndexes = 3
+
+	// uniqueIndexSuffix is the suffix of a secondary index enforcing unique values.
+	uniqueIndexSuffix = ":unique"
+)
 
 // AddTypeOption configures options for AddType.
 type AddTypeOption func(*addTypeOptions)
@@ -33,10 +41,12 @@ type addTypeOptions struct {
 	isMap       bool
 	isSingleton bool
 
-	index string
+	index            string
+	secondaryIndexes []string
 
 	withoutMessage    bool
 	withoutSimulation bool
+	withEvents        bool
 	signer            string
 }
 
@@ -93,6 +103,15 @@ func TypeWithFields(fields ...string) AddTypeOption {
 	}
 }
 
+// TypeWithSecondaryIndexes adds secondary indexes to the fields of a list or map type.
+// An index is declared by the field name, with the ":unique" suffix to enforce the
+// uniqueness of the field values (e.g. "owner", "email:unique").
+func TypeWithSecondaryIndexes(indexes ...string) AddTypeOption {
+	return func(o *addTypeOptions) {
+		o.secondaryIndexes = indexes
+	}
+}
+
 // TypeWithoutMessage disables generating sdk compatible messages and tx related APIs.
 func TypeWithoutMessage() AddTypeOption {
 	return func(o *addTypeOptions) {
@@ -107,6 +126,13 @@ func TypeWithoutSimulation() AddTypeOption {
 	}
 }
 
+// TypeWithEvents emits typed events from the create, update and delete message handlers.
+func TypeWithEvents() AddTypeOption {
+	return func(o *addTypeOptions) {
+		o.withEvents = true
+	}
+}
+
 // TypeWithSigner provides a custom signer name for the message.
 func TypeWithSigner(signer string) AddTypeOption {
 	return func(o *addTypeOptions) {
@@ -156,6 +182,7 @@ func (s Scaffolder) AddType(
 	if err != nil {
 		return err
 	}
+	tFields = tFields.ScopeEnums(name)
 
 	mfSigner, err := multiformatname.NewName(o.signer)
 	if err != nil {
@@ -179,12 +206,36 @@ func (s Scaffolder) AddType(
 			Fields:       tFields,
 			NoMessage:    o.withoutMessage,
 			NoSimulation: o.withoutSimulation,
+			Events:       o.withEvents,
 			MsgSigner:    mfSigner,
 			IsIBC:        isIBC,
 		}
 		gens []*genny.Generator
 	)
 
+	if len(o.secondaryIndexes) > 0 {
+		if !o.isList && !o.isMap {
+			return errors.New("secondary indexes can only be added to list and map types")
+		}
+		if opts.SecondaryIndexes, err = parseSecondaryIndexes(opts, o.secondaryIndexes); err != nil {
+			return err
+		}
+	}
+
+	if o.withEvents {
+		if !o.isList && !o.isMap && !o.isSingleton {
+			return errors.New("events can only be emitted by list, map and single types")
+		}
+		if o.withoutMessage {
+			return errors.New("events are emitted by the message handlers and can't be used without messages")
+		}
+	}
+
+	// scaffold the enums declared by the fields
+	if gens, err = s.enumGenerators(moduleName, tFields); err != nil {
+		return err
+	}
+
 	// create the type generator depending on the model
 	switch {
 	case o.isList:
@@ -260,11 +311,12 @@ func parseTypeFields(opts addTypeOptions) (field.Fields, error) {
 // mapGenerator returns the template generator for a map.
 func mapGenerator(opts *typed.Options, index string) (*genny.Generator, error) {
 	// Parse indexes with the associated type
-	if strings.Contains(index, ",") {
-		return nil, errors.Errorf("multi-index map isn't supported")
+	indexes := strings.Split(index, ",")
+	if len(indexes) > maxMapIndexes {
+		return nil, errors.Errorf("a map index can't be composed by more than %d fields", maxMapIndexes)
 	}
 
-	parsedIndexes, err := field.ParseFields([]string{index}, checkForbiddenTypeIndex)
+	parsedIndexes, err := field.ParseFields(indexes, checkForbiddenTypeIndex)
 	if err != nil {
 		return nil, err
 	}
@@ -279,10 +331,64 @@ func mapGenerator(opts *typed.Options, index string) (*genny.Generator, error) {
 		exists[name.Name.LowerCamel] = struct{}{}
 	}
 
-	if _, ok := exists[parsedIndexes[0].Name.LowerCamel]; ok {
-		return nil, errors.Errorf("%s cannot simultaneously be an index and a field", parsedIndexes[0].Name.Original)
+	for _, parsedIndex := range parsedIndexes {
+		if dt, ok := datatype.IsSupportedType(parsedIndex.DatatypeName); !ok || dt.NonIndex {
+			return nil, errors.Errorf("invalid index type %s", parsedIndex.DatatypeName)
+		}
+		if _, ok := exists[parsedIndex.Name.LowerCamel]; ok {
+			return nil, errors.Errorf("%s cannot simultaneously be an index and a field", parsedIndex.Name.Original)
+		}
 	}
 
-	opts.Index = parsedIndexes[0]
+	opts.Indexes = parsedIndexes
 	return maptype.NewGenerator(opts)
 }
+
+// parseSecondaryIndexes resolves the secondary indexes against the fields of the type.
+// A secondary index can reference a field of the type or its message signer.
+func parseSecondaryIndexes(opts *typed.Options, secondaryIndexes []string) ([]typed.SecondaryIndex, error) {
+	fields := make(map[string]field.Field)
+	for _, f := range opts.Fields {
+		fields[f.Name.LowerCamel] = f
+	}
+	if !opts.NoMessage {
+		fields[opts.MsgSigner.LowerCamel] = field.Field{
+			Name:         opts.MsgSigner,
+			DatatypeName: datatype.String,
+		}
+	}
+
+	var (
+		parsed = make([]typed.SecondaryIndex, 0, len(secondaryIndexes))
+		exists = make(map[string]struct{})
+	)
+	for _, secondaryIndex := range secondaryIndexes {
+		name, unique := strings.CutSuffix(secondaryIndex, uniqueIndexSuffix)
+		mfName, err := multiformatname.NewName(name)
+		if err != nil {
+			return nil, err
+		}
+
+		f, ok := fields[mfName.LowerCamel]
+		if !ok {
+			return nil, errors.Errorf("secondary index %s is not a field of the type", name)
+		}
+		if _, ok := exists[mfName.LowerCamel]; ok {
+			return nil, errors.Errorf("the secondary index %s is duplicated", name)
+		}
+		exists[mfName.LowerCamel] = struct{}{}
+
+		if dt, ok := datatype.IsSupportedType(f.DatatypeName); !ok || dt.NonIndex {
+			return nil, errors.Errorf("invalid secondary index type %s for %s", f.DatatypeName, name)
+		}
+		if unique && f.DatatypeName == datatype.Bool {
+			return nil, errors.Errorf("secondary index %s can't be unique with a bool type", name)
+		}
+		if unique && !opts.NoMessage && mfName.LowerCamel == opts.MsgSigner.LowerCamel {
+			return nil, errors.Errorf("secondary index %s can't be unique because it is the message signer", name)
+		}
+
+		parsed = append(parsed, typed.SecondaryIndex{Field: f, Unique: unique})
+	}
+	return parsed, nil
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/ignite/cli/v29/ignite/pkg/randstr"
 	"github.com/ignite/cli/v29/ignite/templates/field"
 	"github.com/ignite/cli/v29/ignite/templates/field/datatype"
+	"github.com/ignite/cli/v29/ignite/templates/typed"
 )
 
 func TestParseTypeFields(t *testing.T) {
@@ -232,6 +233,165 @@ func TestCheckForbiddenTypeIndexField(t *testing.T) {
 	}
 }
 
+func TestMapGeneratorIndexes(t *testing.T) {
+	fields, err := field.ParseFields([]string{"amount:uint"}, checkForbiddenTypeField)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name            string
+		index           string
+		expectedIndexes []string
+		shouldError     bool
+	}{
+		{
+			name:            "single index",
+			index:           "denom",
+			expectedIndexes: []string{"denom"},
+		},
+		{
+			name:            "pair index",
+			index:           "owner:address,denom:string",
+			expectedIndexes: []string{"owner", "denom"},
+		},
+		{
+			name:            "triple index",
+			index:           "owner:address,denom,nonce:uint",
+			expectedIndexes: []string{"owner", "denom", "nonce"},
+		},
+		{
+			name:        "should fail with more than three indexes",
+			index:       "a,b,c,d",
+			shouldError: true,
+		},
+		{
+			name:        "should fail with duplicated indexes",
+			index:       "owner,owner",
+			shouldError: true,
+		},
+		{
+			name:        "should fail with index used as field",
+			index:       "owner,amount",
+			shouldError: true,
+		},
+		{
+			name:        "should fail with non index type",
+			index:       "owner,price:coin",
+			shouldError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			typeName, err := multiformatname.NewName("balance")
+			require.NoError(t, err)
+			opts := &typed.Options{
+				AppName:    "blog",
+				ProtoDir:   "proto",
+				ProtoVer:   "v1",
+				ModuleName: "bank",
+				ModulePath: "github.com/test/blog",
+				TypeName:   typeName,
+				Fields:     fields,
+			}
+			_, err = mapGenerator(opts, tc.index)
+			if tc.shouldError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			indexes := make([]string, len(opts.Indexes))
+			for i, index := range opts.Indexes {
+				indexes[i] = index.Name.LowerCamel
+			}
+			require.Equal(t, tc.expectedIndexes, indexes)
+		})
+	}
+}
+
+func TestParseSecondaryIndexes(t *testing.T) {
+	fields, err := field.ParseFields(
+		[]string{"title", "email", "score:uint", "published:bool", "tags:array.string"},
+		checkForbiddenTypeField,
+	)
+	require.NoError(t, err)
+	signer, err := multiformatname.NewName("creator")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name             string
+		secondaryIndexes []string
+		noMessage        bool
+		expected         []typed.SecondaryIndex
+		shouldError      bool
+	}{
+		{
+			name:             "multi and unique indexes",
+			secondaryIndexes: []string{"title", "email:unique", "score"},
+			expected: []typed.SecondaryIndex{
+				{Field: fields[0]},
+				{Field: fields[1], Unique: true},
+				{Field: fields[2]},
+			},
+		},
+		{
+			name:             "signer index",
+			secondaryIndexes: []string{"creator"},
+			expected: []typed.SecondaryIndex{
+				{Field: field.Field{Name: signer, DatatypeName: datatype.String}},
+			},
+		},
+		{
+			name:             "should fail with unknown field",
+			secondaryIndexes: []string{"owner"},
+			shouldError:      true,
+		},
+		{
+			name:             "should fail with signer index without message",
+			secondaryIndexes: []string{"creator"},
+			noMessage:        true,
+			shouldError:      true,
+		},
+		{
+			name:             "should fail with duplicated index",
+			secondaryIndexes: []string{"title", "title:unique"},
+			shouldError:      true,
+		},
+		{
+			name:             "should fail with non index type",
+			secondaryIndexes: []string{"tags"},
+			shouldError:      true,
+		},
+		{
+			name:             "should fail with unique bool",
+			secondaryIndexes: []string{"published:unique"},
+			shouldError:      true,
+		},
+		{
+			name:             "should fail with unique signer",
+			secondaryIndexes: []string{"creator:unique"},
+			shouldError:      true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			opts := &typed.Options{
+				Fields:    fields,
+				MsgSigner: signer,
+				NoMessage: tc.noMessage,
+			}
+			got, err := parseSecondaryIndexes(opts, tc.secondaryIndexes)
+			if tc.shouldError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, got)
+		})
+	}
+}
+
 func Test_checkMaxLength(t *testing.T) {
 	tests := []struct {
 		desc        string
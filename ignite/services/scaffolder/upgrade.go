@@ -0,0 +1,104 @@
+package scaffolder
+
+import (
+	"go/token"
+	"os"
+	"path/filepath"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/templates/upgrade"
+)
+
+// UpgradeOption configures options for CreateUpgrade.
+type UpgradeOption func(*upgradeOptions)
+
+type upgradeOptions struct {
+	addStores    []string
+	deleteStores []string
+	withTest     bool
+}
+
+// UpgradeWithAddedStores adds the stores of the modules added by the upgrade.
+func UpgradeWithAddedStores(stores ...string) UpgradeOption {
+	return func(o *upgradeOptions) {
+		o.addStores = stores
+	}
+}
+
+// UpgradeWithDeletedStores deletes the stores of the modules removed by the upgrade.
+func UpgradeWithDeletedStores(stores ...string) UpgradeOption {
+	return func(o *upgradeOptions) {
+		o.deleteStores = stores
+	}
+}
+
+// UpgradeWithTest scaffolds a test applying the upgrade to an exported genesis.
+func UpgradeWithTest() UpgradeOption {
+	return func(o *upgradeOptions) {
+		o.withTest = true
+	}
+}
+
+// CreateUpgrade scaffolds a new chain software upgrade handler inside the app.
+func (s Scaffolder) CreateUpgrade(name string, options ...UpgradeOption) error {
+	var o upgradeOptions
+	for _, apply := range options {
+		apply(&o)
+	}
+
+	if name == "" {
+		return errors.New("the upgrade name can't be empty")
+	}
+	pkgName := upgrade.PackageName(name)
+	if !token.IsIdentifier(pkgName) || token.IsKeyword(pkgName) || pkgName[0] == '_' {
+		return errors.Errorf("invalid upgrade name %s", name)
+	}
+
+	if err := checkStoreUpgrades(o.addStores, o.deleteStores); err != nil {
+		return err
+	}
+
+	opts := &upgrade.Options{
+		ModulePath:   s.modpath.RawPath,
+		UpgradeName:  name,
+		AddStores:    o.addStores,
+		DeleteStores: o.deleteStores,
+		WithTest:     o.withTest,
+	}
+
+	upgradeDir := filepath.Join(s.appPath, opts.UpgradeDir())
+	if _, err := os.Stat(upgradeDir); err == nil {
+		return errors.Errorf("upgrade %s already exists", name)
+	} else if !os.IsNotExist(err) {
+		return err
+	}
+
+	upgradesFile := filepath.Join(s.appPath, "app", "upgrades.go")
+	if _, err := os.Stat(upgradesFile); os.IsNotExist(err) {
+		opts.FirstUpgrade = true
+	} else if err != nil {
+		return err
+	}
+
+	g, err := upgrade.NewGenerator(opts)
+	if err != nil {
+		return err
+	}
+
+	return s.Run(g)
+}
+
+// checkStoreUpgrades checks the stores added and deleted by an upgrade are valid.
+func checkStoreUpgrades(addStores, deleteStores []string) error {
+	stores := make(map[string]struct{})
+	for _, store := range append(append([]string{}, addStores...), deleteStores...) {
+		if store == "" {
+			return errors.New("a store name can't be empty")
+		}
+		if _, ok := stores[store]; ok {
+			return errors.Errorf("the store %s is duplicated", store)
+		}
+		stores[store] = struct{}{}
+	}
+	return nil
+}
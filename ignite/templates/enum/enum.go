@@ -0,0 +1,61 @@
+package enum
+
+import (
+	"embed"
+	"io/fs"
+
+	"github.com/gobuffalo/genny/v2"
+	"github.com/gobuffalo/plush/v4"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/pkg/gomodulepath"
+	"github.com/ignite/cli/v29/ignite/pkg/multiformatname"
+	"github.com/ignite/cli/v29/ignite/pkg/xgenny"
+	"github.com/ignite/cli/v29/ignite/templates/field/plushhelpers"
+	"github.com/ignite/cli/v29/ignite/templates/module"
+)
+
+//go:embed files/* files/**/*
+var fsEnum embed.FS
+
+// NewGenerator returns the generator to scaffold an enum declared by a field in a module.
+func NewGenerator(opts *Options) (*genny.Generator, error) {
+	subFs, err := fs.Sub(fsEnum, "files")
+	if err != nil {
+		return nil, errors.Errorf("fail to generate sub: %w", err)
+	}
+
+	g := genny.New()
+	if err := g.OnlyFS(subFs, nil, nil); err != nil {
+		return g, err
+	}
+
+	enumName, err := multiformatname.NewName(opts.Enum.Datatype)
+	if err != nil {
+		return nil, err
+	}
+
+	appModulePath := gomodulepath.ExtractAppPath(opts.ModulePath)
+	valueNames := ValueNames(opts.Enum)
+
+	ctx := plush.NewContext()
+	ctx.Set("ModuleName", opts.ModuleName)
+	ctx.Set("ModulePath", opts.ModulePath)
+	ctx.Set("EnumName", opts.Enum.Name)
+	ctx.Set("EnumType", opts.Enum.Datatype)
+	ctx.Set("EnumPrefix", valuePrefix(opts.Enum))
+	ctx.Set("EnumUnspecified", valueNames[0])
+	ctx.Set("EnumValues", valueNames[1:])
+	ctx.Set("EnumExample", opts.Enum.EnumValues[0].Kebab)
+	ctx.Set("protoPkgName", module.ProtoPackageName(appModulePath, opts.ModuleName, opts.ProtoVer))
+
+	plushhelpers.ExtendPlushContext(ctx)
+	g.Transformer(xgenny.Transformer(ctx))
+	g.Transformer(genny.Replace("{{protoDir}}", opts.ProtoDir))
+	g.Transformer(genny.Replace("{{appName}}", opts.AppName))
+	g.Transformer(genny.Replace("{{moduleName}}", opts.ModuleName))
+	g.Transformer(genny.Replace("{{protoVer}}", opts.ProtoVer))
+	g.Transformer(genny.Replace("{{enumName}}", enumName.Snake))
+
+	return g, nil
+}
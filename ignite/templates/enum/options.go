@@ -0,0 +1,39 @@
+package enum
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ignite/cli/v29/ignite/pkg/multiformatname"
+	"github.com/ignite/cli/v29/ignite/templates/field"
+)
+
+// unspecified is the suffix of the enum zero value name.
+const unspecified = "UNSPECIFIED"
+
+// Options ...
+type Options struct {
+	AppName    string
+	ProtoDir   string
+	ProtoVer   string
+	ModuleName string
+	ModulePath string
+	Enum       field.Field
+}
+
+// ValueNames returns the proto names of the enum values, starting with the unspecified zero value.
+func ValueNames(enum field.Field) []string {
+	prefix := valuePrefix(enum)
+	names := []string{prefix + unspecified}
+	for _, value := range enum.EnumValues {
+		names = append(names, prefix+strings.ToUpper(value.Snake))
+	}
+	return names
+}
+
+// valuePrefix returns the prefix of the enum value names, as required by the proto style guide.
+// The prefix is the enum type name since the enum values share the scope of the proto package.
+func valuePrefix(enum field.Field) string {
+	name := multiformatname.MustNewName(enum.Datatype)
+	return fmt.Sprintf("%s_", strings.ToUpper(name.Snake))
+}
@@ -0,0 +1,29 @@
+package enum
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/v29/ignite/pkg/multiformatname"
+	"github.com/ignite/cli/v29/ignite/templates/field"
+	"github.com/ignite/cli/v29/ignite/templates/field/datatype"
+)
+
+func TestValueNames(t *testing.T) {
+	enum := field.Field{
+		Name:         multiformatname.MustNewName("status"),
+		DatatypeName: datatype.Enum,
+		Datatype:     "OrderStatus",
+		EnumValues: []multiformatname.Name{
+			multiformatname.MustNewName("active"),
+			multiformatname.MustNewName("paused-for-now"),
+		},
+	}
+
+	require.Equal(t, []string{
+		"ORDER_STATUS_UNSPECIFIED",
+		"ORDER_STATUS_ACTIVE",
+		"ORDER_STATUS_PAUSED_FOR_NOW",
+	}, ValueNames(enum))
+}
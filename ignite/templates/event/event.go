@@ -0,0 +1,52 @@
+package event
+
+import (
+	"embed"
+	"io/fs"
+
+	"github.com/gobuffalo/genny/v2"
+	"github.com/gobuffalo/plush/v4"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/pkg/gomodulepath"
+	"github.com/ignite/cli/v29/ignite/pkg/xgenny"
+	"github.com/ignite/cli/v29/ignite/templates/field/plushhelpers"
+	"github.com/ignite/cli/v29/ignite/templates/module"
+)
+
+//go:embed files/* files/**/*
+var fsEvent embed.FS
+
+// NewGenerator returns the generator to scaffold a typed event in a module.
+func NewGenerator(opts *Options) (*genny.Generator, error) {
+	subFs, err := fs.Sub(fsEvent, "files")
+	if err != nil {
+		return nil, errors.Errorf("fail to generate sub: %w", err)
+	}
+
+	g := genny.New()
+	if err := g.OnlyFS(subFs, nil, nil); err != nil {
+		return g, err
+	}
+
+	appModulePath := gomodulepath.ExtractAppPath(opts.ModulePath)
+
+	ctx := plush.NewContext()
+	ctx.Set("ModuleName", opts.ModuleName)
+	ctx.Set("ProtoVer", opts.ProtoVer)
+	ctx.Set("AppName", opts.AppName)
+	ctx.Set("ModulePath", opts.ModulePath)
+	ctx.Set("EventName", opts.EventName)
+	ctx.Set("Fields", opts.Fields)
+	ctx.Set("protoPkgName", module.ProtoPackageName(appModulePath, opts.ModuleName, opts.ProtoVer))
+
+	plushhelpers.ExtendPlushContext(ctx)
+	g.Transformer(xgenny.Transformer(ctx))
+	g.Transformer(genny.Replace("{{protoDir}}", opts.ProtoDir))
+	g.Transformer(genny.Replace("{{appName}}", opts.AppName))
+	g.Transformer(genny.Replace("{{moduleName}}", opts.ModuleName))
+	g.Transformer(genny.Replace("{{protoVer}}", opts.ProtoVer))
+	g.Transformer(genny.Replace("{{eventName}}", opts.EventName.Snake))
+
+	return g, nil
+}
@@ -0,0 +1,17 @@
+package event
+
+import (
+	"github.com/ignite/cli/v29/ignite/pkg/multiformatname"
+	"github.com/ignite/cli/v29/ignite/templates/field"
+)
+
+// Options ...
+type Options struct {
+	AppName    string
+	ProtoDir   string
+	ProtoVer   string
+	ModuleName string
+	ModulePath string
+	EventName  multiformatname.Name
+	Fields     field.Fields
+}
@@ -0,0 +1,42 @@
+package datatype
+
+import (
+	"fmt"
+
+	"github.com/emicklei/proto"
+
+	"github.com/ignite/cli/v29/ignite/pkg/multiformatname"
+	"github.com/ignite/cli/v29/ignite/pkg/protoanalysis/protoutil"
+)
+
+// DataEnum is an enum data type definition.
+// The datatype is the name of the enum scaffolded in the module types.
+var DataEnum = DataType{
+	Name:                    Enum,
+	DataType:                func(datatype string) string { return datatype },
+	CollectionsKeyValueName: func(string) string { return collectionValueComment },
+	DefaultTestValue:        "active",
+	ValueLoop:               "1",
+	ProtoType: func(datatype, name string, index int) string {
+		return fmt.Sprintf("%s %s = %d", datatype, name, index)
+	},
+	GenesisArgs: func(name multiformatname.Name, _ int) string {
+		return fmt.Sprintf("%s: 1,\n", name.UpperCamel)
+	},
+	CLIArgs: func(name multiformatname.Name, datatype, prefix string, argIndex int) string {
+		return fmt.Sprintf(`%[1]v%[2]v, err := types.Parse%[3]v(args[%[4]v])
+					if err != nil {
+						return err
+					}`, prefix, name.UpperCamel, datatype, argIndex)
+	},
+	SimulationValue: func(datatype string) string {
+		return fmt.Sprintf("types.%[1]v(r.Intn(len(types.%[1]v_name)-1) + 1)", datatype)
+	},
+	Validate: func(value string) string {
+		return fmt.Sprintf("%s.Validate()", value)
+	},
+	ToProtoField: func(datatype, name string, index int) *proto.NormalField {
+		return protoutil.NewField(name, datatype, index)
+	},
+	NonIndex: true,
+}
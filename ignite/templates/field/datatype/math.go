@@ -0,0 +1,80 @@
+package datatype
+
+import (
+	"fmt"
+
+	"github.com/emicklei/proto"
+
+	"github.com/ignite/cli/v29/ignite/pkg/multiformatname"
+	"github.com/ignite/cli/v29/ignite/pkg/protoanalysis/protoutil"
+)
+
+var (
+	// DataMathInt is a math integer data type definition.
+	DataMathInt = DataType{
+		Name:                    MathInt,
+		DataType:                func(string) string { return "math.Int" },
+		CollectionsKeyValueName: func(string) string { return collectionValueComment },
+		DefaultTestValue:        "1000",
+		ValueLoop:               "math.NewInt(int64(i))",
+		ProtoType: func(_, name string, index int) string {
+			return fmt.Sprintf(`string %s = %d [(cosmos_proto.scalar) = "cosmos.Int", (gogoproto.customtype) = "cosmossdk.io/math.Int", (gogoproto.nullable) = false]`,
+				name, index)
+		},
+		GenesisArgs: func(multiformatname.Name, int) string { return "" },
+		CLIArgs: func(name multiformatname.Name, _, prefix string, argIndex int) string {
+			return fmt.Sprintf(`%[1]s%[2]s, ok := math.NewIntFromString(args[%[3]d])
+					if !ok {
+						return fmt.Errorf(`+"`invalid integer %%s`"+`, args[%[3]d])
+					}`, prefix, name.UpperCamel, argIndex)
+		},
+		SimulationValue: func(string) string { return "simtypes.RandomAmount(r, math.NewInt(1000000))" },
+		GoCLIImports:    []GoImport{{Name: "cosmossdk.io/math"}, {Name: "fmt"}},
+		ProtoImports:    []string{"gogoproto/gogo.proto", "cosmos_proto/cosmos.proto"},
+		NonIndex:        true,
+		ToProtoField: func(_, name string, index int) *proto.NormalField {
+			optionScalar := protoutil.NewOption("cosmos_proto.scalar", "cosmos.Int", protoutil.Custom())
+			optionCustomType := protoutil.NewOption("gogoproto.customtype", "cosmossdk.io/math.Int", protoutil.Custom())
+			optionNullable := protoutil.NewOption("gogoproto.nullable", "false", protoutil.Custom())
+			return protoutil.NewField(name, "string", index,
+				protoutil.WithFieldOptions(optionScalar),
+				protoutil.WithFieldOptions(optionCustomType),
+				protoutil.WithFieldOptions(optionNullable),
+			)
+		},
+	}
+
+	// DataMathDec is a math decimal data type definition.
+	DataMathDec = DataType{
+		Name:                    MathDec,
+		DataType:                func(string) string { return "math.LegacyDec" },
+		CollectionsKeyValueName: func(string) string { return collectionValueComment },
+		DefaultTestValue:        "1.5",
+		ValueLoop:               "math.LegacyNewDec(int64(i))",
+		ProtoType: func(_, name string, index int) string {
+			return fmt.Sprintf(`string %s = %d [(cosmos_proto.scalar) = "cosmos.Dec", (gogoproto.customtype) = "cosmossdk.io/math.LegacyDec", (gogoproto.nullable) = false]`,
+				name, index)
+		},
+		GenesisArgs: func(multiformatname.Name, int) string { return "" },
+		CLIArgs: func(name multiformatname.Name, _, prefix string, argIndex int) string {
+			return fmt.Sprintf(`%s%s, err := math.LegacyNewDecFromStr(args[%d])
+					if err != nil {
+						return err
+					}`, prefix, name.UpperCamel, argIndex)
+		},
+		SimulationValue: func(string) string { return "simtypes.RandomDecAmount(r, math.LegacyNewDec(1000000))" },
+		GoCLIImports:    []GoImport{{Name: "cosmossdk.io/math"}},
+		ProtoImports:    []string{"gogoproto/gogo.proto", "cosmos_proto/cosmos.proto"},
+		NonIndex:        true,
+		ToProtoField: func(_, name string, index int) *proto.NormalField {
+			optionScalar := protoutil.NewOption("cosmos_proto.scalar", "cosmos.Dec", protoutil.Custom())
+			optionCustomType := protoutil.NewOption("gogoproto.customtype", "cosmossdk.io/math.LegacyDec", protoutil.Custom())
+			optionNullable := protoutil.NewOption("gogoproto.nullable", "false", protoutil.Custom())
+			return protoutil.NewField(name, "string", index,
+				protoutil.WithFieldOptions(optionScalar),
+				protoutil.WithFieldOptions(optionCustomType),
+				protoutil.WithFieldOptions(optionNullable),
+			)
+		},
+	}
+)
@@ -0,0 +1,76 @@
+package datatype
+
+import (
+	"fmt"
+
+	"github.com/emicklei/proto"
+
+	"github.com/ignite/cli/v29/ignite/pkg/multiformatname"
+	"github.com/ignite/cli/v29/ignite/pkg/protoanalysis/protoutil"
+)
+
+var (
+	// DataTimestamp is a timestamp data type definition.
+	DataTimestamp = DataType{
+		Name:                    Timestamp,
+		DataType:                func(string) string { return "time.Time" },
+		CollectionsKeyValueName: func(string) string { return collectionValueComment },
+		DefaultTestValue:        "2006-01-02T15:04:05Z",
+		ValueLoop:               "time.Unix(int64(i), 0).UTC()",
+		ProtoType: func(_, name string, index int) string {
+			return fmt.Sprintf("google.protobuf.Timestamp %s = %d [(gogoproto.nullable) = false, (gogoproto.stdtime) = true]",
+				name, index)
+		},
+		GenesisArgs: func(multiformatname.Name, int) string { return "" },
+		CLIArgs: func(name multiformatname.Name, _, prefix string, argIndex int) string {
+			return fmt.Sprintf(`%s%s, err := time.Parse(time.RFC3339, args[%d])
+					if err != nil {
+						return err
+					}`, prefix, name.UpperCamel, argIndex)
+		},
+		SimulationValue: func(string) string { return "simtypes.RandTimestamp(r)" },
+		GoCLIImports:    []GoImport{{Name: "time"}},
+		ProtoImports:    []string{"gogoproto/gogo.proto", "google/protobuf/timestamp.proto"},
+		NonIndex:        true,
+		ToProtoField: func(_, name string, index int) *proto.NormalField {
+			optionNullable := protoutil.NewOption("gogoproto.nullable", "false", protoutil.Custom())
+			optionStdTime := protoutil.NewOption("gogoproto.stdtime", "true", protoutil.Custom())
+			return protoutil.NewField(name, "google.protobuf.Timestamp", index,
+				protoutil.WithFieldOptions(optionNullable),
+				protoutil.WithFieldOptions(optionStdTime),
+			)
+		},
+	}
+
+	// DataDuration is a duration data type definition.
+	DataDuration = DataType{
+		Name:                    Duration,
+		DataType:                func(string) string { return "time.Duration" },
+		CollectionsKeyValueName: func(string) string { return collectionValueComment },
+		DefaultTestValue:        "1h30m",
+		ValueLoop:               "time.Duration(i) * time.Second",
+		ProtoType: func(_, name string, index int) string {
+			return fmt.Sprintf("google.protobuf.Duration %s = %d [(gogoproto.nullable) = false, (gogoproto.stdduration) = true]",
+				name, index)
+		},
+		GenesisArgs: func(multiformatname.Name, int) string { return "" },
+		CLIArgs: func(name multiformatname.Name, _, prefix string, argIndex int) string {
+			return fmt.Sprintf(`%s%s, err := time.ParseDuration(args[%d])
+					if err != nil {
+						return err
+					}`, prefix, name.UpperCamel, argIndex)
+		},
+		SimulationValue: func(string) string { return "time.Duration(r.Int63n(int64(time.Hour)))" },
+		GoCLIImports:    []GoImport{{Name: "time"}},
+		ProtoImports:    []string{"gogoproto/gogo.proto", "google/protobuf/duration.proto"},
+		NonIndex:        true,
+		ToProtoField: func(_, name string, index int) *proto.NormalField {
+			optionNullable := protoutil.NewOption("gogoproto.nullable", "false", protoutil.Custom())
+			optionStdDuration := protoutil.NewOption("gogoproto.stdduration", "true", protoutil.Custom())
+			return protoutil.NewField(name, "google.protobuf.Duration", index,
+				protoutil.WithFieldOptions(optionNullable),
+				protoutil.WithFieldOptions(optionStdDuration),
+			)
+		},
+	}
+)
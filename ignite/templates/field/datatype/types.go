@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"io"
 	"sort"
+	"strings"
 
 	"github.com/emicklei/proto"
 
@@ -48,6 +49,16 @@ const (
 	Bytes Name = "bytes"
 	// Address represents the address type name.
 	Address Name = "address"
+	// Enum represents the enum type name, declared with its values (eg: "enum=active|paused").
+	Enum Name = "enum"
+	// Timestamp represents the timestamp type name.
+	Timestamp Name = "timestamp"
+	// Duration represents the duration type name.
+	Duration Name = "duration"
+	// MathInt represents the math integer type name.
+	MathInt Name = "math.int"
+	// MathDec represents the math decimal type name.
+	MathDec Name = "math.dec"
 	// Custom represents the custom type name.
 	Custom Name = Name(TypeCustom)
 	// CustomSlice represents the custom array type name.
@@ -64,6 +75,11 @@ const (
 	// DecCoinSliceAlias represents the coin array type name alias.
 	DecCoinSliceAlias Name = "dec.coins"
 
+	// EnumValuesSeparator represents the separator between the enum type name and its values.
+	EnumValuesSeparator = "="
+	// EnumValueSeparator represents the enum values separator.
+	EnumValueSeparator = "|"
+
 	// TypeCustom represents the string type name id.
 	TypeCustom = "customignitetype"
 	// TypeCustomSlice represents the custom array type name id.
@@ -94,6 +110,11 @@ var supportedTypes = map[Name]DataType{
 	DecCoins:          DataDecCoinSlice,
 	DecCoinSliceAlias: DataDecCoinSlice,
 	Address:           DataAddress,
+	Enum:              DataEnum,
+	Timestamp:         DataTimestamp,
+	Duration:          DataDuration,
+	MathInt:           DataMathInt,
+	MathDec:           DataMathDec,
 	Custom:            DataCustom,
 	CustomSlice:       DataCustomSlice,
 }
@@ -118,6 +139,8 @@ type DataType struct {
 	ToString                func(name string) string
 	ToProtoField            func(datatype, name string, index int) *proto.NormalField
 	CLIArgs                 func(name multiformatname.Name, datatype, prefix string, argIndex int) string
+	SimulationValue         func(datatype string) string
+	Validate                func(value string) string
 	NonIndex                bool
 }
 
@@ -127,6 +150,9 @@ func (t DataType) Usage() string {
 	if t.Name == Custom || t.Name == CustomSlice {
 		return "use the custom type to scaffold already created chain types."
 	}
+	if t.Name == Enum {
+		return fmt.Sprintf("use '<FIELD_NAME>:%[1]s=<VALUE>|<VALUE>' to scaffold enum types (eg: %[1]s=active|paused).", Enum)
+	}
 	usage := fmt.Sprintf("use '<FIELD_NAME>:%s' to scaffold %s types (eg: %s).", t.Name, t.DataType(""), t.DefaultTestValue)
 	if t.Name == Coins || t.Name == DecCoins ||
 		t.Name == CoinSliceAlias || t.Name == DecCoinSliceAlias {
@@ -147,10 +173,26 @@ type GoImport struct {
 // IsSupportedType type checks if the given typename is supported by ignite scaffolding.
 // Returns corresponding Datatype if supported.
 func IsSupportedType(typename Name) (dt DataType, ok bool) {
+	if _, isEnum := EnumValues(typename); isEnum {
+		typename = Enum
+	}
 	dt, ok = supportedTypes[typename]
 	return
 }
 
+// EnumValues returns the values declared by an enum type name (eg: "enum=active|paused").
+// Returns false if the type name is not an enum.
+func EnumValues(typename Name) ([]string, bool) {
+	name, values, _ := strings.Cut(string(typename), EnumValuesSeparator)
+	if Name(name) != Enum {
+		return nil, false
+	}
+	if values == "" {
+		return nil, true
+	}
+	return strings.Split(values, EnumValueSeparator), true
+}
+
 // SupportedTypes return a list of supported types.
 func SupportedTypes() map[string]string {
 	supported := make(map[string]string)
@@ -99,6 +99,36 @@ func TestIsSupportedType(t *testing.T) {
 			typename: datatype.Address,
 			ok:       true,
 		},
+		{
+			name:     "enum",
+			typename: datatype.Name("enum=active|paused"),
+			ok:       true,
+		},
+		{
+			name:     "timestamp",
+			typename: datatype.Timestamp,
+			ok:       true,
+		},
+		{
+			name:     "duration",
+			typename: datatype.Duration,
+			ok:       true,
+		},
+		{
+			name:     "math int",
+			typename: datatype.MathInt,
+			ok:       true,
+		},
+		{
+			name:     "math dec",
+			typename: datatype.MathDec,
+			ok:       true,
+		},
+		{
+			name:     "enum prefixed type name",
+			typename: datatype.Name("enumeration"),
+			ok:       false,
+		},
 		{
 			name:     "invalid type name",
 			typename: datatype.Name("invalid"),
@@ -113,3 +143,37 @@ func TestIsSupportedType(t *testing.T) {
 		})
 	}
 }
+
+func TestEnumValues(t *testing.T) {
+	tests := []struct {
+		name     string
+		typename datatype.Name
+		values   []string
+		ok       bool
+	}{
+		{
+			name:     "enum with values",
+			typename: datatype.Name("enum=active|paused|closed"),
+			values:   []string{"active", "paused", "closed"},
+			ok:       true,
+		},
+		{
+			name:     "enum without values",
+			typename: datatype.Enum,
+			ok:       true,
+		},
+		{
+			name:     "not an enum",
+			typename: datatype.String,
+			ok:       false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			values, ok := datatype.EnumValues(tc.typename)
+			require.Equal(t, tc.ok, ok)
+			require.Equal(t, tc.values, values)
+		})
+	}
+}
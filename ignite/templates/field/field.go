@@ -16,6 +16,8 @@ type Field struct {
 	Name         multiformatname.Name
 	DatatypeName datatype.Name
 	Datatype     string
+	// EnumValues are the values of an enum field, without the unspecified zero value.
+	EnumValues []multiformatname.Name
 }
 
 // DataType returns the field Datatype.
@@ -58,6 +60,11 @@ func (f Field) IsSlice() bool {
 		datatype.Uint64,
 		datatype.DecCoin,
 		datatype.Coin,
+		datatype.Enum,
+		datatype.Timestamp,
+		datatype.Duration,
+		datatype.MathInt,
+		datatype.MathDec,
 		datatype.Custom:
 		return false
 	default:
@@ -85,6 +92,16 @@ func (f Field) ProtoType(index int) string {
 	return dt.ProtoType(f.Datatype, f.ProtoFieldName(), index)
 }
 
+// EnumProtoFile returns the name of the proto file declaring the enum of the field, without extension
+// (eg: "post_status_enum" for the "PostStatus" enum).
+func (f Field) EnumProtoFile() string {
+	name, err := multiformatname.NewName(f.Datatype)
+	if err != nil {
+		panic(err)
+	}
+	return name.Snake + "_enum"
+}
+
 // CollectionsKeyValueType returns the field collections key value type.
 func (f Field) CollectionsKeyValueType() string {
 	dt, ok := datatype.IsSupportedType(f.DatatypeName)
@@ -155,6 +172,32 @@ func (f Field) CLIArgs(prefix string, argIndex int) string {
 	return dt.CLIArgs(f.Name, f.Datatype, prefix, argIndex)
 }
 
+// SimulationValue returns the Datatype random value used by the simulation,
+// or an empty string if the Datatype has no simulation generator.
+func (f Field) SimulationValue() string {
+	dt, ok := datatype.IsSupportedType(f.DatatypeName)
+	if !ok {
+		panic(fmt.Sprintf("unknown type %s", f.DatatypeName))
+	}
+	if dt.SimulationValue == nil {
+		return ""
+	}
+	return dt.SimulationValue(f.Datatype)
+}
+
+// Validation returns the expression validating the field of the given variable
+// (eg: "msg" gives "msg.Status.Validate()"), or an empty string if the Datatype has no validation.
+func (f Field) Validation(variable string) string {
+	dt, ok := datatype.IsSupportedType(f.DatatypeName)
+	if !ok {
+		panic(fmt.Sprintf("unknown type %s", f.DatatypeName))
+	}
+	if dt.Validate == nil {
+		return ""
+	}
+	return dt.Validate(fmt.Sprintf("%s.%s", variable, f.Name.UpperCamel))
+}
+
 // ToBytes returns the Datatype byte array cast.
 func (f Field) ToBytes(name string) string {
 	dt, ok := datatype.IsSupportedType(f.DatatypeName)
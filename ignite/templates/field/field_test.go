@@ -70,6 +70,24 @@ func TestField_IsSlice(t *testing.T) {
 			},
 			expected: false,
 		},
+		{
+			name: "enum type should not be slice",
+			field: Field{
+				Name:         multiformatname.Name{},
+				DatatypeName: datatype.Enum,
+				Datatype:     "Status",
+			},
+			expected: false,
+		},
+		{
+			name: "math int type should not be slice",
+			field: Field{
+				Name:         multiformatname.Name{},
+				DatatypeName: datatype.MathInt,
+				Datatype:     "",
+			},
+			expected: false,
+		},
 		{
 			name: "custom array type should be slice",
 			field: Field{
@@ -73,13 +73,117 @@ func (f Fields) CLIUsage() string {
 func (f Fields) Custom() []string {
 	fields := make([]string, 0)
 	for _, field := range f {
-		if field.DatatypeName == datatype.Custom || field.DatatypeName == datatype.CustomSlice {
+		switch field.DatatypeName {
+		case datatype.Custom, datatype.CustomSlice:
 			dataType, err := multiformatname.NewName(field.Datatype)
 			if err != nil {
 				panic(err)
 			}
 			fields = append(fields, dataType.Snake)
+		case datatype.Enum:
+			fields = append(fields, field.EnumProtoFile())
 		}
 	}
 	return fields
 }
+
+// Enums returns the enum fields.
+func (f Fields) Enums() Fields {
+	fields := make(Fields, 0)
+	for _, field := range f {
+		if field.DatatypeName == datatype.Enum {
+			fields = append(fields, field)
+		}
+	}
+	return fields
+}
+
+// ScopeEnums returns the fields with the enum types prefixed by the name of the type or message
+// declaring them (eg: "PostStatus" for the "status" field of "post"), so the enums of different
+// components don't conflict in the module.
+func (f Fields) ScopeEnums(scope multiformatname.Name) Fields {
+	fields := make(Fields, len(f))
+	for i, field := range f {
+		if field.DatatypeName == datatype.Enum {
+			field.Datatype = scope.UpperCamel + field.Name.UpperCamel
+		}
+		fields[i] = field
+	}
+	return fields
+}
+
+// Validated returns the fields with a Datatype validation.
+func (f Fields) Validated() Fields {
+	fields := make(Fields, 0)
+	for _, field := range f {
+		if dt, ok := datatype.IsSupportedType(field.DatatypeName); ok && dt.Validate != nil {
+			fields = append(fields, field)
+		}
+	}
+	return fields
+}
+
+// CollectionsKeyType returns the Go type of a collections key composed by the fields.
+// A single field is used as is, two and three fields are composed into a pair or a triple key.
+func (f Fields) CollectionsKeyType() string {
+	types := make([]string, len(f))
+	for i, field := range f {
+		types[i] = field.DataType()
+	}
+	switch len(f) {
+	case 1:
+		return types[0]
+	case 2:
+		return fmt.Sprintf("collections.Pair[%s]", strings.Join(types, ", "))
+	case 3:
+		return fmt.Sprintf("collections.Triple[%s]", strings.Join(types, ", "))
+	default:
+		panic(fmt.Sprintf("unsupported collections key size %d", len(f)))
+	}
+}
+
+// CollectionsKeyCodec returns the collections key codec for the key composed by the fields.
+func (f Fields) CollectionsKeyCodec() string {
+	codecs := make([]string, len(f))
+	for i, field := range f {
+		codecs[i] = field.CollectionsKeyValueType()
+	}
+	switch len(f) {
+	case 1:
+		return codecs[0]
+	case 2:
+		return fmt.Sprintf("collections.PairKeyCodec(%s)", strings.Join(codecs, ", "))
+	case 3:
+		return fmt.Sprintf("collections.TripleKeyCodec(%s)", strings.Join(codecs, ", "))
+	default:
+		panic(fmt.Sprintf("unsupported collections key size %d", len(f)))
+	}
+}
+
+// CollectionsKey returns the expression building the collections key from the fields
+// of the given variable (e.g. "msg" gives "collections.Join(msg.Owner, msg.Denom)").
+func (f Fields) CollectionsKey(variable string) string {
+	values := make([]string, len(f))
+	for i, field := range f {
+		values[i] = fmt.Sprintf("%s.%s", variable, field.Name.UpperCamel)
+	}
+	switch len(f) {
+	case 1:
+		return values[0]
+	case 2:
+		return fmt.Sprintf("collections.Join(%s)", strings.Join(values, ", "))
+	case 3:
+		return fmt.Sprintf("collections.Join3(%s)", strings.Join(values, ", "))
+	default:
+		panic(fmt.Sprintf("unsupported collections key size %d", len(f)))
+	}
+}
+
+// GenesisArgs returns the genesis args of all the fields.
+func (f Fields) GenesisArgs(value int) string {
+	args := ""
+	for _, field := range f {
+		args += field.GenesisArgs(value)
+	}
+	return args
+}
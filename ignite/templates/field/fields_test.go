@@ -16,6 +16,8 @@ func TestFieldsCustom(t *testing.T) {
 	require.NoError(t, err)
 	nameC, err := multiformatname.NewName("customC")
 	require.NoError(t, err)
+	nameD, err := multiformatname.NewName("status")
+	require.NoError(t, err)
 
 	fields := Fields{
 		{
@@ -32,7 +34,96 @@ func TestFieldsCustom(t *testing.T) {
 			Name:         nameC,
 			DatatypeName: datatype.String,
 		},
+		{
+			Name:         nameD,
+			DatatypeName: datatype.Enum,
+			Datatype:     "PostStatus",
+		},
+	}
+
+	require.Equal(t, []string{"product_details", "line_item", "post_status_enum"}, fields.Custom())
+	require.Equal(t, Fields{fields[3]}, fields.Enums())
+	require.Equal(t, Fields{fields[3]}, fields.Validated())
+	require.Equal(t, "msg.Status.Validate()", fields[3].Validation("msg"))
+	require.Empty(t, fields[2].Validation("msg"))
+}
+
+func TestFieldsCollectionsKey(t *testing.T) {
+	newField := func(name string, dt datatype.Name) Field {
+		n, err := multiformatname.NewName(name)
+		require.NoError(t, err)
+		return Field{Name: n, DatatypeName: dt}
+	}
+	owner := newField("owner", datatype.Address)
+	denom := newField("denom", datatype.String)
+	id := newField("id", datatype.Uint)
+
+	tests := []struct {
+		name      string
+		fields    Fields
+		keyType   string
+		keyCodec  string
+		keyValue  string
+		wantPanic bool
+	}{
+		{
+			name:     "single field",
+			fields:   Fields{denom},
+			keyType:  "string",
+			keyCodec: "collections.StringKey",
+			keyValue: "msg.Denom",
+		},
+		{
+			name:     "pair",
+			fields:   Fields{owner, denom},
+			keyType:  "collections.Pair[string, string]",
+			keyCodec: "collections.PairKeyCodec(collections.StringKey, collections.StringKey)",
+			keyValue: "collections.Join(msg.Owner, msg.Denom)",
+		},
+		{
+			name:     "triple",
+			fields:   Fields{owner, denom, id},
+			keyType:  "collections.Triple[string, string, uint64]",
+			keyCodec: "collections.TripleKeyCodec(collections.StringKey, collections.StringKey, collections.Uint64Key)",
+			keyValue: "collections.Join3(msg.Owner, msg.Denom, msg.Id)",
+		},
+		{
+			name:      "too many fields",
+			fields:    Fields{owner, denom, id, newField("extra", datatype.Int)},
+			wantPanic: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.wantPanic {
+				require.Panics(t, func() { _ = tt.fields.CollectionsKeyType() })
+				require.Panics(t, func() { _ = tt.fields.CollectionsKeyCodec() })
+				require.Panics(t, func() { _ = tt.fields.CollectionsKey("msg") })
+				return
+			}
+			require.Equal(t, tt.keyType, tt.fields.CollectionsKeyType())
+			require.Equal(t, tt.keyCodec, tt.fields.CollectionsKeyCodec())
+			require.Equal(t, tt.keyValue, tt.fields.CollectionsKey("msg"))
+		})
+	}
+}
+
+func TestFieldsScopeEnums(t *testing.T) {
+	fields := Fields{
+		{
+			Name:         multiformatname.MustNewName("title"),
+			DatatypeName: datatype.String,
+		},
+		{
+			Name:         multiformatname.MustNewName("status"),
+			DatatypeName: datatype.Enum,
+			Datatype:     "Status",
+		},
 	}
 
-	require.Equal(t, []string{"product_details", "line_item"}, fields.Custom())
+	scoped := fields.ScopeEnums(multiformatname.MustNewName("post"))
+	require.Equal(t, fields[0], scoped[0])
+	require.Equal(t, "PostStatus", scoped[1].Datatype)
+	require.Equal(t, "post_status_enum", scoped[1].EnumProtoFile())
+	require.Equal(t, "Status", fields[1].Datatype)
 }
@@ -8,6 +8,9 @@ import (
 	"github.com/ignite/cli/v29/ignite/templates/field/datatype"
 )
 
+// enumUnspecified is the name of the enum zero value.
+const enumUnspecified = "unspecified"
+
 // validateField validates the field Name and type, and checks the name is not forbidden by Ignite CLI.
 func validateField(field string, isForbiddenField func(string) error) (multiformatname.Name, datatype.Name, error) {
 	name, dataTypeName, err := parseField(field)
@@ -88,6 +91,21 @@ func ParseFields(
 		}
 		existingFields[name.LowerCamel] = struct{}{}
 
+		// Check if is an enum type declaring its values
+		if values, ok := datatype.EnumValues(datatypeName); ok {
+			enumValues, err := parseEnumValues(values)
+			if err != nil {
+				return parsedFields, errors.Errorf("invalid enum field %s: %w", name.Original, err)
+			}
+			parsedFields = append(parsedFields, Field{
+				Name:         name,
+				Datatype:     name.UpperCamel,
+				DatatypeName: datatype.Enum,
+				EnumValues:   enumValues,
+			})
+			continue
+		}
+
 		// Check if is a static type
 		if _, ok := datatype.IsSupportedType(datatypeName); ok {
 			parsedFields = append(parsedFields, Field{
@@ -118,6 +136,32 @@ func ParseFields(
 	return parsedFields, nil
 }
 
+// parseEnumValues parses the enum values and checks there is no duplicated value.
+func parseEnumValues(values []string) ([]multiformatname.Name, error) {
+	if len(values) == 0 {
+		return nil, errors.Errorf("no values, should be 'Name:%s%s<value>%s<value>'",
+			datatype.Enum, datatype.EnumValuesSeparator, datatype.EnumValueSeparator)
+	}
+
+	existingValues := make(map[string]struct{})
+	enumValues := make([]multiformatname.Name, 0, len(values))
+	for _, value := range values {
+		name, err := multiformatname.NewName(value)
+		if err != nil {
+			return nil, errors.Errorf("invalid value %q: %w", value, err)
+		}
+		if name.Snake == enumUnspecified {
+			return nil, errors.Errorf("the value %s is reserved", value)
+		}
+		if _, exists := existingValues[name.Snake]; exists {
+			return nil, errors.Errorf("the value %s is duplicated", value)
+		}
+		existingValues[name.Snake] = struct{}{}
+		enumValues = append(enumValues, name)
+	}
+	return enumValues, nil
+}
+
 func normalizeCustomTypeName(customType string) string {
 	name, err := multiformatname.NewName(customType)
 	if err != nil {
@@ -35,6 +35,18 @@ func TestForbiddenParseFields(t *testing.T) {
 	// invalid format
 	_, err = ParseFields([]string{"foo:int:int"}, alwaysInvalid)
 	require.Error(t, err)
+
+	// enum without values
+	_, err = ParseFields([]string{"foo:enum"}, noCheck)
+	require.Error(t, err)
+
+	// enum with duplicated values
+	_, err = ParseFields([]string{"foo:enum=active|Active"}, noCheck)
+	require.Error(t, err)
+
+	// enum with reserved value
+	_, err = ParseFields([]string{"foo:enum=active|unspecified"}, noCheck)
+	require.Error(t, err)
 }
 
 func TestParseFields1(t *testing.T) {
@@ -190,6 +202,50 @@ func TestParseFields1(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "test enum types",
+			fields: []string{
+				name1.Original + ":enum=active|paused-for-now",
+			},
+			want: Fields{
+				{
+					Name:         name1,
+					DatatypeName: datatype.Enum,
+					Datatype:     "Foo",
+					EnumValues: []multiformatname.Name{
+						multiformatname.MustNewName("active"),
+						multiformatname.MustNewName("paused-for-now"),
+					},
+				},
+			},
+		},
+		{
+			name: "test time and math types",
+			fields: []string{
+				name1.Original + ":timestamp",
+				name2.Original + ":duration",
+				name3.Original + ":math.int",
+				name4.Original + ":math.dec",
+			},
+			want: Fields{
+				{
+					Name:         name1,
+					DatatypeName: datatype.Timestamp,
+				},
+				{
+					Name:         name2,
+					DatatypeName: datatype.Duration,
+				},
+				{
+					Name:         name3,
+					DatatypeName: datatype.MathInt,
+				},
+				{
+					Name:         name4,
+					DatatypeName: datatype.MathDec,
+				},
+			},
+		},
 		{
 			name: "test sdk.Coin types",
 			fields: []string{
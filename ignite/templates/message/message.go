@@ -24,6 +24,9 @@ var (
 
 	//go:embed files/simapp/* files/simapp/**/*
 	fsSimapp embed.FS
+
+	//go:embed files/validation/* files/validation/**/*
+	fsValidation embed.FS
 )
 
 func Box(box fs.FS, opts *Options, g *genny.Generator) error {
@@ -76,6 +79,16 @@ func NewGenerator(opts *Options) (*genny.Generator, error) {
 			return nil, err
 		}
 	}
+	// Stateless validation of the message fields (e.g. enums)
+	if len(opts.Fields.Validated()) > 0 {
+		subValidation, err := fs.Sub(fsValidation, "files/validation")
+		if err != nil {
+			return nil, errors.Errorf("fail to generate sub: %w", err)
+		}
+		if err := Box(subValidation, opts, g); err != nil {
+			return nil, err
+		}
+	}
 	return g, Box(subMessage, opts, g)
 }
 
@@ -0,0 +1,231 @@
+package moduleblocker
+
+import (
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/fs"
+
+	"github.com/gobuffalo/genny/v2"
+	"github.com/gobuffalo/plush/v4"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/pkg/xast"
+	"github.com/ignite/cli/v29/ignite/pkg/xgenny"
+	modulecreate "github.com/ignite/cli/v29/ignite/templates/module/create"
+)
+
+// NewGenerator returns the generator to scaffold a module blocker.
+func NewGenerator(opts *Options) (*genny.Generator, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+
+	subFS, err := fs.Sub(files, "files")
+	if err != nil {
+		return nil, errors.Errorf("fail to generate sub: %w", err)
+	}
+
+	g := genny.New()
+	if err := g.OnlyFS(subFS, nil, nil); err != nil {
+		return g, err
+	}
+
+	ctx := plush.NewContext()
+	ctx.Set("kind", opts.Kind)
+	ctx.Set("isPre", opts.IsPre())
+	ctx.Set("keeperMethod", opts.KeeperMethod())
+
+	g.Transformer(xgenny.Transformer(ctx))
+	g.Transformer(genny.Replace("{{moduleName}}", opts.ModuleName))
+	g.Transformer(genny.Replace("{{keeperFile}}", opts.KeeperFileName()))
+	g.RunFn(moduleModify(opts))
+	g.RunFn(appConfigModify(opts))
+
+	return g, nil
+}
+
+func moduleModify(opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		f, err := r.Disk.Find(opts.ModuleFile())
+		if err != nil {
+			return err
+		}
+
+		content, err := updateModule(f.String(), opts)
+		if err != nil {
+			return err
+		}
+
+		return r.File(genny.NewFileS(opts.ModuleFile(), content))
+	}
+}
+
+func appConfigModify(opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		f, err := r.Disk.Find(opts.AppConfigFile())
+		if err != nil {
+			return err
+		}
+
+		// the runtime panics when a module implementing a blocker isn't ordered in the app config.
+		content, err := modulecreate.AddModuleToAppConfig(
+			f.String(),
+			opts.ModuleName,
+			modulecreate.SkipConfigEntry(),
+			modulecreate.SpecifyModuleEntry(opts.RuntimeField()),
+		)
+		if err != nil {
+			return err
+		}
+
+		return r.File(genny.NewFileS(opts.AppConfigFile(), content))
+	}
+}
+
+// updateModule makes the module implement the blocker interface by calling the keeper blocker.
+// The blocker stub scaffolded with the module is replaced, an implemented blocker is an error.
+func updateModule(content string, opts *Options) (string, error) {
+	content, err := xast.AppendImports(
+		content,
+		xast.WithImport("context"),
+		xast.WithImport("cosmossdk.io/core/appmodule"),
+	)
+	if err != nil {
+		return "", err
+	}
+
+	content, err = addInterfaceAssertion(content, opts)
+	if err != nil {
+		return "", err
+	}
+
+	return addModuleMethod(content, opts)
+}
+
+// addInterfaceAssertion asserts the module implements the blocker interface,
+// next to the other interface assertions of the module.
+func addInterfaceAssertion(content string, opts *Options) (string, error) {
+	fileSet := token.NewFileSet()
+	file, err := parser.ParseFile(fileSet, "", content, parser.ParseComments)
+	if err != nil {
+		return "", err
+	}
+
+	assertion := fmt.Sprintf("_ appmodule.%s = (*AppModule)(nil)", opts.Interface())
+
+	var last *ast.ValueSpec
+	for _, decl := range file.Decls {
+		genDecl, ok := decl.(*ast.GenDecl)
+		if !ok || genDecl.Tok != token.VAR || !genDecl.Rparen.IsValid() {
+			continue
+		}
+		for _, spec := range genDecl.Specs {
+			valueSpec, ok := spec.(*ast.ValueSpec)
+			if !ok || len(valueSpec.Names) != 1 || valueSpec.Names[0].Name != "_" {
+				continue
+			}
+			if isSelector(valueSpec.Type, "appmodule", opts.Interface()) {
+				return content, nil
+			}
+			last = valueSpec
+		}
+	}
+
+	if last == nil {
+		content = fmt.Sprintf("%s\nvar %s\n", content, assertion)
+	} else {
+		offset := fileSet.Position(last.End()).Offset
+		content = fmt.Sprintf("%s\n\t%s%s", content[:offset], assertion, content[offset:])
+	}
+
+	formatted, err := format.Source([]byte(content))
+	if err != nil {
+		return "", err
+	}
+	return string(formatted), nil
+}
+
+// addModuleMethod adds the module blocker method calling the keeper blocker.
+func addModuleMethod(content string, opts *Options) (string, error) {
+	fileSet := token.NewFileSet()
+	file, err := parser.ParseFile(fileSet, "", content, parser.ParseComments)
+	if err != nil {
+		return "", err
+	}
+
+	var method string
+	if opts.IsPre() {
+		method = fmt.Sprintf(`func (am AppModule) %[1]s(ctx context.Context) (appmodule.ResponsePreBlock, error) {
+	return am.keeper.%[2]s(ctx)
+}`, opts.ModuleMethod(), opts.KeeperMethod())
+	} else {
+		method = fmt.Sprintf(`func (am AppModule) %[1]s(ctx context.Context) error {
+	return am.keeper.%[2]s(ctx)
+}`, opts.ModuleMethod(), opts.KeeperMethod())
+	}
+
+	funcDecl := findModuleMethod(file, opts.ModuleMethod())
+	switch {
+	case funcDecl == nil:
+		content = fmt.Sprintf("%s\n// %s calls the keeper %s.\n%s\n", content, opts.ModuleMethod(), opts.KeeperMethod(), method)
+	case isBlockerStub(funcDecl):
+		// replace the stub, keeping its documentation.
+		start, end := fileSet.Position(funcDecl.Pos()).Offset, fileSet.Position(funcDecl.End()).Offset
+		content = content[:start] + method + content[end:]
+	default:
+		return "", errors.Errorf("%s is already implemented by the %s module", opts.ModuleMethod(), opts.ModuleName)
+	}
+
+	formatted, err := format.Source([]byte(content))
+	if err != nil {
+		return "", err
+	}
+	return string(formatted), nil
+}
+
+// findModuleMethod returns the declaration of the AppModule method with the name.
+func findModuleMethod(file *ast.File, name string) *ast.FuncDecl {
+	for _, decl := range file.Decls {
+		funcDecl, ok := decl.(*ast.FuncDecl)
+		if !ok || funcDecl.Name.Name != name || funcDecl.Recv == nil || len(funcDecl.Recv.List) != 1 {
+			continue
+		}
+		recvType := funcDecl.Recv.List[0].Type
+		if star, ok := recvType.(*ast.StarExpr); ok {
+			recvType = star.X
+		}
+		if ident, ok := recvType.(*ast.Ident); ok && ident.Name == "AppModule" {
+			return funcDecl
+		}
+	}
+	return nil
+}
+
+// isBlockerStub checks if the blocker method only returns empty values, like the stubs scaffolded with a module.
+func isBlockerStub(funcDecl *ast.FuncDecl) bool {
+	if funcDecl.Body == nil || len(funcDecl.Body.List) != 1 {
+		return false
+	}
+	ret, ok := funcDecl.Body.List[0].(*ast.ReturnStmt)
+	if !ok {
+		return false
+	}
+	for _, result := range ret.Results {
+		if ident, ok := result.(*ast.Ident); !ok || ident.Name != "nil" {
+			return false
+		}
+	}
+	return true
+}
+
+func isSelector(expr ast.Expr, pkgName, name string) bool {
+	selector, ok := expr.(*ast.SelectorExpr)
+	if !ok || selector.Sel.Name != name {
+		return false
+	}
+	ident, ok := selector.X.(*ast.Ident)
+	return ok && ident.Name == pkgName
+}
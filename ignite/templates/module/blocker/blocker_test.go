@@ -0,0 +1,152 @@
+package moduleblocker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const moduleContent = `package blog
+
+import (
+	"context"
+
+	"cosmossdk.io/core/appmodule"
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	"github.com/test/blog/x/blog/keeper"
+)
+
+var (
+	_ module.AppModule = (*AppModule)(nil)
+
+	_ appmodule.AppModule       = (*AppModule)(nil)
+	_ appmodule.HasBeginBlocker = (*AppModule)(nil)
+)
+
+type AppModule struct {
+	keeper keeper.Keeper
+}
+
+// BeginBlock contains the logic that is automatically triggered at the beginning of each block.
+// The begin block implementation is optional.
+func (am AppModule) BeginBlock(_ context.Context) error {
+	return nil
+}
+`
+
+func TestUpdateModule(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		kind    string
+		want    string
+		err     string
+	}{
+		{
+			name:    "replace blocker stub",
+			content: moduleContent,
+			kind:    KindBegin,
+			want: `package blog
+
+import (
+	"context"
+	"cosmossdk.io/core/appmodule"
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	"github.com/test/blog/x/blog/keeper"
+)
+
+var (
+	_ module.AppModule = (*AppModule)(nil)
+
+	_ appmodule.AppModule       = (*AppModule)(nil)
+	_ appmodule.HasBeginBlocker = (*AppModule)(nil)
+)
+
+type AppModule struct {
+	keeper keeper.Keeper
+}
+
+// BeginBlock contains the logic that is automatically triggered at the beginning of each block.
+// The begin block implementation is optional.
+func (am AppModule) BeginBlock(ctx context.Context) error {
+	return am.keeper.BeginBlocker(ctx)
+}
+`,
+		},
+		{
+			name:    "add missing blocker",
+			content: moduleContent,
+			kind:    KindPre,
+			want: `package blog
+
+import (
+	"context"
+	"cosmossdk.io/core/appmodule"
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	"github.com/test/blog/x/blog/keeper"
+)
+
+var (
+	_ module.AppModule = (*AppModule)(nil)
+
+	_ appmodule.AppModule       = (*AppModule)(nil)
+	_ appmodule.HasBeginBlocker = (*AppModule)(nil)
+	_ appmodule.HasPreBlocker   = (*AppModule)(nil)
+)
+
+type AppModule struct {
+	keeper keeper.Keeper
+}
+
+// BeginBlock contains the logic that is automatically triggered at the beginning of each block.
+// The begin block implementation is optional.
+func (am AppModule) BeginBlock(_ context.Context) error {
+	return nil
+}
+
+// PreBlock calls the keeper PreBlocker.
+func (am AppModule) PreBlock(ctx context.Context) (appmodule.ResponsePreBlock, error) {
+	return am.keeper.PreBlocker(ctx)
+}
+`,
+		},
+		{
+			name: "implemented blocker",
+			content: `package blog
+
+import "context"
+
+type AppModule struct{}
+
+func (am AppModule) EndBlock(ctx context.Context) error {
+	return doSomething(ctx)
+}
+`,
+			kind: KindEnd,
+			err:  "EndBlock is already implemented by the blog module",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := &Options{ModuleName: "blog", ModulePath: "github.com/test/blog", Kind: tt.kind}
+
+			got, err := updateModule(tt.content, opts)
+			if tt.err != "" {
+				require.EqualError(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestOptionsValidate(t *testing.T) {
+	for _, kind := range Kinds() {
+		require.NoError(t, Options{Kind: kind}.Validate())
+	}
+	require.Error(t, Options{Kind: "middle"}.Validate())
+}
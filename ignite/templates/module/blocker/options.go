@@ -0,0 +1,95 @@
+package moduleblocker
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+)
+
+const (
+	// KindBegin is the kind of the blocker run at the beginning of each block.
+	KindBegin = "begin"
+	// KindEnd is the kind of the blocker run at the end of each block.
+	KindEnd = "end"
+	// KindPre is the kind of the blocker run before the begin blockers of each block.
+	KindPre = "pre"
+)
+
+// Kinds returns the supported blocker kinds.
+func Kinds() []string {
+	return []string{KindBegin, KindEnd, KindPre}
+}
+
+// Options represents the options to scaffold a module blocker.
+type Options struct {
+	ModuleName string
+	ModulePath string
+	Kind       string
+}
+
+// Validate checks the blocker kind.
+func (opts Options) Validate() error {
+	switch opts.Kind {
+	case KindBegin, KindEnd, KindPre:
+		return nil
+	default:
+		return errors.Errorf("invalid blocker kind %q, expected one of %v", opts.Kind, Kinds())
+	}
+}
+
+// IsPre returns true if the blocker is run before the begin blockers.
+func (opts Options) IsPre() bool {
+	return opts.Kind == KindPre
+}
+
+// ModuleFile returns the path to the module definition file.
+func (opts Options) ModuleFile() string {
+	return filepath.Join("x", opts.ModuleName, "module", "module.go")
+}
+
+// AppConfigFile returns the path to the app config file.
+func (opts Options) AppConfigFile() string {
+	return filepath.Join("app", "app_config.go")
+}
+
+// KeeperFile returns the path to the keeper blocker source file.
+func (opts Options) KeeperFile() string {
+	return filepath.Join("x", opts.ModuleName, "keeper", opts.KeeperFileName()+".go")
+}
+
+// KeeperFileName returns the name of the keeper blocker source file without extension.
+func (opts Options) KeeperFileName() string {
+	return fmt.Sprintf("%s_blocker", opts.Kind)
+}
+
+// Interface returns the name of the appmodule interface implemented by the module.
+func (opts Options) Interface() string {
+	return fmt.Sprintf("Has%sBlocker", opts.title())
+}
+
+// ModuleMethod returns the name of the module method called by the app.
+func (opts Options) ModuleMethod() string {
+	return fmt.Sprintf("%sBlock", opts.title())
+}
+
+// KeeperMethod returns the name of the keeper method called by the module.
+func (opts Options) KeeperMethod() string {
+	return fmt.Sprintf("%sBlocker", opts.title())
+}
+
+// RuntimeField returns the name of the runtime config field ordering the module blockers.
+func (opts Options) RuntimeField() string {
+	return fmt.Sprintf("%sBlockers", opts.title())
+}
+
+func (opts Options) title() string {
+	switch opts.Kind {
+	case KindPre:
+		return "Pre"
+	case KindEnd:
+		return "End"
+	default:
+		return "Begin"
+	}
+}
@@ -0,0 +1,6 @@
+package moduleblocker
+
+import "embed"
+
+//go:embed files/* files/**/*
+var files embed.FS
@@ -0,0 +1,201 @@
+package moduleerrors
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path"
+	"slices"
+	"strconv"
+
+	"github.com/gobuffalo/genny/v2"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/pkg/goanalysis"
+	"github.com/ignite/cli/v29/ignite/pkg/xast"
+)
+
+const (
+	// errorsImportPath is the import path of the package registering the module errors.
+	errorsImportPath = "cosmossdk.io/errors"
+
+	// sdkErrorsImportPath is the import path of the generic SDK errors.
+	sdkErrorsImportPath = "github.com/cosmos/cosmos-sdk/types/errors"
+
+	// invalidRequestError is the generic SDK error replaced by the new error.
+	invalidRequestError = "ErrInvalidRequest"
+
+	// firstErrorCode is the code of the first error registered by a module.
+	firstErrorCode = 1100
+)
+
+// NewGenerator returns the generator to scaffold a new module error.
+func NewGenerator(opts *Options) (*genny.Generator, error) {
+	g := genny.New()
+	g.RunFn(typesErrorsModify(opts))
+	if opts.ReplaceInHandler != "" {
+		g.RunFn(handlerModify(opts))
+	}
+	return g, nil
+}
+
+func typesErrorsModify(opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		f, err := r.Disk.Find(opts.ErrorsFile())
+		if err != nil {
+			return err
+		}
+
+		content, err := appendError(f.String(), opts.ErrorName, opts.Description)
+		if err != nil {
+			return err
+		}
+
+		return r.File(genny.NewFileS(opts.ErrorsFile(), content))
+	}
+}
+
+func handlerModify(opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		f, err := r.Disk.Find(opts.ReplaceInFile)
+		if err != nil {
+			return err
+		}
+
+		content, err := replaceInHandler(f.String(), opts.ReplaceInHandler, opts.TypesImportPath(), opts.ErrorName)
+		if err != nil {
+			return err
+		}
+
+		return r.File(genny.NewFileS(opts.ReplaceInFile, content))
+	}
+}
+
+// NextErrorCode returns the next free error code of the errors registered in the content
+// of a module errors file. The first error of a module is registered with the code 1100.
+func NextErrorCode(content string) (uint32, error) {
+	file, err := parser.ParseFile(token.NewFileSet(), "", content, 0)
+	if err != nil {
+		return 0, err
+	}
+	return nextErrorCode(file)
+}
+
+func nextErrorCode(file *ast.File) (uint32, error) {
+	var codes []uint32
+	err := xast.Inspect(file, func(n ast.Node) error {
+		if code, ok := registerCallCode(n); ok {
+			codes = append(codes, code)
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	if len(codes) == 0 {
+		return firstErrorCode, nil
+	}
+	return slices.Max(codes) + 1, nil
+}
+
+// registerCallCode returns the code of an error registration call expression
+// of a "Register(codespace, code, description)" function.
+func registerCallCode(n ast.Node) (uint32, bool) {
+	call, ok := n.(*ast.CallExpr)
+	if !ok || len(call.Args) != 3 {
+		return 0, false
+	}
+	selector, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok || selector.Sel.Name != "Register" {
+		return 0, false
+	}
+	lit, ok := call.Args[1].(*ast.BasicLit)
+	if !ok || lit.Kind != token.INT {
+		return 0, false
+	}
+	code, err := strconv.ParseUint(lit.Value, 0, 32)
+	if err != nil {
+		return 0, false
+	}
+	return uint32(code), true
+}
+
+// appendError registers a new error with the next free code in the content of a module errors file.
+// The error is added to the last variable block of the file when there is one.
+func appendError(content, name, description string) (string, error) {
+	file, err := parser.ParseFile(token.NewFileSet(), "", content, 0)
+	if err != nil {
+		return "", err
+	}
+
+	code, err := nextErrorCode(file)
+	if err != nil {
+		return "", err
+	}
+
+	pkgName, ok := importName(file, errorsImportPath)
+	if !ok {
+		pkgName = "errorsmod"
+	}
+
+	register := fmt.Sprintf("%s.Register(ModuleName, %d, %s)", pkgName, code, strconv.Quote(description))
+	content, err = xast.AppendGlobal(content, xast.GlobalTypeVar, xast.WithGlobal(name, "", register))
+	if err != nil {
+		return "", errors.Errorf("can't register the error %s in the module errors: %w", name, err)
+	}
+
+	if !ok {
+		return xast.AppendImports(content, xast.WithNamedImport(pkgName, errorsImportPath))
+	}
+	return content, nil
+}
+
+// replaceInHandler replaces the generic invalid request errors returned by a handler with a module error.
+// The generic SDK errors import is removed when the errors package is no longer used.
+func replaceInHandler(content, handler, typesImportPath, name string) (string, error) {
+	file, err := parser.ParseFile(token.NewFileSet(), "", content, parser.ImportsOnly)
+	if err != nil {
+		return "", err
+	}
+
+	sdkErrorsName, ok := importName(file, sdkErrorsImportPath)
+	if !ok {
+		return "", errors.Errorf("handler %s doesn't use the %s package", handler, sdkErrorsImportPath)
+	}
+
+	typesName, hasTypesImport := importName(file, typesImportPath)
+	if !hasTypesImport {
+		typesName = path.Base(typesImportPath)
+	}
+
+	content, err = xast.ModifyFunction(
+		content,
+		handler,
+		xast.ReplaceFuncSelector(sdkErrorsName+"."+invalidRequestError, typesName+"."+name),
+	)
+	if err != nil {
+		return "", errors.Errorf("can't replace the %s.%s errors of the handler %s: %w", sdkErrorsName, invalidRequestError, handler, err)
+	}
+
+	content, err = xast.RemoveUnusedImports(content, sdkErrorsImportPath)
+	if err != nil {
+		return "", err
+	}
+
+	if !hasTypesImport {
+		return xast.AppendImports(content, xast.WithImport(typesImportPath))
+	}
+	return content, nil
+}
+
+// importName returns the name used to reference an imported package in the file.
+func importName(file *ast.File, importPath string) (string, bool) {
+	for name, p := range goanalysis.FormatImports(file) {
+		if p == importPath {
+			return name, true
+		}
+	}
+	return "", false
+}
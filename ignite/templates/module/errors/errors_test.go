@@ -0,0 +1,154 @@
+package moduleerrors
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const errorsContent = `package types
+
+// DONTCOVER
+
+import (
+	"cosmossdk.io/errors"
+)
+
+// x/blog module sentinel errors
+var (
+	ErrInvalidSigner = errors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
+	ErrInvalidPacketTimeout = errors.Register(ModuleName, 1500, "invalid packet timeout")
+)
+`
+
+func TestNextErrorCode(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    uint32
+	}{
+		{
+			name:    "registered errors",
+			content: errorsContent,
+			want:    1501,
+		},
+		{
+			name: "no registered errors",
+			content: `package types
+
+var ErrNotRegistered = errors.New("not registered")
+`,
+			want: 1100,
+		},
+		{
+			name: "errors registered in several blocks",
+			content: `package types
+
+var ErrFoo = errorsmod.Register(ModuleName, 2, "foo")
+
+var (
+	ErrBar = errorsmod.Register(ModuleName, 12, "bar")
+)
+`,
+			want: 13,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := NextErrorCode(tc.content)
+			require.NoError(t, err)
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestAppendError(t *testing.T) {
+	got, err := appendError(errorsContent, "ErrPostNotFound", "post not found")
+	require.NoError(t, err)
+
+	normalized := normalize(got)
+	require.Contains(t, normalized, `ErrInvalidPacketTimeout=errors.Register(ModuleName,1500,"invalidpackettimeout")ErrPostNotFound=errors.Register(ModuleName,1501,"postnotfound"))`)
+
+	got, err = appendError(got, "ErrPostExists", `post "already" exists`)
+	require.NoError(t, err)
+	require.Contains(t, normalize(got), `ErrPostExists=errors.Register(ModuleName,1502,"post\"already\"exists")`)
+
+	_, err = appendError(got, "ErrPostNotFound", "post not found")
+	require.Error(t, err)
+}
+
+func TestAppendErrorWithoutErrorsBlock(t *testing.T) {
+	got, err := appendError(`package types
+`, "ErrPostNotFound", "post not found")
+	require.NoError(t, err)
+
+	normalized := normalize(got)
+	require.Contains(t, normalized, `errorsmod"cosmossdk.io/errors"`)
+	require.Contains(t, normalized, `varErrPostNotFound=errorsmod.Register(ModuleName,1100,"postnotfound")`)
+}
+
+const handlerContent = `package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/test/blog/x/blog/types"
+)
+
+func (k msgServer) CreatePost(ctx context.Context, msg *types.MsgCreatePost) (*types.MsgCreatePostResponse, error) {
+	if msg.Title == "" {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty title")
+	}
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "invalid address")
+	}
+	return &types.MsgCreatePostResponse{}, nil
+}
+
+func (k msgServer) DeletePost(ctx context.Context, msg *types.MsgDeletePost) (*types.MsgDeletePostResponse, error) {
+	return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "not implemented")
+}
+`
+
+func TestReplaceInHandler(t *testing.T) {
+	got, err := replaceInHandler(handlerContent, "CreatePost", "github.com/test/blog/x/blog/types", "ErrEmptyTitle")
+	require.NoError(t, err)
+
+	normalized := normalize(got)
+	require.Contains(t, normalized, `returnnil,errorsmod.Wrap(types.ErrEmptyTitle,"emptytitle")`)
+	require.Contains(t, normalized, `returnnil,errorsmod.Wrap(sdkerrors.ErrInvalidRequest,"notimplemented")`)
+	require.Contains(t, normalized, `sdkerrors"github.com/cosmos/cosmos-sdk/types/errors"`)
+}
+
+func TestReplaceInHandlerRemovesUnusedImport(t *testing.T) {
+	got, err := replaceInHandler(handlerContent, "DeletePost", "github.com/test/blog/x/blog/types", "ErrNotImplemented")
+	require.NoError(t, err)
+
+	got, err = replaceInHandler(got, "CreatePost", "github.com/test/blog/x/blog/types", "ErrEmptyTitle")
+	require.NoError(t, err)
+	require.Contains(t, got, "sdkerrors.ErrInvalidAddress")
+
+	content := strings.ReplaceAll(handlerContent, "sdkerrors.ErrInvalidAddress", "types.ErrInvalidSigner")
+	got, err = replaceInHandler(content, "CreatePost", "github.com/test/blog/x/blog/types", "ErrEmptyTitle")
+	require.NoError(t, err)
+	got, err = replaceInHandler(got, "DeletePost", "github.com/test/blog/x/blog/types", "ErrNotImplemented")
+	require.NoError(t, err)
+	require.NotContains(t, got, "sdkerrors")
+}
+
+func TestReplaceInHandlerErrors(t *testing.T) {
+	_, err := replaceInHandler(handlerContent, "UpdatePost", "github.com/test/blog/x/blog/types", "ErrFoo")
+	require.ErrorContains(t, err, `function "UpdatePost" not found`)
+
+	content := strings.ReplaceAll(handlerContent, "sdkerrors.ErrInvalidRequest", "sdkerrors.ErrLogic")
+	_, err = replaceInHandler(content, "CreatePost", "github.com/test/blog/x/blog/types", "ErrFoo")
+	require.ErrorContains(t, err, "function selectors not found")
+}
+
+func normalize(content string) string {
+	return strings.Join(strings.Fields(content), "")
+}
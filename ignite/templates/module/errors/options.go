@@ -0,0 +1,30 @@
+package moduleerrors
+
+import (
+	"fmt"
+	"path/filepath"
+)
+
+// Options represents the options to scaffold a module error.
+type Options struct {
+	ModuleName  string
+	ModulePath  string
+	ErrorName   string
+	Description string
+
+	// ReplaceInFile is the keeper file declaring the handler in which the generic
+	// invalid request error is replaced with the new error.
+	ReplaceInFile string
+	// ReplaceInHandler is the name of the handler in which the error is replaced.
+	ReplaceInHandler string
+}
+
+// ErrorsFile returns the path to the module sentinel errors file.
+func (opts Options) ErrorsFile() string {
+	return filepath.Join("x", opts.ModuleName, "types", "errors.go")
+}
+
+// TypesImportPath returns the import path of the module types package.
+func (opts Options) TypesImportPath() string {
+	return fmt.Sprintf("%s/x/%s/types", opts.ModulePath, opts.ModuleName)
+}
@@ -0,0 +1,123 @@
+package modulehooks
+
+import (
+	"io/fs"
+
+	"github.com/gobuffalo/genny/v2"
+	"github.com/gobuffalo/plush/v4"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/pkg/xast"
+	"github.com/ignite/cli/v29/ignite/pkg/xgenny"
+)
+
+const invokeSetHooks = `// InvokeSetHooks sets the hooks provided by other modules on the keeper.
+// The hooks are called in the alphabetical order of the modules providing them.
+func InvokeSetHooks(k keeper.Keeper, hooks map[string]types.HooksWrapper) error {
+	if len(hooks) == 0 {
+		return nil
+	}
+
+	var multiHooks types.MultiHooks
+	for _, name := range slices.Sorted(maps.Keys(hooks)) {
+		multiHooks = append(multiHooks, hooks[name])
+	}
+	k.SetHooks(multiHooks)
+
+	return nil
+}
+`
+
+// NewGenerator returns the generator to scaffold the hooks of a module.
+func NewGenerator(opts *Options) (*genny.Generator, error) {
+	subFS, err := fs.Sub(files, "files")
+	if err != nil {
+		return nil, errors.Errorf("fail to generate sub: %w", err)
+	}
+
+	g := genny.New()
+	if err := g.OnlyFS(subFS, nil, nil); err != nil {
+		return g, err
+	}
+
+	ctx := plush.NewContext()
+	ctx.Set("moduleName", opts.ModuleName)
+	ctx.Set("modulePath", opts.ModulePath)
+
+	g.Transformer(xgenny.Transformer(ctx))
+	g.Transformer(genny.Replace("{{moduleName}}", opts.ModuleName))
+	g.RunFn(keeperModify(opts))
+	g.RunFn(depinjectModify(opts))
+
+	return g, nil
+}
+
+func keeperModify(opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		f, err := r.Disk.Find(opts.KeeperFile())
+		if err != nil {
+			return err
+		}
+
+		content, err := xast.ModifyStruct(
+			f.String(),
+			"Keeper",
+			xast.AppendStructValue("hooks", "*types.Hooks"),
+		)
+		if err != nil {
+			return err
+		}
+
+		// the hooks are referenced by pointer to be shared by the keeper copies,
+		// so they can be set once the keeper is provided to the other modules.
+		content, err = xast.ModifyFunction(
+			content,
+			"NewKeeper",
+			xast.AppendFuncStruct("Keeper", "hooks", "new(types.Hooks)"),
+		)
+		if err != nil {
+			return err
+		}
+
+		return r.File(genny.NewFileS(opts.KeeperFile(), content))
+	}
+}
+
+func depinjectModify(opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		f, err := r.Disk.Find(opts.DepinjectFile())
+		if err != nil {
+			return err
+		}
+
+		content, err := addInvokeSetHooks(f.String())
+		if err != nil {
+			return err
+		}
+
+		return r.File(genny.NewFileS(opts.DepinjectFile(), content))
+	}
+}
+
+// addInvokeSetHooks registers the function setting the hooks provided by other modules in the module app config.
+func addInvokeSetHooks(content string) (string, error) {
+	content, err := xast.AppendImports(
+		content,
+		xast.WithImport("maps"),
+		xast.WithImport("slices"),
+	)
+	if err != nil {
+		return "", err
+	}
+
+	content, err = xast.ModifyFunction(
+		content,
+		"init",
+		xast.AppendInsideFuncCall("appconfig.Register", "appconfig.Invoke(InvokeSetHooks)", -1),
+	)
+	if err != nil {
+		return "", err
+	}
+
+	return content + "\n" + invokeSetHooks, nil
+}
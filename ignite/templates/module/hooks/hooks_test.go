@@ -0,0 +1,34 @@
+package modulehooks
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddInvokeSetHooks(t *testing.T) {
+	content := `package blog
+
+import (
+	"cosmossdk.io/depinject/appconfig"
+
+	"github.com/test/blog/x/blog/types"
+)
+
+func init() {
+	appconfig.Register(
+		&types.Module{},
+		appconfig.Provide(ProvideModule),
+	)
+}
+`
+
+	got, err := addInvokeSetHooks(content)
+	require.NoError(t, err)
+	require.Contains(t, got, `"maps"`)
+	require.Contains(t, got, `"slices"`)
+	require.Contains(t, got, "appconfig.Provide(ProvideModule), appconfig.Invoke(InvokeSetHooks)")
+	require.Contains(t, got, "func InvokeSetHooks(k keeper.Keeper, hooks map[string]types.HooksWrapper) error {")
+	require.Equal(t, 1, strings.Count(got, "func init()"))
+}
@@ -0,0 +1,24 @@
+package modulehooks
+
+import "path/filepath"
+
+// Options represents the options to scaffold the hooks of a module.
+type Options struct {
+	ModuleName string
+	ModulePath string
+}
+
+// HooksFile returns the path to the module hooks definition file.
+func (opts Options) HooksFile() string {
+	return filepath.Join("x", opts.ModuleName, "types", "hooks.go")
+}
+
+// KeeperFile returns the path to the module keeper definition file.
+func (opts Options) KeeperFile() string {
+	return filepath.Join("x", opts.ModuleName, "keeper", "keeper.go")
+}
+
+// DepinjectFile returns the path to the module dependency injection file.
+func (opts Options) DepinjectFile() string {
+	return filepath.Join("x", opts.ModuleName, "module", "depinject.go")
+}
@@ -0,0 +1,100 @@
+package remove
+
+import (
+	"path/filepath"
+
+	"github.com/ignite/cli/v29/ignite/pkg/xast"
+)
+
+// wiring returns the code to remove from the wiring files of the module, by file path relative
+// to the app path. The code is the one added to the files when the component is scaffolded.
+func (opts *Options) wiring() map[string][]xast.RemoveOptions {
+	var (
+		keeperDir = filepath.Join("x", opts.ModuleName, "keeper")
+		typesDir  = filepath.Join("x", opts.ModuleName, "types")
+		moduleDir = filepath.Join("x", opts.ModuleName, "module")
+		autocli   = filepath.Join(moduleDir, "autocli.go")
+	)
+
+	// the AutoCLI options of all the components are removed by their RPC methods.
+	wiring := map[string][]xast.RemoveOptions{autocli: nil}
+	for _, rpc := range opts.RPCs {
+		wiring[autocli] = append(wiring[autocli], xast.RemoveFuncElement("AutoCLIOptions", "RpcMethod", rpc))
+	}
+
+	switch opts.Kind {
+	case KindType:
+		var (
+			pascal = opts.Name.PascalCase
+			upper  = opts.Name.UpperCamel
+			lower  = opts.Name.LowerCamel
+
+			// keeperFields are the collections of the type in the keeper.
+			keeperFields = []string{upper, upper + "Seq"}
+
+			// genesisFields are the fields of the genesis state holding the values of the type.
+			genesisFields = []string{upper, upper + "List", upper + "Map", upper + "Count"}
+
+			// genesisIdents are the identifiers used by the genesis code of the type.
+			genesisIdents = append(append(
+				[]string{"Get" + upper, "Get" + upper + "List", "Get" + upper + "Map", "Get" + upper + "Count"},
+				keeperFields...), genesisFields...,
+			)
+
+			msgs = []string{"MsgCreate" + pascal, "MsgUpdate" + pascal, "MsgDelete" + pascal}
+		)
+
+		wiring[filepath.Join(keeperDir, "keeper.go")] = []xast.RemoveOptions{
+			xast.RemoveStructField("Keeper", keeperFields...),
+			xast.RemoveFuncStruct("NewKeeper", "Keeper", keeperFields...),
+		}
+		wiring[filepath.Join(keeperDir, "genesis.go")] = []xast.RemoveOptions{
+			xast.RemoveFuncCode("InitGenesis", genesisIdents...),
+			xast.RemoveFuncCode("ExportGenesis", genesisIdents...),
+		}
+		wiring[filepath.Join(keeperDir, "genesis_test.go")] = []xast.RemoveOptions{
+			xast.RemoveFuncStruct("TestGenesis", "GenesisState", genesisFields...),
+			xast.RemoveFuncCode("TestGenesis", genesisFields...),
+		}
+		wiring[filepath.Join(typesDir, "genesis.go")] = []xast.RemoveOptions{
+			xast.RemoveFuncStruct("DefaultGenesis", "GenesisState", genesisFields...),
+			xast.RemoveFuncCode("Validate", genesisIdents...),
+		}
+		wiring[filepath.Join(typesDir, "genesis_test.go")] = []xast.RemoveOptions{
+			xast.RemoveFuncStruct("TestGenesisState_Validate", "GenesisState", genesisFields...),
+			xast.RemoveFuncElement("TestGenesisState_Validate", "desc", "duplicated "+lower),
+			xast.RemoveFuncElement("TestGenesisState_Validate", "desc", "invalid "+lower+" count"),
+		}
+		wiring[filepath.Join(typesDir, "keys.go")] = []xast.RemoveOptions{
+			xast.RemoveGlobal(pascal+"Key", pascal+"CountKey", upper+"Key", upper+"CountKey"),
+		}
+		wiring[filepath.Join(typesDir, "codec.go")] = []xast.RemoveOptions{
+			xast.RemoveFuncCode("RegisterInterfaces", msgs...),
+		}
+
+		wiring[filepath.Join(moduleDir, "simulation.go")] = append(
+			simulationWiring(msgs...),
+			xast.RemoveFuncStruct("GenerateGenesisState", "GenesisState", genesisFields...),
+		)
+	case KindMessage:
+		msg := "Msg" + opts.Name.PascalCase
+		wiring[filepath.Join(typesDir, "codec.go")] = []xast.RemoveOptions{
+			xast.RemoveFuncCode("RegisterInterfaces", msg),
+		}
+		wiring[filepath.Join(moduleDir, "simulation.go")] = simulationWiring(msg)
+	}
+
+	return wiring
+}
+
+// simulationWiring returns the code to remove from the module simulation for the simulation operations of the messages.
+func simulationWiring(msgs ...string) []xast.RemoveOptions {
+	var idents []string
+	for _, msg := range msgs {
+		idents = append(idents, "opWeight"+msg, "defaultWeight"+msg, "weight"+msg)
+	}
+	return []xast.RemoveOptions{
+		xast.RemoveFuncCode("WeightedOperations", idents...),
+		xast.RemoveFuncCode("ProposalMsgs", idents...),
+	}
+}
@@ -0,0 +1,397 @@
+package remove
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/v29/ignite/pkg/multiformatname"
+	"github.com/ignite/cli/v29/ignite/pkg/xast"
+)
+
+func TestWiring(t *testing.T) {
+	tests := []struct {
+		name      string
+		kind      Kind
+		component string
+		file      string
+		rpcs      []string
+		content   string
+		want      string
+	}{
+		{
+			name:      "keeper collections of a list",
+			kind:      KindType,
+			component: "post",
+			file:      "keeper/keeper.go",
+			content: `package keeper
+
+type Keeper struct {
+	Schema  collections.Schema
+	Params  collections.Item[types.Params]
+	PostSeq collections.Sequence
+	Post    collections.Map[uint64, types.Post]
+	Comment collections.Map[string, types.Comment]
+}
+
+func NewKeeper(sb *collections.SchemaBuilder) Keeper {
+	k := Keeper{
+		Params:  collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		Post:    collections.NewMap(sb, types.PostKey, "post", collections.Uint64Key, codec.CollValue[types.Post](cdc)),
+		PostSeq: collections.NewSequence(sb, types.PostCountKey, "postSequence"),
+		Comment: collections.NewMap(sb, types.CommentKey, "comment", collections.StringKey, codec.CollValue[types.Comment](cdc)),
+	}
+	return k
+}
+`,
+			want: `package keeper
+
+type Keeper struct {
+	Schema  collections.Schema
+	Params  collections.Item[types.Params]
+	Comment collections.Map[string, types.Comment]
+}
+
+func NewKeeper(sb *collections.SchemaBuilder) Keeper {
+	k := Keeper{
+		Params:  collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		Comment: collections.NewMap(sb, types.CommentKey, "comment", collections.StringKey, codec.CollValue[types.Comment](cdc)),
+	}
+	return k
+}
+`,
+		},
+		{
+			name:      "genesis of a list",
+			kind:      KindType,
+			component: "post",
+			file:      "keeper/genesis.go",
+			content: `package keeper
+
+// InitGenesis initializes the module's state from a provided genesis state.
+func (k Keeper) InitGenesis(ctx context.Context, genState types.GenesisState) error {
+	for _, elem := range genState.PostList {
+		if err := k.Post.Set(ctx, elem.Id, elem); err != nil {
+			return err
+		}
+	}
+
+	if err := k.PostSeq.Set(ctx, genState.PostCount); err != nil {
+		return err
+	}
+	for _, elem := range genState.CommentMap {
+		if err := k.Comment.Set(ctx, elem.Key, elem); err != nil {
+			return err
+		}
+	}
+
+	return k.Params.Set(ctx, genState.Params)
+}
+
+// ExportGenesis returns the module's exported genesis.
+func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
+	var err error
+
+	genesis := types.DefaultGenesis()
+	genesis.Params, err = k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	err = k.Post.Walk(ctx, nil, func(key uint64, elem types.Post) (bool, error) {
+		genesis.PostList = append(genesis.PostList, elem)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	genesis.PostCount, err = k.PostSeq.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return genesis, nil
+}
+`,
+			want: `package keeper
+
+// InitGenesis initializes the module's state from a provided genesis state.
+func (k Keeper) InitGenesis(ctx context.Context, genState types.GenesisState) error {
+	for _, elem := range genState.CommentMap {
+		if err := k.Comment.Set(ctx, elem.Key, elem); err != nil {
+			return err
+		}
+	}
+
+	return k.Params.Set(ctx, genState.Params)
+}
+
+// ExportGenesis returns the module's exported genesis.
+func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
+	var err error
+
+	genesis := types.DefaultGenesis()
+	genesis.Params, err = k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return genesis, nil
+}
+`,
+		},
+		{
+			name:      "codec of a message",
+			kind:      KindMessage,
+			component: "like-post",
+			file:      "types/codec.go",
+			content: `package types
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+func RegisterInterfaces(registrar codectypes.InterfaceRegistry) {
+	registrar.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgLikePost{},
+	)
+
+	registrar.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgUpdateParams{},
+	)
+	msgservice.RegisterMsgServiceDesc(registrar, &_Msg_serviceDesc)
+}
+`,
+			want: `package types
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+func RegisterInterfaces(registrar codectypes.InterfaceRegistry) {
+	registrar.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgUpdateParams{},
+	)
+	msgservice.RegisterMsgServiceDesc(registrar, &_Msg_serviceDesc)
+}
+`,
+		},
+		{
+			name:      "autocli options of a query",
+			kind:      KindQuery,
+			component: "count-posts",
+			file:      "module/autocli.go",
+			rpcs:      []string{"CountPosts"},
+			content: `package blog
+
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: types.Query_serviceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Shows the parameters of the module",
+				},
+				{
+					RpcMethod:      "CountPosts",
+					Use:            "count-posts [author]",
+					Short:          "",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "author"}},
+				},
+				// this line is used by ignite scaffolding # autocli/query
+			},
+		},
+	}
+}
+`,
+			want: `package blog
+
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: types.Query_serviceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Shows the parameters of the module",
+				},
+				// this line is used by ignite scaffolding # autocli/query
+			},
+		},
+	}
+}
+`,
+		},
+		{
+			name:      "unused imports removed",
+			kind:      KindType,
+			component: "post",
+			file:      "types/keys.go",
+			content: `package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/collections"
+)
+
+var PostKey = collections.NewPrefix("post/value/")
+
+func Validate(ids map[string]struct{}) error {
+	for id := range ids {
+		return fmt.Errorf("duplicated post %s", id)
+	}
+	return nil
+}
+`,
+			want: `package types
+
+import (
+	"fmt"
+)
+
+func Validate(ids map[string]struct{}) error {
+	for id := range ids {
+		return fmt.Errorf("duplicated post %s", id)
+	}
+	return nil
+}
+`,
+		},
+		{
+			name:      "simulation of a type",
+			kind:      KindType,
+			component: "bar",
+			file:      "module/simulation.go",
+			content: `package blog
+
+import (
+	"math/rand"
+
+	blogsimulation "example.com/blog/x/blog/simulation"
+)
+
+func (am AppModule) WeightedOperations(simState module.SimulationState) []simtypes.WeightedOperation {
+	operations := make([]simtypes.WeightedOperation, 0)
+	const (
+		opWeightMsgCreateFooBar          = "op_weight_msg_blog"
+		defaultWeightMsgCreateFooBar int = 100
+	)
+
+	var weightMsgCreateFooBar int
+	simState.AppParams.GetOrGenerate(opWeightMsgCreateFooBar, &weightMsgCreateFooBar, nil,
+		func(_ *rand.Rand) {
+			weightMsgCreateFooBar = defaultWeightMsgCreateFooBar
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgCreateFooBar,
+		blogsimulation.SimulateMsgCreateFooBar(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+
+	const (
+		opWeightMsgCreateBar          = "op_weight_msg_blog"
+		defaultWeightMsgCreateBar int = 100
+	)
+
+	var weightMsgCreateBar int
+	simState.AppParams.GetOrGenerate(opWeightMsgCreateBar, &weightMsgCreateBar, nil,
+		func(_ *rand.Rand) {
+			weightMsgCreateBar = defaultWeightMsgCreateBar
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgCreateBar,
+		blogsimulation.SimulateMsgCreateBar(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+
+	return operations
+}
+`,
+			want: `package blog
+
+import (
+	"math/rand"
+
+	blogsimulation "example.com/blog/x/blog/simulation"
+)
+
+func (am AppModule) WeightedOperations(simState module.SimulationState) []simtypes.WeightedOperation {
+	operations := make([]simtypes.WeightedOperation, 0)
+	const (
+		opWeightMsgCreateFooBar          = "op_weight_msg_blog"
+		defaultWeightMsgCreateFooBar int = 100
+	)
+
+	var weightMsgCreateFooBar int
+	simState.AppParams.GetOrGenerate(opWeightMsgCreateFooBar, &weightMsgCreateFooBar, nil,
+		func(_ *rand.Rand) {
+			weightMsgCreateFooBar = defaultWeightMsgCreateFooBar
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgCreateFooBar,
+		blogsimulation.SimulateMsgCreateFooBar(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+
+	return operations
+}
+`,
+		},
+		{
+			name:      "codec of a type with the same suffix as another one",
+			kind:      KindType,
+			component: "bar",
+			file:      "types/codec.go",
+			content: `package types
+
+func RegisterInterfaces(registrar codectypes.InterfaceRegistry) {
+	registrar.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgCreateFooBar{},
+		&MsgUpdateFooBar{},
+		&MsgDeleteFooBar{},
+	)
+}
+`,
+		},
+		{
+			name:      "component not wired",
+			kind:      KindType,
+			component: "post",
+			file:      "types/genesis.go",
+			content: `package types
+
+// DefaultGenesis returns the default genesis state
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		Params:    DefaultParams(),
+		CommentMap: []Comment{}}
+}
+`,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			name, err := multiformatname.NewName(tc.component)
+			require.NoError(t, err)
+
+			opts := &Options{ModuleName: "blog", Kind: tc.kind, Name: name, RPCs: tc.rpcs}
+			got, err := xast.RemoveCode(tc.content, opts.wiring()[filepath.Join("x", "blog", tc.file)]...)
+			require.NoError(t, err)
+
+			want := tc.want
+			if want == "" {
+				want = tc.content
+			}
+			require.Equal(t, want, got)
+		})
+	}
+}
@@ -0,0 +1,108 @@
+package remove
+
+import (
+	"maps"
+	"path/filepath"
+	"slices"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/pkg/multiformatname"
+)
+
+// Kind is the kind of a scaffolded component.
+type Kind string
+
+const (
+	// KindType is a type scaffolded with "ignite scaffold list|map|single|type".
+	KindType Kind = "type"
+
+	// KindMessage is a message scaffolded with "ignite scaffold message".
+	KindMessage Kind = "message"
+
+	// KindQuery is a query scaffolded with "ignite scaffold query".
+	KindQuery Kind = "query"
+)
+
+// Kinds returns the kinds of components that can be removed.
+func Kinds() []Kind {
+	return []Kind{KindType, KindMessage, KindQuery}
+}
+
+// Options represents the options to remove a scaffolded component.
+type Options struct {
+	ModuleName string
+	Kind       Kind
+	Name       multiformatname.Name
+
+	// ProtoFiles are the proto files of the module to remove the component definitions from.
+	ProtoFiles []string
+
+	// GoFiles are the wiring files of the module to remove the component code from.
+	GoFiles []string
+
+	// RPCs are the names of the component RPCs in the services of the module.
+	RPCs []string
+
+	// Messages are the names of the component proto messages defined in the proto files of the module.
+	Messages []string
+
+	// ProtoImport is the import path of the proto file removed with a type, if any.
+	ProtoImport string
+}
+
+// Validate that options are usable.
+func (opts *Options) Validate() error {
+	if !slices.Contains(Kinds(), opts.Kind) {
+		return errors.Errorf("unknown component kind %q", opts.Kind)
+	}
+	return nil
+}
+
+// ComponentFiles returns the Go files scaffolded for the component,
+// relative to the app path. The files may not exist, depending on the scaffold options.
+func (opts *Options) ComponentFiles() []string {
+	var (
+		name       = opts.Name.Snake
+		keeperDir  = filepath.Join("x", opts.ModuleName, "keeper")
+		typesDir   = filepath.Join("x", opts.ModuleName, "types")
+		simulation = filepath.Join("x", opts.ModuleName, "simulation", name+".go")
+	)
+
+	switch opts.Kind {
+	case KindType:
+		return []string{
+			filepath.Join(keeperDir, "msg_server_"+name+".go"),
+			filepath.Join(keeperDir, "msg_server_"+name+"_test.go"),
+			filepath.Join(keeperDir, "query_"+name+".go"),
+			filepath.Join(keeperDir, "query_"+name+"_test.go"),
+			filepath.Join(keeperDir, name+"_indexes.go"),
+			filepath.Join(keeperDir, "query_"+name+"_indexes.go"),
+			filepath.Join(keeperDir, "query_"+name+"_indexes_test.go"),
+			filepath.Join(typesDir, "key_"+name+".go"),
+			filepath.Join(typesDir, "key_"+name+"_indexes.go"),
+			filepath.Join(typesDir, "messages_"+name+".go"),
+			simulation,
+		}
+	case KindMessage:
+		return []string{
+			filepath.Join(keeperDir, "msg_server_"+name+".go"),
+			filepath.Join(keeperDir, "msg_server_"+name+"_test.go"),
+			filepath.Join(typesDir, "message_"+name+".go"),
+			simulation,
+		}
+	case KindQuery:
+		return []string{
+			filepath.Join(keeperDir, "query_"+name+".go"),
+			filepath.Join(keeperDir, "query_"+name+"_test.go"),
+		}
+	}
+	return nil
+}
+
+// WiringFiles returns the Go files of the module the component is wired into,
+// relative to the app path. The files may not exist, depending on the scaffold options.
+func (opts *Options) WiringFiles() []string {
+	files := slices.Collect(maps.Keys(opts.wiring()))
+	slices.Sort(files)
+	return files
+}
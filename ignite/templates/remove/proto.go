@@ -0,0 +1,46 @@
+package remove
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/emicklei/proto"
+)
+
+// genesisStateMessage is the name of the proto message holding the genesis state of a module.
+const genesisStateMessage = "GenesisState"
+
+// stripProto removes the component definitions from a proto file: the RPCs of the component,
+// its messages, the genesis state fields of a type and the import of its proto file.
+func stripProto(pf *proto.Proto, opts *Options) {
+	pf.Elements = slices.DeleteFunc(pf.Elements, func(elem proto.Visitee) bool {
+		switch elem := elem.(type) {
+		case *proto.Import:
+			return opts.ProtoImport != "" && elem.Filename == opts.ProtoImport
+		case *proto.Message:
+			if slices.Contains(opts.Messages, elem.Name) {
+				return true
+			}
+			if opts.Kind == KindType && elem.Name == genesisStateMessage {
+				elem.Elements = slices.DeleteFunc(elem.Elements, func(elem proto.Visitee) bool {
+					field, ok := elem.(*proto.NormalField)
+					return ok && isTypeField(field, opts)
+				})
+			}
+		case *proto.Service:
+			elem.Elements = slices.DeleteFunc(elem.Elements, func(elem proto.Visitee) bool {
+				rpc, ok := elem.(*proto.RPC)
+				return ok && slices.Contains(opts.RPCs, rpc.Name)
+			})
+		}
+		return false
+	})
+}
+
+// isTypeField checks if a genesis state field holds the values of the type, or its count for a list.
+func isTypeField(field *proto.NormalField, opts *Options) bool {
+	name := opts.Name.PascalCase
+	return field.Type == name ||
+		strings.HasSuffix(field.Type, "."+name) ||
+		field.Name == opts.Name.Snake+"_count"
+}
@@ -0,0 +1,95 @@
+package remove
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ignite/cli/v29/ignite/pkg/multiformatname"
+	"github.com/ignite/cli/v29/ignite/pkg/protoanalysis/protoutil"
+)
+
+const genesisProto = `syntax = "proto3";
+package blog.blog.v1;
+
+import "blog/blog/v1/params.proto";
+import "blog/blog/v1/post.proto";
+
+// GenesisState defines the blog module's genesis state.
+message GenesisState {
+  Params params = 1;
+  repeated Post post_list = 2;
+  uint64 post_count = 3;
+}
+`
+
+const txProto = `syntax = "proto3";
+package blog.blog.v1;
+
+service Msg {
+  rpc UpdateParams(MsgUpdateParams) returns (MsgUpdateParamsResponse);
+  rpc LikePost(MsgLikePost) returns (MsgLikePostResponse);
+}
+
+message MsgLikePost {
+  uint64 id = 1;
+}
+
+message MsgLikePostResponse {}
+`
+
+func TestStripProto(t *testing.T) {
+	tests := []struct {
+		name      string
+		content   string
+		opts      Options
+		component string
+		contains  []string
+		removed   []string
+	}{
+		{
+			name:      "genesis state of a list",
+			content:   genesisProto,
+			opts:      Options{Kind: KindType, ProtoImport: "blog/blog/v1/post.proto"},
+			component: "post",
+			contains:  []string{"blog/blog/v1/params.proto", "Params params = 1;"},
+			removed:   []string{"blog/blog/v1/post.proto", "post_list", "post_count"},
+		},
+		{
+			name:      "message",
+			content:   txProto,
+			opts:      Options{Kind: KindMessage, RPCs: []string{"LikePost"}, Messages: []string{"MsgLikePost", "MsgLikePostResponse"}},
+			component: "like-post",
+			contains:  []string{"rpc UpdateParams"},
+			removed:   []string{"LikePost"},
+		},
+		{
+			name:      "genesis state of a message",
+			content:   genesisProto,
+			opts:      Options{Kind: KindMessage, RPCs: []string{"LikePost"}},
+			component: "like-post",
+			contains:  []string{"blog/blog/v1/post.proto", "post_list", "post_count"},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			name, err := multiformatname.NewName(tc.component)
+			require.NoError(t, err)
+			tc.opts.Name = name
+
+			pf, err := protoutil.ParseProtoFile(strings.NewReader(tc.content))
+			require.NoError(t, err)
+
+			stripProto(pf, &tc.opts)
+			got := protoutil.Print(pf)
+
+			for _, s := range tc.contains {
+				require.Contains(t, got, s)
+			}
+			for _, s := range tc.removed {
+				require.NotContains(t, got, s)
+			}
+		})
+	}
+}
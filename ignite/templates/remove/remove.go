@@ -0,0 +1,74 @@
+// Package remove provides the generator removing a scaffolded component from a module:
+// its proto definitions and the Go code wiring it into the module.
+package remove
+
+import (
+	"github.com/gobuffalo/genny/v2"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/pkg/protoanalysis/protoutil"
+	"github.com/ignite/cli/v29/ignite/pkg/xast"
+)
+
+// NewGenerator returns the generator removing a component from the proto files and the wiring files of a module.
+// The files scaffolded for the component are not removed by the generator.
+func NewGenerator(opts *Options) (*genny.Generator, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+
+	g := genny.New()
+	for _, path := range opts.ProtoFiles {
+		g.RunFn(protoModify(opts, path))
+	}
+	for _, path := range opts.GoFiles {
+		g.RunFn(goModify(opts, path))
+	}
+
+	return g, nil
+}
+
+func protoModify(opts *Options, path string) genny.RunFn {
+	return func(r *genny.Runner) error {
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		protoFile, err := protoutil.ParseProtoFile(f)
+		if err != nil {
+			return errors.Errorf("failed while parsing proto file %s: %w", path, err)
+		}
+
+		content := protoutil.Print(protoFile)
+		stripProto(protoFile, opts)
+		stripped := protoutil.Print(protoFile)
+		if stripped == content {
+			// the file doesn't define the component, it must not be reported as modified.
+			r.Disk.Remove(path)
+			return nil
+		}
+
+		return r.File(genny.NewFileS(path, stripped))
+	}
+}
+
+func goModify(opts *Options, path string) genny.RunFn {
+	return func(r *genny.Runner) error {
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		content, err := xast.RemoveCode(f.String(), opts.wiring()[path]...)
+		if err != nil {
+			return errors.Errorf("failed while removing the %s from %s: %w", opts.Kind, path, err)
+		}
+		if content == f.String() {
+			r.Disk.Remove(path)
+			return nil
+		}
+
+		return r.File(genny.NewFileS(path, content))
+	}
+}
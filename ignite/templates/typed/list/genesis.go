@@ -202,7 +202,12 @@ require.Equal(t, genesisState.%[1]vCount, got.%[1]vCount)`, opts.TypeName.UpperC
 			xast.AppendFuncStruct(
 				"GenesisState",
 				fmt.Sprintf("%[1]vList", opts.TypeName.UpperCamel),
-				fmt.Sprintf("[]types.%[1]v{{ Id: 0 }, { Id: 1 }}", opts.TypeName.PascalCase),
+				fmt.Sprintf(
+					"[]types.%[1]v{{ Id: 0, %[2]v }, { Id: 1, %[3]v }}",
+					opts.TypeName.PascalCase,
+					opts.UniqueIndexesGenesisArgs(0),
+					opts.UniqueIndexesGenesisArgs(1),
+				),
 			),
 			xast.AppendFuncStruct(
 				"GenesisState",
@@ -275,7 +280,12 @@ func genesisTypesTestsModify(opts *typed.Options) genny.RunFn {
 			xast.AppendFuncStruct(
 				"GenesisState",
 				fmt.Sprintf("%[1]vList", opts.TypeName.UpperCamel),
-				fmt.Sprintf("[]types.%[1]v{{ Id: 0 }, { Id: 1 }}", opts.TypeName.PascalCase),
+				fmt.Sprintf(
+					"[]types.%[1]v{{ Id: 0, %[2]v }, { Id: 1, %[3]v }}",
+					opts.TypeName.PascalCase,
+					opts.UniqueIndexesGenesisArgs(0),
+					opts.UniqueIndexesGenesisArgs(1),
+				),
 			),
 			xast.AppendFuncStruct(
 				"GenesisState",
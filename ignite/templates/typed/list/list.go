@@ -14,6 +14,7 @@ import (
 	"github.com/ignite/cli/v29/ignite/pkg/protoanalysis/protoutil"
 	"github.com/ignite/cli/v29/ignite/pkg/xast"
 	"github.com/ignite/cli/v29/ignite/templates/typed"
+	"github.com/ignite/cli/v29/ignite/templates/typed/secondaryindex"
 )
 
 var (
@@ -51,6 +52,10 @@ func NewGenerator(opts *typed.Options) (*genny.Generator, error) {
 	// Genesis modifications
 	genesisModify(opts, g)
 
+	if err := secondaryindex.Register(g, opts, "List", true); err != nil {
+		return nil, err
+	}
+
 	if !opts.NoMessage {
 		// Modifications for new messages
 		g.RunFn(protoTxModify(opts))
@@ -68,6 +73,9 @@ func NewGenerator(opts *typed.Options) (*genny.Generator, error) {
 		if err := typed.Box(subMessages, opts, g); err != nil {
 			return nil, err
 		}
+		if err := typed.BoxValidation(opts, g); err != nil {
+			return nil, err
+		}
 	}
 
 	return g, typed.Box(subComponent, opts, g)
@@ -311,7 +319,7 @@ func keeperModify(opts *typed.Options) genny.RunFn {
 			),
 			xast.AppendStructValue(
 				opts.TypeName.UpperCamel,
-				fmt.Sprintf("collections.Map[uint64, types.%[1]v]", opts.TypeName.PascalCase),
+				opts.CollectionsType(),
 			),
 		)
 		if err != nil {
@@ -325,10 +333,7 @@ func keeperModify(opts *typed.Options) genny.RunFn {
 			xast.AppendFuncStruct(
 				"Keeper",
 				opts.TypeName.UpperCamel,
-				fmt.Sprintf(`collections.NewMap(sb, types.%[1]vKey, "%[2]v", collections.Uint64Key, codec.CollValue[types.%[1]v](cdc))`,
-					opts.TypeName.PascalCase,
-					opts.TypeName.LowerCamel,
-				),
+				opts.CollectionsConstructor(),
 			),
 			xast.AppendFuncStruct(
 				"Keeper",
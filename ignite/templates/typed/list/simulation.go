@@ -29,10 +29,12 @@ func moduleSimulationModify(opts *typed.Options) genny.RunFn {
 				"GenesisState",
 				fmt.Sprintf("%[1]vList", opts.TypeName.UpperCamel),
 				fmt.Sprintf(
-					"[]types.%[2]v{{ Id: 0, %[3]v }, { Id: 1, %[3]v }}",
+					"[]types.%[2]v{{ Id: 0, %[3]v%[4]v }, { Id: 1, %[3]v%[5]v }}",
 					opts.TypeName.UpperCamel,
 					opts.TypeName.PascalCase,
 					msgField,
+					opts.UniqueIndexesGenesisArgs(0),
+					opts.UniqueIndexesGenesisArgs(1),
 				),
 			),
 			xast.AppendFuncStruct(
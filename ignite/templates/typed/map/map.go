@@ -14,8 +14,10 @@ import (
 	"github.com/ignite/cli/v29/ignite/pkg/gomodulepath"
 	"github.com/ignite/cli/v29/ignite/pkg/protoanalysis/protoutil"
 	"github.com/ignite/cli/v29/ignite/pkg/xast"
+	"github.com/ignite/cli/v29/ignite/templates/field"
 	"github.com/ignite/cli/v29/ignite/templates/field/datatype"
 	"github.com/ignite/cli/v29/ignite/templates/typed"
+	"github.com/ignite/cli/v29/ignite/templates/typed/secondaryindex"
 )
 
 var (
@@ -40,8 +42,10 @@ func NewGenerator(opts *typed.Options) (*genny.Generator, error) {
 	// Tests are not generated for map with a custom index that contains only booleans
 	// because we can't generate reliable tests for this type
 	var generateTest bool
-	if opts.Index.DatatypeName != datatype.Bool {
-		generateTest = true
+	for _, index := range opts.Indexes {
+		if index.DatatypeName != datatype.Bool {
+			generateTest = true
+		}
 	}
 
 	subMessages, err := fs.Sub(fsMessages, "files/messages")
@@ -75,6 +79,10 @@ func NewGenerator(opts *typed.Options) (*genny.Generator, error) {
 	g.RunFn(genesisTestsModify(opts))
 	g.RunFn(genesisTypesTestsModify(opts))
 
+	if err := secondaryindex.Register(g, opts, "Map", generateTest); err != nil {
+		return nil, err
+	}
+
 	// Modifications for new messages
 	if !opts.NoMessage {
 		g.RunFn(protoTxModify(opts))
@@ -91,6 +99,9 @@ func NewGenerator(opts *typed.Options) (*genny.Generator, error) {
 		if err := typed.Box(subMessages, opts, g); err != nil {
 			return nil, err
 		}
+		if err := typed.BoxValidation(opts, g); err != nil {
+			return nil, err
+		}
 		if generateTest {
 			if err := typed.Box(subTestsMessages, opts, g); err != nil {
 				return nil, err
@@ -120,7 +131,7 @@ func keeperModify(opts *typed.Options) genny.RunFn {
 			"Keeper",
 			xast.AppendStructValue(
 				opts.TypeName.UpperCamel,
-				fmt.Sprintf("collections.Map[%[1]v, types.%[2]v]", opts.Index.DataType(), opts.TypeName.PascalCase),
+				opts.CollectionsType(),
 			),
 		)
 		if err != nil {
@@ -134,11 +145,7 @@ func keeperModify(opts *typed.Options) genny.RunFn {
 			xast.AppendFuncStruct(
 				"Keeper",
 				opts.TypeName.UpperCamel,
-				fmt.Sprintf(`collections.NewMap(sb, types.%[1]vKey, "%[2]v", %[3]v, codec.CollValue[types.%[1]v](cdc))`,
-					opts.TypeName.PascalCase,
-					opts.TypeName.LowerCamel,
-					opts.Index.CollectionsKeyValueType(),
-				),
+				opts.CollectionsConstructor(),
 			),
 		)
 		if err != nil {
@@ -172,7 +179,11 @@ func protoRPCModify(opts *typed.Options) genny.RunFn {
 			return errors.Errorf("failed while adding imports in %s: %w", path, err)
 		}
 
-		protoIndex := fmt.Sprintf("{%s}", opts.Index.ProtoFieldName())
+		protoIndexes := make([]string, len(opts.Indexes))
+		for i, index := range opts.Indexes {
+			protoIndexes[i] = fmt.Sprintf("{%s}", index.ProtoFieldName())
+		}
+		protoIndex := strings.Join(protoIndexes, "/")
 		appModulePath := gomodulepath.ExtractAppPath(opts.ModulePath)
 		serviceQuery, err := protoutil.GetServiceByName(protoFile, "Query")
 		if err != nil {
@@ -216,9 +227,37 @@ func protoRPCModify(opts *typed.Options) genny.RunFn {
 		protoutil.AttachComment(rpcQueryGet, fmt.Sprintf("List%[1]v Queries a list of %[1]v items.", typenamePascal))
 		protoutil.Append(serviceQuery, rpcQueryGet, rpcQueryAll)
 
+		// Composite keys can be queried by their first index as a prefix.
+		var prefixIndex field.Field
+		isComposite := len(opts.Indexes) > 1
+		if isComposite {
+			prefixIndex = opts.Indexes[0]
+			rpcQueryByPrefix := protoutil.NewRPC(
+				fmt.Sprintf("List%sBy%s", typenamePascal, prefixIndex.Name.PascalCase),
+				fmt.Sprintf("QueryAll%sBy%sRequest", typenamePascal, prefixIndex.Name.PascalCase),
+				fmt.Sprintf("QueryAll%sResponse", typenamePascal),
+				protoutil.WithRPCOptions(
+					protoutil.NewOption(
+						"google.api.http",
+						fmt.Sprintf(
+							"/%s/%s/%s/%s/%s",
+							appModulePath, opts.ModuleName, opts.ProtoVer, typenameSnake, protoIndexes[0],
+						),
+						protoutil.Custom(),
+						protoutil.SetField("get"),
+					),
+				),
+			)
+			protoutil.AttachComment(
+				rpcQueryByPrefix,
+				fmt.Sprintf("List%[1]vBy%[2]v Queries a list of %[1]v items by %[2]v.", typenamePascal, prefixIndex.Name.PascalCase),
+			)
+			protoutil.Append(serviceQuery, rpcQueryByPrefix)
+		}
+
 		//  Ensure custom types are imported
 		var protoImports []*proto.Import
-		for _, imp := range opts.Fields.ProtoImports() {
+		for _, imp := range append(opts.Fields.ProtoImports(), opts.Indexes.ProtoImports()...) {
 			protoImports = append(protoImports, protoutil.NewImport(imp))
 		}
 		for _, f := range opts.Fields.Custom() {
@@ -233,9 +272,13 @@ func protoRPCModify(opts *typed.Options) genny.RunFn {
 
 		// Add the messages.
 		paginationType, paginationName := "cosmos.base.query.v1beta1.Page", "pagination"
+		indexFields := make([]*proto.NormalField, len(opts.Indexes))
+		for i, index := range opts.Indexes {
+			indexFields[i] = index.ToProtoField(i + 1)
+		}
 		queryGetRequest := protoutil.NewMessage(
 			fmt.Sprintf("QueryGet%sRequest", typenamePascal),
-			protoutil.WithFields(opts.Index.ToProtoField(1)),
+			protoutil.WithFields(indexFields...),
 		)
 		gogoOption := protoutil.NewOption("gogoproto.nullable", "false", protoutil.Custom())
 		queryGetResponse := protoutil.NewMessage(
@@ -260,6 +303,16 @@ func protoRPCModify(opts *typed.Options) genny.RunFn {
 			),
 		)
 		protoutil.Append(protoFile, queryGetRequest, queryGetResponse, queryAllRequest, queryAllResponse)
+		if isComposite {
+			queryByPrefixRequest := protoutil.NewMessage(
+				fmt.Sprintf("QueryAll%sBy%sRequest", typenamePascal, prefixIndex.Name.PascalCase),
+				protoutil.WithFields(
+					prefixIndex.ToProtoField(1),
+					protoutil.NewField(paginationName, paginationType+"Request", 2),
+				),
+			)
+			protoutil.Append(protoFile, queryByPrefixRequest)
+		}
 
 		newFile := genny.NewFileS(path, protoutil.Print(protoFile))
 		return r.File(newFile)
@@ -287,17 +340,36 @@ func clientCliQueryModify(opts *typed.Options) genny.RunFn {
 		getOption := fmt.Sprintf(
 			`{
 				RpcMethod: "Get%[1]v",
-				Use: "get-%[2]v [id]",
+				Use: "get-%[2]v %[5]v",
 				Short: "Gets a %[3]v",
 				Alias: []string{"show-%[2]v"},
-				PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "%[4]s"}},
+				PositionalArgs: []*autocliv1.PositionalArgDescriptor{%[4]s},
 			}`,
 			opts.TypeName.PascalCase,
 			opts.TypeName.Kebab,
 			opts.TypeName.Original,
-			opts.Index.ProtoFieldName(),
+			opts.Indexes.ProtoFieldNameAutoCLI(),
+			indexesCLIUsage(opts.Indexes),
 		)
-		content, err := typed.AppendAutoCLIQueryOptions(f.String(), listOption, getOption)
+		options := []string{listOption, getOption}
+		if len(opts.Indexes) > 1 {
+			prefixIndex := opts.Indexes[0]
+			options = append(options, fmt.Sprintf(
+				`{
+				RpcMethod: "List%[1]vBy%[4]v",
+				Use: "list-%[2]v-by-%[5]v [%[6]v]",
+				Short: "List all %[3]v by %[6]v",
+				PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "%[6]s"}},
+			}`,
+				opts.TypeName.PascalCase,
+				opts.TypeName.Kebab,
+				opts.TypeName.Original,
+				prefixIndex.Name.PascalCase,
+				prefixIndex.Name.Kebab,
+				prefixIndex.ProtoFieldName(),
+			))
+		}
+		content, err := typed.AppendAutoCLIQueryOptions(f.String(), options...)
 		if err != nil {
 			return err
 		}
@@ -369,7 +441,14 @@ func genesisTypesModify(opts *typed.Options) genny.RunFn {
 		}
 
 		// lines of code to call the key function with the indexes of the element
-		keyCall := fmt.Sprintf(`fmt.Sprint(elem.%s)`, opts.Index.Name.UpperCamel)
+		keyCall := fmt.Sprintf(`fmt.Sprint(elem.%s)`, opts.Indexes[0].Name.UpperCamel)
+		if len(opts.Indexes) > 1 {
+			indexValues := make([]string, len(opts.Indexes))
+			for i, index := range opts.Indexes {
+				indexValues[i] = "elem." + index.Name.UpperCamel
+			}
+			keyCall = fmt.Sprintf("fmt.Sprintf(\"%%#v\", []any{%s})", strings.Join(indexValues, ", "))
+		}
 		templateTypesValidate := `// Check for duplicated index in %[1]v
 %[1]vIndexMap := make(map[string]struct{})
 
@@ -410,7 +489,7 @@ func genesisModuleModify(opts *typed.Options) genny.RunFn {
 
 		templateModuleInit := `// Set all the %[1]v
 for _, elem := range genState.%[2]vMap {
-	if err := k.%[2]v.Set(ctx, elem.%[3]v, elem); err != nil {
+	if err := k.%[2]v.Set(ctx, %[3]v, elem); err != nil {
 		return err
 	}
 }`
@@ -418,7 +497,7 @@ for _, elem := range genState.%[2]vMap {
 			templateModuleInit,
 			opts.TypeName.LowerCamel,
 			opts.TypeName.UpperCamel,
-			opts.Index.Name.UpperCamel,
+			opts.Indexes.CollectionsKey("elem"),
 		)
 		content, err := xast.ModifyFunction(
 			f.String(),
@@ -438,7 +517,7 @@ for _, elem := range genState.%[2]vMap {
 		replacementModuleExport := fmt.Sprintf(
 			templateModuleExport,
 			opts.TypeName.UpperCamel,
-			opts.Index.DataType(),
+			opts.Indexes.CollectionsKeyType(),
 			opts.TypeName.PascalCase,
 		)
 		content, err = xast.ModifyFunction(
@@ -466,7 +545,8 @@ func genesisTestsModify(opts *typed.Options) genny.RunFn {
 		// Create a list of two different indexes to use as sample
 		sampleIndexes := make([]string, 2)
 		for i := 0; i < 2; i++ {
-			sampleIndexes[i] = opts.Index.GenesisArgs(i)
+			sampleIndexes[i] = opts.Indexes.GenesisArgs(i)
+			sampleIndexes[i] += opts.UniqueIndexesGenesisArgs(i)
 		}
 
 		// add parameter to the struct into the new method.
@@ -505,7 +585,8 @@ func genesisTypesTestsModify(opts *typed.Options) genny.RunFn {
 		// Create a list of two different indexes to use as sample
 		sampleIndexes := make([]string, 2)
 		for i := 0; i < 2; i++ {
-			sampleIndexes[i] = opts.Index.GenesisArgs(i)
+			sampleIndexes[i] = opts.Indexes.GenesisArgs(i)
+			sampleIndexes[i] += opts.UniqueIndexesGenesisArgs(i)
 		}
 
 		templateDuplicated := `{
@@ -596,15 +677,18 @@ func protoTxModify(opts *typed.Options) genny.RunFn {
 		)
 
 		// Messages
-		index := opts.Index.ToProtoField(2)
+		var indexes []*proto.NormalField
+		for i, index := range opts.Indexes {
+			indexes = append(indexes, index.ToProtoField(i+2)) // +2 because of the signer
+		}
 		var fields []*proto.NormalField
 		for i, f := range opts.Fields {
-			fields = append(fields, f.ToProtoField(i+3)) // +3 because of the index
+			fields = append(fields, f.ToProtoField(i+len(indexes)+2)) // +2 because of the signer
 		}
 
 		// Ensure custom types are imported
 		var protoImports []*proto.Import
-		for _, imp := range append(opts.Fields.ProtoImports(), opts.Index.ProtoImports()...) {
+		for _, imp := range append(opts.Fields.ProtoImports(), opts.Indexes.ProtoImports()...) {
 			protoImports = append(protoImports, protoutil.NewImport(imp))
 		}
 		for _, f := range opts.Fields.Custom() {
@@ -620,7 +704,7 @@ func protoTxModify(opts *typed.Options) genny.RunFn {
 		creator.Options = append(creator.Options, protoutil.NewOption("cosmos_proto.scalar", "cosmos.AddressString", protoutil.Custom())) // set the scalar annotation
 		creatorOpt := protoutil.NewOption(typed.MsgSignerOption, opts.MsgSigner.Snake)
 		commonFields := []*proto.NormalField{creator}
-		commonFields = append(commonFields, index)
+		commonFields = append(commonFields, indexes...)
 
 		msgCreate := protoutil.NewMessage(
 			"MsgCreate"+typenamePascal,
@@ -659,8 +743,8 @@ func clientCliTxModify(opts *typed.Options) genny.RunFn {
 			return err
 		}
 
-		index := fmt.Sprintf(`{ProtoField: "%s"}, `, opts.Index.ProtoFieldName())
-		indexStr := fmt.Sprintf("[%s] ", opts.Index.ProtoFieldName())
+		index := opts.Indexes.ProtoFieldNameAutoCLI() + ", "
+		indexStr := indexesCLIUsage(opts.Indexes) + " "
 		positionalArgs := index + opts.Fields.ProtoFieldNameAutoCLI()
 		positionalArgsStr := indexStr + opts.Fields.CLIUsage()
 
@@ -749,3 +833,12 @@ func typesCodecModify(opts *typed.Options) genny.RunFn {
 		return r.File(newFile)
 	}
 }
+
+// indexesCLIUsage returns the CLI usage of the map indexes (e.g. "[owner] [denom]").
+func indexesCLIUsage(indexes field.Fields) string {
+	usage := make([]string, len(indexes))
+	for i, index := range indexes {
+		usage[i] = fmt.Sprintf("[%s]", index.ProtoFieldName())
+	}
+	return strings.Join(usage, " ")
+}
@@ -22,7 +22,8 @@ func moduleSimulationModify(opts *typed.Options) genny.RunFn {
 		sampleIndexes := make([]string, 2)
 		for i := 0; i < 2; i++ {
 			sampleIndexes[i] = fmt.Sprintf("%s: sample.AccAddress(),\n", opts.MsgSigner.UpperCamel)
-			sampleIndexes[i] += opts.Index.GenesisArgs(i)
+			sampleIndexes[i] += opts.Indexes.GenesisArgs(i)
+			sampleIndexes[i] += opts.UniqueIndexesGenesisArgs(i)
 		}
 
 		// simulation genesis state
@@ -13,18 +13,26 @@ import (
 
 // Options ...
 type Options struct {
-	AppName      string
-	ProtoDir     string
-	ProtoVer     string
-	ModuleName   string
-	ModulePath   string
-	TypeName     multiformatname.Name
-	MsgSigner    multiformatname.Name
-	Fields       field.Fields
-	Index        field.Field
-	NoMessage    bool
-	NoSimulation bool
-	IsIBC        bool
+	AppName          string
+	ProtoDir         string
+	ProtoVer         string
+	ModuleName       string
+	ModulePath       string
+	TypeName         multiformatname.Name
+	MsgSigner        multiformatname.Name
+	Fields           field.Fields
+	Indexes          field.Fields
+	SecondaryIndexes []SecondaryIndex
+	NoMessage        bool
+	NoSimulation     bool
+	Events           bool
+	IsIBC            bool
+}
+
+// SecondaryIndex represents a field of the type indexed by a secondary index.
+type SecondaryIndex struct {
+	Field  field.Field
+	Unique bool
 }
 
 // ProtoFile returns the path to the proto folder within the generated app.
@@ -36,3 +44,72 @@ func (opts *Options) ProtoFile(fname string) string {
 func (opts *Options) ProtoTypeImport() *proto.Import {
 	return protoutil.NewImport(fmt.Sprintf("%s/%s/%s/%s.proto", opts.AppName, opts.ModuleName, opts.ProtoVer, opts.TypeName.Snake))
 }
+
+// CollectionsKeyType returns the Go type of the key storing the type values.
+// List types are stored by their id and map types by their indexes.
+func (opts *Options) CollectionsKeyType() string {
+	if len(opts.Indexes) == 0 {
+		return "uint64"
+	}
+	return opts.Indexes.CollectionsKeyType()
+}
+
+// CollectionsKeyCodec returns the codec of the key storing the type values.
+func (opts *Options) CollectionsKeyCodec() string {
+	if len(opts.Indexes) == 0 {
+		return "collections.Uint64Key"
+	}
+	return opts.Indexes.CollectionsKeyCodec()
+}
+
+// CollectionsType returns the keeper collection type storing the type values.
+// An indexed map is used when the type has secondary indexes.
+func (opts *Options) CollectionsType() string {
+	if len(opts.SecondaryIndexes) == 0 {
+		return fmt.Sprintf("collections.Map[%s, types.%s]", opts.CollectionsKeyType(), opts.TypeName.PascalCase)
+	}
+	return fmt.Sprintf(
+		"*collections.IndexedMap[%[1]s, types.%[2]s, %[2]sIndexes]",
+		opts.CollectionsKeyType(),
+		opts.TypeName.PascalCase,
+	)
+}
+
+// CollectionsConstructor returns the keeper collection constructor call.
+func (opts *Options) CollectionsConstructor() string {
+	if len(opts.SecondaryIndexes) == 0 {
+		return fmt.Sprintf(
+			`collections.NewMap(sb, types.%[1]vKey, "%[2]v", %[3]v, codec.CollValue[types.%[1]v](cdc))`,
+			opts.TypeName.PascalCase,
+			opts.TypeName.LowerCamel,
+			opts.CollectionsKeyCodec(),
+		)
+	}
+	return fmt.Sprintf(
+		`collections.NewIndexedMap(sb, types.%[1]vKey, "%[2]v", %[3]v, codec.CollValue[types.%[1]v](cdc), New%[1]vIndexes(sb))`,
+		opts.TypeName.PascalCase,
+		opts.TypeName.LowerCamel,
+		opts.CollectionsKeyCodec(),
+	)
+}
+
+// UniqueIndexes returns the secondary indexes enforcing the uniqueness of their field.
+func (opts *Options) UniqueIndexes() []SecondaryIndex {
+	var uniqueIndexes []SecondaryIndex
+	for _, index := range opts.SecondaryIndexes {
+		if index.Unique {
+			uniqueIndexes = append(uniqueIndexes, index)
+		}
+	}
+	return uniqueIndexes
+}
+
+// UniqueIndexesGenesisArgs returns the genesis args of the fields indexed by a unique
+// secondary index, so sample values of the type don't collide on these indexes.
+func (opts *Options) UniqueIndexesGenesisArgs(value int) string {
+	var args string
+	for _, index := range opts.UniqueIndexes() {
+		args += index.Field.GenesisArgs(value)
+	}
+	return args
+}
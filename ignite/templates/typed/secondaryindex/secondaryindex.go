@@ -0,0 +1,231 @@
+// Package secondaryindex provides the templates to scaffold the secondary indexes of list and map types.
+package secondaryindex
+
+import (
+	"embed"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+
+	"github.com/emicklei/proto"
+	"github.com/gobuffalo/genny/v2"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/pkg/gomodulepath"
+	"github.com/ignite/cli/v29/ignite/pkg/protoanalysis/protoutil"
+	"github.com/ignite/cli/v29/ignite/pkg/xast"
+	"github.com/ignite/cli/v29/ignite/templates/typed"
+)
+
+var (
+	//go:embed files/component/* files/component/**/*
+	fsComponent embed.FS
+
+	//go:embed files/tests/* files/tests/**/*
+	fsTests embed.FS
+)
+
+// Register adds to the generator of a list or map type the files and modifications
+// required by its secondary indexes. genesisField is the suffix of the genesis state
+// field holding the values of the type (e.g. "List" or "Map").
+func Register(g *genny.Generator, opts *typed.Options, genesisField string, withTests bool) error {
+	if len(opts.SecondaryIndexes) == 0 {
+		return nil
+	}
+
+	subComponent, err := fs.Sub(fsComponent, "files/component")
+	if err != nil {
+		return errors.Errorf("fail to generate sub: %w", err)
+	}
+	subTests, err := fs.Sub(fsTests, "files/tests")
+	if err != nil {
+		return errors.Errorf("fail to generate sub: %w", err)
+	}
+
+	g.RunFn(protoQueryModify(opts))
+	g.RunFn(clientCliQueryModify(opts))
+	g.RunFn(genesisTypesModify(opts, genesisField))
+
+	if withTests {
+		if err := typed.Box(subTests, opts, g); err != nil {
+			return err
+		}
+	}
+	return typed.Box(subComponent, opts, g)
+}
+
+// protoQueryModify modifies query.proto to add the RPCs and messages querying the type by its indexed fields.
+//
+// What it depends on:
+//   - Existence of a service with name "Query". Adds the rpc's there.
+//   - Existence of the QueryGet<Type>Response and QueryAll<Type>Response messages.
+func protoQueryModify(opts *typed.Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := opts.ProtoFile("query.proto")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+		protoFile, err := protoutil.ParseProtoFile(f)
+		if err != nil {
+			return err
+		}
+
+		serviceQuery, err := protoutil.GetServiceByName(protoFile, "Query")
+		if err != nil {
+			return errors.Errorf("failed while looking up service 'Query' in %s: %w", path, err)
+		}
+
+		var (
+			appModulePath                 = gomodulepath.ExtractAppPath(opts.ModulePath)
+			typenamePascal, typenameSnake = opts.TypeName.PascalCase, opts.TypeName.Snake
+			paginationType                = "cosmos.base.query.v1beta1.PageRequest"
+			protoImports                  []*proto.Import
+		)
+		for _, index := range opts.SecondaryIndexes {
+			for _, imp := range index.Field.ProtoImports() {
+				protoImports = append(protoImports, protoutil.NewImport(imp))
+			}
+
+			route := fmt.Sprintf(
+				"/%s/%s/%s/%s_by_%s/{%s}",
+				appModulePath,
+				opts.ModuleName,
+				opts.ProtoVer,
+				typenameSnake,
+				index.Field.Name.Snake,
+				index.Field.ProtoFieldName(),
+			)
+			httpOption := protoutil.WithRPCOptions(
+				protoutil.NewOption("google.api.http", route, protoutil.Custom(), protoutil.SetField("get")),
+			)
+
+			if index.Unique {
+				rpcName := fmt.Sprintf("Get%sBy%s", typenamePascal, index.Field.Name.PascalCase)
+				requestName := fmt.Sprintf("QueryGet%sBy%sRequest", typenamePascal, index.Field.Name.PascalCase)
+				rpc := protoutil.NewRPC(rpcName, requestName, fmt.Sprintf("QueryGet%sResponse", typenamePascal), httpOption)
+				protoutil.AttachComment(rpc, fmt.Sprintf("%s Queries a %s by %s.", rpcName, typenamePascal, index.Field.Name.LowerCamel))
+				protoutil.Append(serviceQuery, rpc)
+
+				request := protoutil.NewMessage(requestName, protoutil.WithFields(index.Field.ToProtoField(1)))
+				protoutil.Append(protoFile, request)
+				continue
+			}
+
+			rpcName := fmt.Sprintf("List%sBy%s", typenamePascal, index.Field.Name.PascalCase)
+			requestName := fmt.Sprintf("QueryAll%sBy%sRequest", typenamePascal, index.Field.Name.PascalCase)
+			rpc := protoutil.NewRPC(rpcName, requestName, fmt.Sprintf("QueryAll%sResponse", typenamePascal), httpOption)
+			protoutil.AttachComment(rpc, fmt.Sprintf("%s Queries a list of %s items by %s.", rpcName, typenamePascal, index.Field.Name.LowerCamel))
+			protoutil.Append(serviceQuery, rpc)
+
+			request := protoutil.NewMessage(
+				requestName,
+				protoutil.WithFields(
+					index.Field.ToProtoField(1),
+					protoutil.NewField("pagination", paginationType, 2),
+				),
+			)
+			protoutil.Append(protoFile, request)
+		}
+
+		// we already know an import exists, pass false for fallback.
+		if err = protoutil.AddImports(protoFile, false, protoImports...); err != nil {
+			return errors.Errorf("failed to add imports to %s: %w", path, err)
+		}
+
+		newFile := genny.NewFileS(path, protoutil.Print(protoFile))
+		return r.File(newFile)
+	}
+}
+
+func clientCliQueryModify(opts *typed.Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join("x", opts.ModuleName, "module/autocli.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		options := make([]string, 0, len(opts.SecondaryIndexes))
+		for _, index := range opts.SecondaryIndexes {
+			method, use, short := "List", "list", "List all"
+			if index.Unique {
+				method, use, short = "Get", "get", "Gets a"
+			}
+			options = append(options, fmt.Sprintf(
+				`{
+				RpcMethod: "%[1]v%[2]vBy%[3]v",
+				Use: "%[4]v-%[5]v-by-%[6]v [%[7]v]",
+				Short: "%[8]v %[9]v by %[7]v",
+				PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "%[7]v"}},
+			}`,
+				method,
+				opts.TypeName.PascalCase,
+				index.Field.Name.PascalCase,
+				use,
+				opts.TypeName.Kebab,
+				index.Field.Name.Kebab,
+				index.Field.ProtoFieldName(),
+				short,
+				opts.TypeName.Original,
+			))
+		}
+
+		content, err := typed.AppendAutoCLIQueryOptions(f.String(), options...)
+		if err != nil {
+			return err
+		}
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+// genesisTypesModify adds to the genesis validation the checks of the unique indexes,
+// so the genesis state can be indexed when initializing the chain.
+func genesisTypesModify(opts *typed.Options, genesisField string) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join("x", opts.ModuleName, "types/genesis.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		content := f.String()
+		for _, index := range opts.SecondaryIndexes {
+			if !index.Unique {
+				continue
+			}
+
+			templateTypesValidate := `// Check for duplicated %[3]v in %[1]v
+%[1]v%[4]vMap := make(map[string]struct{})
+
+for _, elem := range gs.%[2]v%[5]v {
+	key := fmt.Sprint(elem.%[6]v)
+	if _, ok := %[1]v%[4]vMap[key]; ok {
+		return fmt.Errorf("duplicated %[3]v for %[1]v")
+	}
+	%[1]v%[4]vMap[key] = struct{}{}
+}`
+			replacementTypesValidate := fmt.Sprintf(
+				templateTypesValidate,
+				opts.TypeName.LowerCamel,
+				opts.TypeName.UpperCamel,
+				index.Field.Name.LowerCamel,
+				index.Field.Name.PascalCase,
+				genesisField,
+				index.Field.Name.UpperCamel,
+			)
+			content, err = xast.ModifyFunction(
+				content,
+				"Validate",
+				xast.AppendFuncCode(replacementTypesValidate),
+			)
+			if err != nil {
+				return err
+			}
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
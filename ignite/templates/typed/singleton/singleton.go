@@ -73,6 +73,9 @@ func NewGenerator(opts *typed.Options) (*genny.Generator, error) {
 		if err := typed.Box(subMessages, opts, g); err != nil {
 			return nil, err
 		}
+		if err := typed.BoxValidation(opts, g); err != nil {
+			return nil, err
+		}
 	}
 
 	return g, typed.Box(subComponent, opts, g)
@@ -1,17 +1,22 @@
 package typed
 
 import (
+	"embed"
 	"io/fs"
 
 	"github.com/gobuffalo/genny/v2"
 	"github.com/gobuffalo/plush/v4"
 
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
 	"github.com/ignite/cli/v29/ignite/pkg/gomodulepath"
 	"github.com/ignite/cli/v29/ignite/pkg/xgenny"
 	"github.com/ignite/cli/v29/ignite/templates/field/plushhelpers"
 	"github.com/ignite/cli/v29/ignite/templates/module"
 )
 
+//go:embed files/validation/* files/validation/**/*
+var fsValidation embed.FS
+
 func Box(box fs.FS, opts *Options, g *genny.Generator) error {
 	if err := g.OnlyFS(box, nil, nil); err != nil {
 		return err
@@ -28,8 +33,13 @@ func Box(box fs.FS, opts *Options, g *genny.Generator) error {
 	ctx.Set("ModulePath", opts.ModulePath)
 	ctx.Set("MsgSigner", opts.MsgSigner)
 	ctx.Set("Fields", opts.Fields)
-	ctx.Set("Index", opts.Index)
+	ctx.Set("Indexes", opts.Indexes)
+	ctx.Set("SecondaryIndexes", opts.SecondaryIndexes)
+	ctx.Set("UniqueIndexes", opts.UniqueIndexes())
+	ctx.Set("CollectionsKeyType", opts.CollectionsKeyType())
+	ctx.Set("CollectionsKeyCodec", opts.CollectionsKeyCodec())
 	ctx.Set("NoMessage", opts.NoMessage)
+	ctx.Set("Events", opts.Events)
 	ctx.Set("protoPkgName", module.ProtoPackageName(appModulePath, opts.ModuleName, opts.ProtoVer))
 	ctx.Set("strconv", func() bool {
 		strconv := false
@@ -51,3 +61,17 @@ func Box(box fs.FS, opts *Options, g *genny.Generator) error {
 
 	return nil
 }
+
+// BoxValidation adds the stateless validation of the create and update messages,
+// when some of the type fields require it (e.g. enums).
+func BoxValidation(opts *Options, g *genny.Generator) error {
+	if len(opts.Fields.Validated()) == 0 {
+		return nil
+	}
+
+	subValidation, err := fs.Sub(fsValidation, "files/validation")
+	if err != nil {
+		return errors.Errorf("fail to generate sub: %w", err)
+	}
+	return Box(subValidation, opts, g)
+}
@@ -0,0 +1,43 @@
+package upgrade
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+	"unicode"
+)
+
+// Options represents the options to scaffold a chain software upgrade.
+type Options struct {
+	ModulePath   string
+	UpgradeName  string
+	AddStores    []string
+	DeleteStores []string
+	WithTest     bool
+	FirstUpgrade bool
+}
+
+// UpgradePkg returns the Go package name of the upgrade, derived from the upgrade name.
+func (opts Options) UpgradePkg() string {
+	return PackageName(opts.UpgradeName)
+}
+
+// UpgradeDir returns the path to the upgrade folder.
+func (opts Options) UpgradeDir() string {
+	return filepath.Join("app", "upgrades", opts.UpgradePkg())
+}
+
+// UpgradeImportPath returns the upgrade import path used by the app.
+func (opts Options) UpgradeImportPath() string {
+	return fmt.Sprintf("%s/app/upgrades/%s", opts.ModulePath, opts.UpgradePkg())
+}
+
+// PackageName returns the Go package name for an upgrade name, e.g. "v2.1.0" becomes "v2_1_0".
+func PackageName(upgradeName string) string {
+	return strings.Map(func(r rune) rune {
+		if r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)) {
+			return unicode.ToLower(r)
+		}
+		return '_'
+	}, upgradeName)
+}
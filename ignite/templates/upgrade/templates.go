@@ -0,0 +1,14 @@
+package upgrade
+
+import "embed"
+
+var (
+	//go:embed files/base/* files/base/**/*
+	fsBase embed.FS
+
+	//go:embed files/upgrade/* files/upgrade/**/*
+	fsUpgrade embed.FS
+
+	//go:embed files/test/* files/test/**/*
+	fsTest embed.FS
+)
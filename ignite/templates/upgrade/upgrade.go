@@ -0,0 +1,176 @@
+// Package upgrade provides the templates to scaffold chain software upgrades.
+package upgrade
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/gobuffalo/genny/v2"
+	"github.com/gobuffalo/plush/v4"
+
+	"github.com/ignite/cli/v29/ignite/pkg/errors"
+	"github.com/ignite/cli/v29/ignite/pkg/xast"
+	"github.com/ignite/cli/v29/ignite/pkg/xgenny"
+)
+
+const setupUpgradeHandlersCall = "app.setupUpgradeHandlers()"
+
+var (
+	appFile      = filepath.Join("app", "app.go")
+	upgradesFile = filepath.Join("app", "upgrades.go")
+)
+
+// NewGenerator returns the generator to scaffold a new chain upgrade.
+func NewGenerator(opts *Options) (*genny.Generator, error) {
+	g := genny.New()
+
+	ctx := plush.NewContext()
+	ctx.Set("ModulePath", opts.ModulePath)
+	ctx.Set("UpgradeName", opts.UpgradeName)
+	ctx.Set("UpgradePkg", opts.UpgradePkg())
+	ctx.Set("UpgradeImportPath", opts.UpgradeImportPath())
+	ctx.Set("AddStores", opts.AddStores)
+	ctx.Set("DeleteStores", opts.DeleteStores)
+	ctx.Set("TestName", "Upgrade"+strings.ToUpper(opts.UpgradePkg()[:1])+opts.UpgradePkg()[1:])
+
+	boxes := []struct {
+		fs   fs.FS
+		dir  string
+		skip bool
+	}{
+		{fs: fsBase, dir: "files/base", skip: !opts.FirstUpgrade},
+		{fs: fsUpgrade, dir: "files/upgrade"},
+		{fs: fsTest, dir: "files/test", skip: !opts.WithTest},
+	}
+	for _, box := range boxes {
+		if box.skip {
+			continue
+		}
+		subFS, err := fs.Sub(box.fs, box.dir)
+		if err != nil {
+			return nil, errors.Errorf("fail to generate sub: %w", err)
+		}
+		if err := g.OnlyFS(subFS, nil, nil); err != nil {
+			return g, err
+		}
+	}
+
+	g.Transformer(xgenny.Transformer(ctx))
+	g.Transformer(genny.Replace("{{upgradePkg}}", opts.UpgradePkg()))
+
+	if opts.FirstUpgrade {
+		g.RunFn(appModify())
+	} else {
+		g.RunFn(upgradesModify(opts))
+	}
+
+	return g, nil
+}
+
+// appModify modifies app.go to register the upgrade handlers and the store loader
+// before the app state is loaded.
+func appModify() genny.RunFn {
+	return func(r *genny.Runner) error {
+		f, err := r.Disk.Find(appFile)
+		if err != nil {
+			return err
+		}
+
+		content, err := registerUpgradeHandlers(f.String())
+		if err != nil {
+			return err
+		}
+
+		return r.File(genny.NewFileS(appFile, content))
+	}
+}
+
+// upgradesModify modifies app/upgrades.go to add the new upgrade to the chain upgrades.
+// The file is not modified when the upgrade is already part of the chain upgrades.
+func upgradesModify(opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		f, err := r.Disk.Find(upgradesFile)
+		if err != nil {
+			return err
+		}
+		if hasUpgrade(f.String(), opts.UpgradePkg()) {
+			return nil
+		}
+
+		content, err := xast.AppendImports(f.String(), xast.WithImport(opts.UpgradeImportPath()))
+		if err != nil {
+			return err
+		}
+
+		content, err = xast.ModifyGlobalArrayVar(
+			content,
+			"Upgrades",
+			xast.AppendGlobalArrayValue(opts.UpgradePkg()+".Upgrade"),
+		)
+		if err != nil {
+			return err
+		}
+
+		return r.File(genny.NewFileS(upgradesFile, content))
+	}
+}
+
+// hasUpgrade checks if the upgrade of the package is part of the chain upgrades.
+func hasUpgrade(content, upgradePkg string) bool {
+	return regexp.MustCompile(`\b` + regexp.QuoteMeta(upgradePkg) + `\.Upgrade\b`).MatchString(content)
+}
+
+func registerUpgradeHandlers(content string) (string, error) {
+	if strings.Contains(content, setupUpgradeHandlersCall) {
+		return content, nil
+	}
+
+	line, err := appLoadLine(content)
+	if err != nil {
+		return "", err
+	}
+
+	return xast.ModifyFunction(content, "New", xast.AppendFuncAtLine(setupUpgradeHandlersCall, line))
+}
+
+// appLoadLine returns the index of the statement loading the app state in the New function.
+// The upgrade handlers and store loader must be set before the state is loaded.
+func appLoadLine(content string) (uint64, error) {
+	fileSet := token.NewFileSet()
+	file, err := parser.ParseFile(fileSet, "", content, parser.ParseComments)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, decl := range file.Decls {
+		funcDecl, ok := decl.(*ast.FuncDecl)
+		if !ok || funcDecl.Name.Name != "New" || funcDecl.Recv != nil || funcDecl.Body == nil {
+			continue
+		}
+
+		for i, stmt := range funcDecl.Body.List {
+			found := false
+			ast.Inspect(stmt, func(n ast.Node) bool {
+				call, ok := n.(*ast.CallExpr)
+				if !ok {
+					return true
+				}
+				if sel, ok := call.Fun.(*ast.SelectorExpr); ok && sel.Sel.Name == "Load" {
+					found = true
+				}
+				return !found
+			})
+			if found {
+				return uint64(i), nil
+			}
+		}
+		return 0, errors.New("app state loading not found in function \"New\"")
+	}
+
+	return 0, errors.New("function \"New\" not found")
+}
@@ -0,0 +1,81 @@
+package upgrade
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const appContent = `package app
+
+func New(loadLatest bool) *App {
+	app := &App{}
+
+	app.SetInitChainer(app.InitChainer)
+
+	if err := app.Load(loadLatest); err != nil {
+		panic(err)
+	}
+
+	return app
+}
+`
+
+func TestRegisterUpgradeHandlers(t *testing.T) {
+	got, err := registerUpgradeHandlers(appContent)
+	require.NoError(t, err)
+
+	normalized := normalize(got)
+	require.Contains(t, normalized, `app.SetInitChainer(app.InitChainer)app.setupUpgradeHandlers()iferr:=app.Load(loadLatest)`)
+
+	// registering the handlers twice must not duplicate the call
+	got, err = registerUpgradeHandlers(got)
+	require.NoError(t, err)
+	require.Equal(t, 1, strings.Count(got, setupUpgradeHandlersCall))
+}
+
+func TestRegisterUpgradeHandlersWithoutLoad(t *testing.T) {
+	_, err := registerUpgradeHandlers(`package app
+
+func New() *App {
+	return &App{}
+}
+`)
+	require.Error(t, err)
+}
+
+func TestPackageName(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{name: "v2", want: "v2"},
+		{name: "v2.1.0", want: "v2_1_0"},
+		{name: "V3-rc1", want: "v3_rc1"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.want, PackageName(tc.name))
+		})
+	}
+}
+
+func TestHasUpgrade(t *testing.T) {
+	content := `package app
+
+var Upgrades = []upgrades.Upgrade{
+	v2.Upgrade,
+	hot_reload_12.Upgrade,
+}
+`
+	require.True(t, hasUpgrade(content, "v2"))
+	require.True(t, hasUpgrade(content, "hot_reload_12"))
+	require.False(t, hasUpgrade(content, "v3"))
+	require.False(t, hasUpgrade(content, "reload_12"))
+	require.False(t, hasUpgrade(content, "hot_reload_1"))
+}
+
+func normalize(content string) string {
+	return strings.Join(strings.Fields(content), "")
+}
@@ -172,6 +172,36 @@ func TestGenerateAnAppWithListAndVerify(t *testing.T) {
 		"list", "user", "email", "--module", "idontexist",
 	)
 
+	app.Scaffold(
+		"create a list with secondary indexes",
+		false,
+		"list", "article", "title", "slug", "views:uint", "--secondary-index", "creator,slug:unique,views",
+	)
+
+	app.Scaffold(
+		"create a list emitting typed events",
+		false,
+		"list", "comment", "body", "likes:uint", "--events",
+	)
+
+	app.Scaffold(
+		"should prevent creating a list emitting events without messages",
+		true,
+		"list", "reply", "body", "--events", "--no-message",
+	)
+
+	app.Scaffold(
+		"should prevent creating a list with a unique index on the signer",
+		true,
+		"list", "draft", "title", "--secondary-index", "creator:unique",
+	)
+
+	app.Scaffold(
+		"should prevent creating a list with a secondary index on an unknown field",
+		true,
+		"list", "note", "title", "--secondary-index", "body",
+	)
+
 	app.EnsureSteady()
 
 	app.RunChainAndSimulateTxs(servers)
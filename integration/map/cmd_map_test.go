@@ -137,7 +137,43 @@ func TestCreateMap(t *testing.T) {
 	)
 
 	app.Scaffold(
-		"create a map with invalid index (multi-index)",
+		"create a map with a composite index",
+		false,
+		"map",
+		"map_with_composite_index",
+		"amount:uint",
+		"--index",
+		"owner:address,denom:string",
+		"--module",
+		"example",
+	)
+
+	app.Scaffold(
+		"create a map with a triple composite index",
+		false,
+		"map",
+		"map_with_triple_index",
+		"email",
+		"--index",
+		"owner:address,denom,nonce:uint",
+		"--module",
+		"example",
+	)
+
+	app.Scaffold(
+		"create a map with invalid index (too many fields)",
+		true,
+		"map",
+		"map_with_invalid_index",
+		"email",
+		"--index",
+		"a,b,c,d",
+		"--module",
+		"example",
+	)
+
+	app.Scaffold(
+		"create a map with invalid index (slice index)",
 		true,
 		"map",
 		"map_with_invalid_index",
@@ -178,6 +214,33 @@ func TestCreateMap(t *testing.T) {
 		"map", "map_with_invalid_index", "email", "--index", "email",
 	)
 
+	app.Scaffold(
+		"create a map with secondary indexes",
+		false,
+		"map",
+		"handle",
+		"name",
+		"rank:uint",
+		"--index",
+		"owner:address,denom",
+		"--secondary-index",
+		"name:unique,rank,creator",
+		"--module",
+		"example",
+	)
+
+	app.Scaffold(
+		"create a map emitting typed events",
+		false,
+		"map", "map_with_events", "name", "--index", "owner:address", "--events", "--module", "example",
+	)
+
+	app.Scaffold(
+		"should prevent creating a map with a secondary index on an index",
+		true,
+		"map", "map_with_invalid_secondary_index", "name", "--index", "owner", "--secondary-index", "owner",
+	)
+
 	app.EnsureSteady()
 
 	app.RunChainAndSimulateTxs(servers)
@@ -0,0 +1,60 @@
+//go:build !relayer
+
+package other_components_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	envtest "github.com/ignite/cli/v29/integration"
+)
+
+const blueprint = `modules:
+  - name: blog
+    types:
+      - name: post
+        kind: list
+        fields: [title, body]
+      - name: comment
+        kind: map
+        fields: [body]
+        index: postID:uint
+      - name: config
+        kind: single
+        fields: [owner]
+    messages:
+      - name: like-post
+        fields: [id:uint]
+        response: [count:uint]
+    queries:
+      - name: count-posts
+        fields: [author]
+        response: [count:uint]
+  - name: shop
+    params: [fee:uint]
+    types:
+      - name: item
+        kind: list
+        fields: [name, price:uint]
+`
+
+func TestApplyBlueprint(t *testing.T) {
+	var (
+		env           = envtest.New(t)
+		app           = env.ScaffoldApp("github.com/test/blog")
+		blueprintPath = filepath.Join(env.TmpDir(), "blueprint.yml")
+	)
+
+	require.NoError(t, os.WriteFile(blueprintPath, []byte(blueprint), 0o644))
+
+	app.Scaffold("apply a blueprint", false, "apply", blueprintPath)
+	require.FileExists(t, filepath.Join(app.SourcePath(), "x", "blog", "keeper", "msg_server_post.go"))
+	require.FileExists(t, filepath.Join(app.SourcePath(), "x", "shop", "keeper", "query_item.go"))
+
+	app.Scaffold("apply the same blueprint again", false, "apply", blueprintPath)
+
+	app.EnsureSteady()
+}
@@ -0,0 +1,79 @@
+//go:build !relayer
+
+package other_components_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	envtest "github.com/ignite/cli/v29/integration"
+)
+
+func TestGenerateAnAppWithBlockersAndHooks(t *testing.T) {
+	var (
+		env = envtest.New(t)
+		app = env.ScaffoldApp("github.com/test/blog")
+	)
+
+	for _, kind := range []string{"begin", "end", "pre"} {
+		app.Scaffold(
+			"create a "+kind+" blocker",
+			false,
+			"blocker",
+			kind,
+		)
+	}
+
+	app.Scaffold(
+		"should prevent creating an existing blocker",
+		true,
+		"blocker",
+		"end",
+	)
+
+	app.Scaffold(
+		"should prevent creating an unknown blocker",
+		true,
+		"blocker",
+		"middle",
+	)
+
+	app.Scaffold(
+		"create a module",
+		false,
+		"module",
+		"comments",
+	)
+
+	app.Scaffold(
+		"create hooks in a custom module",
+		false,
+		"hooks",
+		"--module",
+		"comments",
+	)
+
+	app.Scaffold(
+		"should prevent creating existing hooks",
+		true,
+		"hooks",
+		"--module",
+		"comments",
+	)
+
+	moduleContent, err := os.ReadFile(filepath.Join(app.SourcePath(), "x", "blog", "module", "module.go"))
+	require.NoError(t, err)
+
+	normalized := normalizeWhitespace(string(moduleContent))
+	require.Contains(t, normalized, "returnam.keeper.BeginBlocker(ctx)")
+	require.Contains(t, normalized, "returnam.keeper.EndBlocker(ctx)")
+	require.Contains(t, normalized, "returnam.keeper.PreBlocker(ctx)")
+
+	require.FileExists(t, filepath.Join(app.SourcePath(), "x", "comments", "types", "hooks.go"))
+	require.FileExists(t, filepath.Join(app.SourcePath(), "x", "comments", "keeper", "hooks.go"))
+
+	app.EnsureSteady()
+}
@@ -0,0 +1,77 @@
+//go:build !relayer
+
+package other_components_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	envtest "github.com/ignite/cli/v29/integration"
+)
+
+func TestGenerateAnAppWithErrors(t *testing.T) {
+	var (
+		env = envtest.New(t)
+		app = env.ScaffoldApp("github.com/test/blog")
+	)
+
+	app.Scaffold(
+		"create a list",
+		false,
+		"list",
+		"post",
+		"title",
+	)
+
+	app.Scaffold(
+		"create an error",
+		false,
+		"error",
+		"title-taken",
+		"title already taken",
+	)
+
+	app.Scaffold(
+		"create an error replacing the generic error of a handler",
+		false,
+		"error",
+		"sequence-unavailable",
+		"sequence unavailable",
+		"--replace-in",
+		"CreatePost",
+	)
+
+	app.Scaffold(
+		"should prevent creating an existing error",
+		true,
+		"error",
+		"title-taken",
+		"title already taken",
+	)
+
+	app.Scaffold(
+		"should prevent replacing the error of an unknown handler",
+		true,
+		"error",
+		"post-not-found",
+		"post not found",
+		"--replace-in",
+		"ArchivePost",
+	)
+
+	errorsContent, err := os.ReadFile(filepath.Join(app.SourcePath(), "x", "blog", "types", "errors.go"))
+	require.NoError(t, err)
+
+	normalized := normalizeWhitespace(string(errorsContent))
+	require.Contains(t, normalized, `ErrTitleTaken=errors.Register(ModuleName,1101,"titlealreadytaken")`)
+	require.Contains(t, normalized, `ErrSequenceUnavailable=errors.Register(ModuleName,1102,"sequenceunavailable")`)
+
+	handlerContent, err := os.ReadFile(filepath.Join(app.SourcePath(), "x", "blog", "keeper", "msg_server_post.go"))
+	require.NoError(t, err)
+	require.Contains(t, normalizeWhitespace(string(handlerContent)), `errorsmod.Wrap(types.ErrSequenceUnavailable,"failedtogetnextid")`)
+
+	app.EnsureSteady()
+}
@@ -0,0 +1,81 @@
+//go:build !relayer
+
+package other_components_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	envtest "github.com/ignite/cli/v29/integration"
+)
+
+func TestGenerateAnAppWithEvents(t *testing.T) {
+	var (
+		env = envtest.New(t)
+		app = env.ScaffoldApp("github.com/test/blog")
+	)
+
+	app.Scaffold(
+		"create an event",
+		false,
+		"event",
+		"post-created",
+		"id:uint",
+		"title",
+		"amount:coins",
+	)
+
+	keeperContent, err := os.ReadFile(filepath.Join(app.SourcePath(), "x", "blog", "keeper", "event_post_created.go"))
+	require.NoError(t, err)
+	require.Contains(
+		t,
+		normalizeWhitespace(string(keeperContent)),
+		`func(kKeeper)EmitPostCreatedEvent(ctxcontext.Context,event*types.EventPostCreated)error`,
+	)
+
+	app.Scaffold(
+		"create a custom field type",
+		false,
+		"type",
+		"pool",
+		"name",
+	)
+
+	app.Scaffold(
+		"create an event with a custom field type",
+		false,
+		"event",
+		"pool-updated",
+		"pool:Pool",
+	)
+
+	app.Scaffold(
+		"create a module",
+		false,
+		"module",
+		"foo",
+	)
+
+	app.Scaffold(
+		"create an event in a custom module",
+		false,
+		"event",
+		"bar-created",
+		"bar",
+		"--module",
+		"foo",
+	)
+
+	app.Scaffold(
+		"should prevent creating an existing event",
+		true,
+		"event",
+		"post-created",
+		"text",
+	)
+
+	app.EnsureSteady()
+}
@@ -0,0 +1,58 @@
+//go:build !relayer
+
+package other_components_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	envtest "github.com/ignite/cli/v29/integration"
+)
+
+func TestRemoveScaffoldedComponents(t *testing.T) {
+	var (
+		env       = envtest.New(t)
+		app       = env.ScaffoldApp("github.com/test/blog")
+		keeperDir = filepath.Join(app.SourcePath(), "x", "blog", "keeper")
+	)
+
+	app.Scaffold("create a list", false, "list", "post", "title", "body")
+	app.Scaffold("create a map", false, "map", "comment", "text", "--index", "postID:uint")
+	app.Scaffold("create a singleton", false, "single", "config", "owner")
+	app.Scaffold("create a type", false, "type", "tag", "name")
+	app.Scaffold("create a list with events", false, "list", "article", "title", "--events")
+	app.Scaffold("create a message", false, "message", "like-post", "id:uint", "--response", "count:uint")
+	app.Scaffold("create a query", false, "query", "count-posts", "author", "--response", "count:uint")
+	app.Scaffold("create a type used by a message", false, "type", "author", "name")
+	app.Scaffold("create a message using the type", false, "message", "add-author", "author:Author")
+
+	app.Scaffold("remove a query", false, "remove", "query", "count-posts")
+	require.NoFileExists(t, filepath.Join(keeperDir, "query_count_posts.go"))
+
+	app.Scaffold("remove a message", false, "remove", "message", "like-post")
+	require.NoFileExists(t, filepath.Join(keeperDir, "msg_server_like_post.go"))
+
+	app.Scaffold("remove a list", false, "remove", "type", "post")
+	require.NoFileExists(t, filepath.Join(keeperDir, "msg_server_post.go"))
+	require.NoFileExists(t, filepath.Join(keeperDir, "query_post.go"))
+	require.NoFileExists(t, filepath.Join(app.SourcePath(), "x", "blog", "types", "post.pb.go"))
+
+	app.Scaffold("remove a list with events", false, "remove", "type", "article")
+	require.NoFileExists(t, filepath.Join(app.SourcePath(), "proto", "blog", "blog", "v1", "article.proto"))
+	require.NoFileExists(t, filepath.Join(keeperDir, "msg_server_article.go"))
+
+	app.Scaffold("remove a map", false, "remove", "type", "comment", "--module", "blog")
+	app.Scaffold("remove a singleton", false, "remove", "type", "config")
+	app.Scaffold("remove a type", false, "remove", "type", "tag")
+
+	app.Scaffold("should prevent removing a type used by a message", true, "remove", "type", "author")
+	app.Scaffold("should prevent removing a nonexistent query", true, "remove", "query", "count-posts")
+	app.Scaffold("should prevent removing from a nonexistent module", true, "remove", "type", "author", "--module", "foo")
+
+	app.Scaffold("revert the removed type", false, "undo")
+	require.FileExists(t, filepath.Join(app.SourcePath(), "proto", "blog", "blog", "v1", "tag.proto"))
+
+	app.EnsureSteady()
+}
@@ -0,0 +1,70 @@
+//go:build !relayer
+
+package other_components_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	envtest "github.com/ignite/cli/v29/integration"
+)
+
+func TestUndoScaffoldOperations(t *testing.T) {
+	var (
+		env = envtest.New(t)
+		app = env.ScaffoldApp("github.com/test/blog")
+	)
+
+	genesisContent, err := os.ReadFile(filepath.Join(app.SourcePath(), "x", "blog", "types", "genesis.go"))
+	require.NoError(t, err)
+
+	app.Scaffold(
+		"create a list",
+		false,
+		"list",
+		"post",
+		"title",
+		"--no-message",
+	)
+
+	app.Scaffold(
+		"create an error",
+		false,
+		"error",
+		"post-not-found",
+		"post not found",
+	)
+
+	app.Scaffold(
+		"list the scaffold history",
+		false,
+		"undo",
+		"--list",
+	)
+
+	app.Scaffold(
+		"revert the scaffolded list and error",
+		false,
+		"undo",
+		"--steps",
+		"2",
+	)
+
+	require.NoFileExists(t, filepath.Join(app.SourcePath(), "x", "blog", "keeper", "query_post.go"))
+	require.NoFileExists(t, filepath.Join(app.SourcePath(), "x", "blog", "types", "post.pb.go"))
+
+	revertedContent, err := os.ReadFile(filepath.Join(app.SourcePath(), "x", "blog", "types", "genesis.go"))
+	require.NoError(t, err)
+	require.Equal(t, string(genesisContent), string(revertedContent))
+
+	app.Scaffold(
+		"should prevent reverting more operations than recorded",
+		true,
+		"undo",
+	)
+
+	app.EnsureSteady()
+}
@@ -71,6 +71,13 @@ func TestCreateSingleton(t *testing.T) {
 		"singleuser", "email", "--module", "example",
 	)
 
+	app.Scaffold(
+		"create an singleton type emitting typed events",
+		false,
+		"single",
+		"settings", "enabled:bool", "--events", "--module", "example",
+	)
+
 	app.EnsureSteady()
 
 	app.RunChainAndSimulateTxs(servers)